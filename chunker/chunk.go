@@ -70,6 +70,8 @@ const (
 	RdfFormat
 	// JsonFormat is a constant to denote the input to the live/bulk loader is in the JSON format.
 	JsonFormat
+	// CsvFormat is a constant to denote the input to the live/bulk loader is in the CSV format.
+	CsvFormat
 )
 
 // NewChunker returns a new chunker for the specified format.
@@ -84,12 +86,26 @@ func NewChunker(inputFormat InputFormat, batchSize int) Chunker {
 		return &jsonChunker{
 			nqs: NewNQuadBuffer(batchSize),
 		}
+	case CsvFormat:
+		x.Panic(errors.New("CSV input needs a mapping, use NewCSVChunker"))
+		return nil
 	default:
 		x.Panic(errors.New("unknown input format"))
 		return nil
 	}
 }
 
+// NewCSVChunker returns a new chunker for CSV input, mapped to N-Quads as described by the
+// mapping. The filename is used to find the mapping of the file to chunk. It can be empty if
+// the chunker is only used to parse chunks.
+func NewCSVChunker(mapping *CSVMapping, filename string, batchSize int) Chunker {
+	return &csvChunker{
+		nqs:      NewNQuadBuffer(batchSize),
+		mapping:  mapping,
+		filename: filename,
+	}
+}
+
 // Chunk reads the input line by line until one of the following 3 conditions happens
 // 1) the EOF is reached
 // 2) 1e5 lines have been read
@@ -402,7 +418,7 @@ func IsJSONData(r *bufio.Reader) (bool, error) {
 	return err == nil, nil
 }
 
-// DataFormat returns a file's data format (RDF, JSON, or unknown) based on the filename
+// DataFormat returns a file's data format (RDF, JSON, CSV or unknown) based on the filename
 // or the user-provided format option. The file extension has precedence.
 func DataFormat(filename string, format string) InputFormat {
 	format = strings.ToLower(format)
@@ -412,6 +428,8 @@ func DataFormat(filename string, format string) InputFormat {
 		return RdfFormat
 	case strings.HasSuffix(filename, ".json") || format == "json":
 		return JsonFormat
+	case strings.HasSuffix(filename, ".csv") || format == "csv":
+		return CsvFormat
 	default:
 		return UnknownFormat
 	}
@@ -0,0 +1,376 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+)
+
+// CSVMapping describes how the rows of CSV files map to nodes and edges. Every row of a file
+// describes a node, identified by the value of its xid column, and its columns map to
+// predicates. The columns referring to the nodes of other files become edges. The nodes are
+// assigned uids through the xid handling of the loaders, so rows referring to the same node
+// get the same uid, whichever file they come from.
+type CSVMapping struct {
+	Files []*CSVFile `json:"files"`
+}
+
+// CSVFile describes the mapping of the CSV files matching the File pattern.
+type CSVFile struct {
+	// File is matched against the base name of the input files, without the .gz extension. It
+	// can be a pattern as understood by filepath.Match, e.g. to load a table dumped to many files.
+	File string `json:"file"`
+	// Name identifies the nodes described by these files, so that other files can refer to them.
+	// It defaults to File without the .csv extension.
+	Name string `json:"name"`
+	// Xid is the column holding the external id of the node described by each row.
+	Xid string `json:"xid"`
+	// XidRef is the name of the files describing the nodes the xid column refers to. It
+	// defaults to Name. Setting it allows loading files of edges, such as join tables, whose rows
+	// add predicates to nodes described by another file.
+	XidRef string `json:"xid_ref"`
+	// Type, if set, is added to the dgraph.type predicate of the nodes.
+	Type string `json:"type"`
+	// Delimiter is the field delimiter of the files. It defaults to a comma.
+	Delimiter string `json:"delimiter"`
+	// Columns lists the columns to load. The other columns are ignored.
+	Columns []*CSVColumn `json:"columns"`
+}
+
+// CSVColumn describes how the values of a column are loaded. Empty values are skipped.
+type CSVColumn struct {
+	Column    string `json:"column"`
+	Predicate string `json:"predicate"`
+	// Type is the name of the schema type of the values, e.g. int or datetime. The values are
+	// loaded with the default type if it isn't set.
+	Type string `json:"type"`
+	// Ref is the name of the files describing the nodes this column refers to. If set, the
+	// values are xids of these nodes and each of them becomes an edge.
+	Ref string `json:"ref"`
+
+	tid types.TypeID
+}
+
+// graphqlSchemaCSV describes the chunk used by the bulk loader to load the GraphQL schema.
+var graphqlSchemaCSV = &CSVFile{
+	Name:   "dgraph.graphql",
+	Xid:    "xid",
+	XidRef: "dgraph.graphql",
+	Type:   "dgraph.graphql",
+	Columns: []*CSVColumn{
+		{Column: "xid", Predicate: "dgraph.graphql.xid", tid: types.StringID},
+		{Column: "schema", Predicate: "dgraph.graphql.schema", tid: types.StringID},
+	},
+}
+
+// GraphQLSchemaCSVChunk returns a chunk of CSV input that loads the given GraphQL schema.
+func GraphQLSchemaCSVChunk(schema string) *bytes.Buffer {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	x.Check(w.Write([]string{graphqlSchemaCSV.Name}))
+	x.Check(w.Write([]string{"xid", "schema"}))
+	x.Check(w.Write([]string{"dgraph.graphql.schema", schema}))
+	w.Flush()
+	return &buf
+}
+
+// ReadCSVMapping reads and validates a mapping in JSON format from the given file.
+func ReadCSVMapping(file string) (*CSVMapping, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading CSV mapping %s", file)
+	}
+	var mapping CSVMapping
+	if err := json.Unmarshal(b, &mapping); err != nil {
+		return nil, errors.Wrapf(err, "while parsing CSV mapping %s", file)
+	}
+	if err := mapping.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid CSV mapping %s", file)
+	}
+	return &mapping, nil
+}
+
+func (m *CSVMapping) validate() error {
+	names := make(map[string]bool)
+	for _, f := range m.Files {
+		if f.File == "" {
+			return errors.New("Missing file in the mapping of a CSV file")
+		}
+		if _, err := filepath.Match(f.File, ""); err != nil {
+			return errors.Wrapf(err, "invalid file pattern %q", f.File)
+		}
+		if f.Name == "" {
+			f.Name = strings.TrimSuffix(f.File, ".csv")
+		}
+		if names[f.Name] || f.Name == graphqlSchemaCSV.Name {
+			return errors.Errorf("Found multiple CSV files named %q", f.Name)
+		}
+		names[f.Name] = true
+		if f.XidRef == "" {
+			f.XidRef = f.Name
+		}
+		if f.Xid == "" {
+			return errors.Errorf("Missing xid column for CSV file %q", f.File)
+		}
+		if f.Delimiter != "" && utf8.RuneCountInString(f.Delimiter) != 1 {
+			return errors.Errorf("Delimiter of CSV file %q must be a single character", f.File)
+		}
+
+		for _, c := range f.Columns {
+			if c.Column == "" || c.Predicate == "" {
+				return errors.Errorf("Columns of CSV file %q must have a column and a predicate",
+					f.File)
+			}
+			if c.Ref != "" {
+				if c.Type != "" && c.Type != "uid" {
+					return errors.Errorf("Column %q of CSV file %q refers to other nodes and "+
+						"can't have type %s", c.Column, f.File, c.Type)
+				}
+				c.tid = types.UidID
+				continue
+			}
+			c.tid = types.DefaultID
+			if c.Type != "" {
+				tid, ok := types.TypeForName(c.Type)
+				if !ok || tid == types.UidID {
+					return errors.Errorf("Invalid type %q for column %q of CSV file %q",
+						c.Type, c.Column, f.File)
+				}
+				c.tid = tid
+			}
+		}
+	}
+
+	// Check the references once all the names are known.
+	for _, f := range m.Files {
+		if !names[f.XidRef] {
+			return errors.Errorf("CSV file %q refers to unknown CSV file %q", f.File, f.XidRef)
+		}
+		for _, c := range f.Columns {
+			if c.Ref != "" && !names[c.Ref] {
+				return errors.Errorf("Column %q of CSV file %q refers to unknown CSV file %q",
+					c.Column, f.File, c.Ref)
+			}
+		}
+	}
+	return nil
+}
+
+// fileFor returns the mapping of the file with the given path.
+func (m *CSVMapping) fileFor(path string) (*CSVFile, error) {
+	base := strings.TrimSuffix(filepath.Base(path), ".gz")
+	for _, f := range m.Files {
+		if ok, _ := filepath.Match(f.File, base); ok {
+			return f, nil
+		}
+	}
+	return nil, errors.Errorf("No mapping found for CSV file %s", path)
+}
+
+func (m *CSVMapping) fileNamed(name string) (*CSVFile, error) {
+	for _, f := range m.Files {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return nil, errors.Errorf("No mapping found for CSV file named %q", name)
+}
+
+// csvXid returns the xid of the node identified by the value of an xid column in the files
+// with the given name. The name avoids collisions between the ids of different tables.
+func csvXid(name, val string) string {
+	return name + ":" + val
+}
+
+type csvChunker struct {
+	nqs     *NQuadBuffer
+	mapping *CSVMapping
+
+	// The following are only used by Chunk.
+	filename string
+	file     *CSVFile
+	reader   *csv.Reader
+	header   []string
+}
+
+func (cc *csvChunker) NQuads() *NQuadBuffer {
+	return cc.nqs
+}
+
+// Chunk reads up to 1e5 rows of the file. As Parse might be called on chunks from various
+// files, every chunk starts with the name of the file mapping and the header of the file.
+func (cc *csvChunker) Chunk(r *bufio.Reader) (*bytes.Buffer, error) {
+	if cc.reader == nil {
+		if cc.mapping == nil {
+			return nil, errors.New("CSV input needs a mapping")
+		}
+		file, err := cc.mapping.fileFor(cc.filename)
+		if err != nil {
+			return nil, err
+		}
+		cc.file = file
+		cc.reader = csv.NewReader(r)
+		if file.Delimiter != "" {
+			cc.reader.Comma, _ = utf8.DecodeRuneInString(file.Delimiter)
+		}
+		if cc.header, err = cc.reader.Read(); err != nil {
+			return nil, errors.Wrapf(err, "while reading the header of %s", cc.filename)
+		}
+		// Skip the byte order mark written by some tools.
+		cc.header[0] = strings.TrimPrefix(cc.header[0], "\ufeff")
+	}
+
+	batch := new(bytes.Buffer)
+	batch.Grow(1 << 20)
+	w := csv.NewWriter(batch)
+	if err := w.Write([]string{cc.file.Name}); err != nil {
+		return nil, err
+	}
+	if err := w.Write(cc.header); err != nil {
+		return nil, err
+	}
+	for rowCount := 0; rowCount < 1e5; rowCount++ {
+		row, err := cc.reader.Read()
+		if err == io.EOF {
+			w.Flush()
+			return batch, err
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading %s", cc.filename)
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return batch, w.Error()
+}
+
+// Parse converts the rows of the chunk into N-Quads.
+func (cc *csvChunker) Parse(chunkBuf *bytes.Buffer) error {
+	if chunkBuf == nil || chunkBuf.Len() == 0 {
+		return nil
+	}
+
+	r := csv.NewReader(chunkBuf)
+	r.FieldsPerRecord = -1
+	name, err := r.Read()
+	if err != nil {
+		return err
+	}
+	if len(name) != 1 {
+		return errors.Errorf("Invalid CSV chunk, it must start with the name of the file")
+	}
+	file := graphqlSchemaCSV
+	if name[0] != graphqlSchemaCSV.Name {
+		if cc.mapping == nil {
+			return errors.New("CSV input needs a mapping")
+		}
+		if file, err = cc.mapping.fileNamed(name[0]); err != nil {
+			return err
+		}
+	}
+
+	header, err := r.Read()
+	if err != nil {
+		return err
+	}
+	index := make(map[string]int, len(header))
+	for i, col := range header {
+		index[strings.TrimSpace(col)] = i
+	}
+	xidIdx, ok := index[file.Xid]
+	if !ok {
+		return errors.Errorf("Xid column %q not found in CSV file %q", file.Xid, file.Name)
+	}
+	colIdx := make([]int, len(file.Columns))
+	for i, c := range file.Columns {
+		if colIdx[i], ok = index[c.Column]; !ok {
+			return errors.Errorf("Column %q not found in CSV file %q", c.Column, file.Name)
+		}
+	}
+
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if xidIdx >= len(row) || row[xidIdx] == "" {
+			return errors.Errorf("Missing xid in row %q of CSV file %q", row, file.Name)
+		}
+		subject := csvXid(file.XidRef, row[xidIdx])
+
+		if file.Type != "" {
+			cc.nqs.Push(&api.NQuad{
+				Subject:     subject,
+				Predicate:   "dgraph.type",
+				ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: file.Type}},
+			})
+		}
+		for i, c := range file.Columns {
+			if colIdx[i] >= len(row) || row[colIdx[i]] == "" {
+				continue
+			}
+			nq, err := csvNQuad(subject, c, row[colIdx[i]])
+			if err != nil {
+				return errors.Wrapf(err, "while parsing row %q of CSV file %q", row, file.Name)
+			}
+			cc.nqs.Push(nq)
+		}
+	}
+}
+
+func csvNQuad(subject string, c *CSVColumn, val string) (*api.NQuad, error) {
+	nq := &api.NQuad{Subject: subject, Predicate: c.Predicate}
+	switch c.tid {
+	case types.UidID:
+		nq.ObjectId = csvXid(c.Ref, val)
+	case types.DefaultID:
+		nq.ObjectValue = &api.Value{Val: &api.Value_DefaultVal{DefaultVal: val}}
+	default:
+		src := types.ValueForType(types.StringID)
+		src.Value = []byte(val)
+		// Passwords are already encrypted, as in the RDF input.
+		if c.tid == types.PasswordID {
+			src.Tid = c.tid
+		}
+		p, err := types.Convert(src, c.tid)
+		if err != nil {
+			return nil, err
+		}
+		if nq.ObjectValue, err = types.ObjectValue(c.tid, p.Value); err != nil {
+			return nil, err
+		}
+	}
+	return nq, nil
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chunker
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/stretchr/testify/require"
+)
+
+const testCSVMapping = `{
+	"files": [
+		{
+			"file": "person*.csv",
+			"name": "person",
+			"xid": "id",
+			"type": "Person",
+			"columns": [
+				{"column": "name", "predicate": "name"},
+				{"column": "age", "predicate": "age", "type": "int"},
+				{"column": "company_id", "predicate": "works_for", "ref": "company"}
+			]
+		},
+		{
+			"file": "company.csv",
+			"xid": "id",
+			"delimiter": ";",
+			"columns": [{"column": "name", "predicate": "name", "type": "string"}]
+		},
+		{
+			"file": "knows.csv",
+			"xid": "from",
+			"xid_ref": "person",
+			"columns": [{"column": "to", "predicate": "knows", "ref": "person"}]
+		}
+	]
+}`
+
+func readTestCSVMapping(t *testing.T, mapping string) (*CSVMapping, error) {
+	f, err := ioutil.TempFile("", "mapping")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(mapping)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	return ReadCSVMapping(f.Name())
+}
+
+func parseTestCSV(t *testing.T, mapping *CSVMapping, file, data string) []*api.NQuad {
+	chunker := NewCSVChunker(mapping, file, 0)
+	r := bufio.NewReader(strings.NewReader(data))
+	for {
+		chunkBuf, err := chunker.Chunk(r)
+		if err != nil && err != io.EOF {
+			require.NoError(t, err)
+		}
+		require.NoError(t, chunker.Parse(chunkBuf))
+		if err == io.EOF {
+			break
+		}
+	}
+	chunker.NQuads().Flush()
+	var nqs []*api.NQuad
+	for batch := range chunker.NQuads().Ch() {
+		nqs = append(nqs, batch...)
+	}
+	return nqs
+}
+
+func TestCSVMapping(t *testing.T) {
+	mapping, err := readTestCSVMapping(t, testCSVMapping)
+	require.NoError(t, err)
+
+	people := "\ufeffid,name,age,company_id,ignored\n" +
+		"1,Alice,31,10,x\n" +
+		"2,\"Bob, \"\"the builder\"\"\",,10,y\n"
+	require.Equal(t, []*api.NQuad{
+		{Subject: "person:1", Predicate: "dgraph.type",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "Person"}}},
+		{Subject: "person:1", Predicate: "name",
+			ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: "Alice"}}},
+		{Subject: "person:1", Predicate: "age",
+			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 31}}},
+		{Subject: "person:1", Predicate: "works_for", ObjectId: "company:10"},
+		{Subject: "person:2", Predicate: "dgraph.type",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "Person"}}},
+		{Subject: "person:2", Predicate: "name",
+			ObjectValue: &api.Value{Val: &api.Value_DefaultVal{
+				DefaultVal: `Bob, "the builder"`}}},
+		{Subject: "person:2", Predicate: "works_for", ObjectId: "company:10"},
+	}, parseTestCSV(t, mapping, "/data/person_1.csv.gz", people))
+
+	companies := "id;name\n10;Acme\n"
+	require.Equal(t, []*api.NQuad{
+		{Subject: "company:10", Predicate: "name",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "Acme"}}},
+	}, parseTestCSV(t, mapping, "company.csv", companies))
+
+	knows := "from,to\n1,2\n"
+	require.Equal(t, []*api.NQuad{
+		{Subject: "person:1", Predicate: "knows", ObjectId: "person:2"},
+	}, parseTestCSV(t, mapping, "knows.csv", knows))
+
+	// Chunks can be parsed by a chunker that doesn't know about the file.
+	chunk, err := NewCSVChunker(mapping, "knows.csv", 0).Chunk(
+		bufio.NewReader(strings.NewReader(knows)))
+	require.Equal(t, io.EOF, err)
+	parser := NewCSVChunker(mapping, "", 0)
+	require.NoError(t, parser.Parse(chunk))
+
+	_, err = NewCSVChunker(mapping, "unknown.csv", 0).Chunk(
+		bufio.NewReader(strings.NewReader(knows)))
+	require.Error(t, err)
+
+	badAge := "id,name,age,company_id\n3,Carol,old,10\n"
+	chunk, err = NewCSVChunker(mapping, "person.csv", 0).Chunk(
+		bufio.NewReader(strings.NewReader(badAge)))
+	require.Equal(t, io.EOF, err)
+	require.Error(t, NewCSVChunker(mapping, "", 0).Parse(chunk))
+}
+
+func TestCSVMappingValidation(t *testing.T) {
+	invalid := []string{
+		`{"files": [{"file": "a.csv", "columns": []}]}`,
+		`{"files": [{"file": "a.csv", "xid": "id", "xid_ref": "b"}]}`,
+		`{"files": [{"file": "a.csv", "xid": "id",
+			"columns": [{"column": "b", "predicate": "b", "ref": "b"}]}]}`,
+		`{"files": [{"file": "a.csv", "xid": "id",
+			"columns": [{"column": "b", "predicate": "b", "type": "number"}]}]}`,
+		`{"files": [{"file": "a.csv", "xid": "id"}, {"file": "a.csv", "xid": "id"}]}`,
+		`{"files": [{"file": "a.csv", "xid": "id", "delimiter": "||"}]}`,
+	}
+	for _, mapping := range invalid {
+		_, err := readTestCSVMapping(t, mapping)
+		require.Error(t, err, mapping)
+	}
+}
+
+func TestCSVGraphQLSchema(t *testing.T) {
+	parser := NewCSVChunker(nil, "", 0)
+	require.NoError(t, parser.Parse(GraphQLSchemaCSVChunk("type A {\n\tb: String\n}")))
+	parser.NQuads().Flush()
+	nqs := <-parser.NQuads().Ch()
+	require.Len(t, nqs, 3)
+	require.Equal(t, "dgraph.graphql:dgraph.graphql.schema", nqs[0].Subject)
+	require.Equal(t, "dgraph.type", nqs[0].Predicate)
+	require.Equal(t, "type A {\n\tb: String\n}", nqs[2].ObjectValue.GetStrVal())
+}
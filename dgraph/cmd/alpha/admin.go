@@ -139,7 +139,7 @@ func exportHandler(w http.ResponseWriter, r *http.Request, adminServer web.IServ
 			}
 		  }
 		}`,
-		Variables: map[string]interface{}{},
+		Variables: map[string]interface{}{"format": format},
 	}
 	resp := resolveWithAdminServer(gqlReq, r, adminServer)
 	if len(resp.Errors) != 0 {
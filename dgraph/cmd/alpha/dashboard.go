@@ -116,6 +116,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"uid",
 		"within",
 		"upsert",
+		"unique",
 	}
 
 	for _, w := range predefined {
@@ -158,6 +158,11 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	isExplain, err := parseBool(r, x.ExplainKey)
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 	queryTimeout, err := parseDuration(r, "timeout")
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
@@ -208,6 +213,15 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := context.WithValue(r.Context(), query.DebugKey, isDebugMode)
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
+	ctx = x.AttachAsOf(ctx, r)
+	ctx = x.AttachPersistedQuery(ctx, r)
+	// The plans chosen for the query and the profiles of its blocks are returned in the
+	// extensions in debug or explain mode.
+	var explain *query.Explain
+	if isDebugMode || isExplain {
+		explain = &query.Explain{}
+		ctx = context.WithValue(ctx, query.ExplainKey, explain)
+	}
 
 	if queryTimeout != 0 {
 		var cancel context.CancelFunc
@@ -258,6 +272,10 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		Latency: resp.Latency,
 		Metrics: resp.Metrics,
 	}
+	if explain != nil {
+		e.Plan = explain.Plans()
+		e.Profile = explain.Profiles()
+	}
 	js, err := json.Marshal(e)
 	if err != nil {
 		x.SetStatusWithData(w, x.Error, err.Error())
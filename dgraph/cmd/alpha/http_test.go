@@ -842,6 +842,159 @@ func TestDebugSupport(t *testing.T) {
 	require.Empty(t, resp.Header.Get("Content-Encoding"))
 }
 
+func TestQueryExplain(t *testing.T) {
+	require.NoError(t, dropAll())
+	require.NoError(t, alterSchema(`
+		name: string @index(exact) .
+		email: string @index(exact) .`))
+
+	m1 := `
+	{
+	  set {
+		_:a <name> "Alice" .
+		_:b <name> "Bob" .
+		_:b <email> "bob@dgraph.io" .
+		_:c <name> "Charlie" .
+		_:c <email> "charlie@dgraph.io" .
+	  }
+	}
+	`
+	require.NoError(t, runMutation(m1))
+
+	q1 := `
+	{
+	  users(func: has(name)) @filter(has(email) AND eq(email, "bob@dgraph.io")) {
+	    name
+	  }
+	}
+	`
+	_, body, err := runWithRetries("POST", "application/graphql+-",
+		addr+"/query?explain=true", q1)
+	require.NoError(t, err)
+
+	var r res
+	require.NoError(t, json.Unmarshal(body, &r))
+	require.JSONEq(t, `{"users": [{"name": "Bob"}]}`, string(r.Data))
+	require.NotNil(t, r.Extensions)
+	require.Equal(t, []*query.QueryPlan{{
+		Block:   "users",
+		Root:    query.PlanStep{Func: `eq(email, "bob@dgraph.io")`, Estimate: 1},
+		Swapped: true,
+		Filters: []query.PlanStep{
+			{Func: "has(email)", Estimate: -1},
+			{Func: "has(name)", Estimate: -1},
+		},
+	}}, r.Extensions.Plan)
+
+	require.Len(t, r.Extensions.Profile, 1)
+	root := r.Extensions.Profile[0]
+	require.Equal(t, "users", root.Alias)
+	require.Equal(t, `eq(email, "bob@dgraph.io")`, root.Func)
+	require.Equal(t, "index", root.Path)
+	require.NotZero(t, root.Group)
+	require.Equal(t, 1, root.UidsOut)
+	require.True(t, root.TimeNs >= root.TaskNs)
+
+	require.Len(t, root.Filters, 1)
+	and := root.Filters[0]
+	require.Equal(t, "and", and.FilterOp)
+	require.Len(t, and.Filters, 2)
+	for _, f := range and.Filters {
+		require.Equal(t, "data", f.Path)
+		require.Equal(t, 1, f.UidsIn)
+		require.Equal(t, 1, f.UidsOut)
+	}
+
+	require.Len(t, root.Children, 1)
+	name := root.Children[0]
+	require.Equal(t, "name", name.Attr)
+	require.Equal(t, "data", name.Path)
+	require.Equal(t, 1, name.UidsIn)
+
+	// Without explain, neither the plan nor the profile is returned.
+	_, body, err = runWithRetries("POST", "application/graphql+-", addr+"/query", q1)
+	require.NoError(t, err)
+	r = res{}
+	require.NoError(t, json.Unmarshal(body, &r))
+	require.JSONEq(t, `{"users": [{"name": "Bob"}]}`, string(r.Data))
+	require.Empty(t, r.Extensions.Plan)
+	require.Empty(t, r.Extensions.Profile)
+}
+
+func TestPersistedQuery(t *testing.T) {
+	require.NoError(t, dropAll())
+	require.NoError(t, alterSchema(`name: string @index(exact) .`))
+	require.NoError(t, runMutation(`
+	{
+	  set {
+		_:a <name> "Alice" .
+		_:b <name> "Bob" .
+	  }
+	}
+	`))
+
+	grootJwt, _ := testutil.GrootHttpLogin(addr + "/admin")
+	addQuery := func(query string) {
+		resp := testutil.MakeGQLRequestWithAccessJwt(t, &testutil.GraphQLParams{
+			Query: `mutation add($query: String!) {
+				addPersistedQuery(input: {id: "byName", query: $query}) {
+					persistedQuery {
+						id
+					}
+				}
+			}`,
+			Variables: map[string]interface{}{"query": query},
+		}, grootJwt)
+		resp.RequireNoGraphQLErrors(t)
+		require.JSONEq(t, `{"addPersistedQuery":{"persistedQuery":{"id":"byName"}}}`,
+			string(resp.Data))
+	}
+	runQuery := func(name string) (string, error) {
+		_, body, err := runWithRetries("POST", "application/json",
+			addr+"/query?persisted_query=byName", `{"variables": {"$name": "`+name+`"}}`)
+		if err != nil {
+			return "", err
+		}
+		var r res
+		require.NoError(t, json.Unmarshal(body, &r))
+		return string(r.Data), nil
+	}
+
+	addQuery(`query q($name: string!) { q(func: eq(name, $name)) { name } }`)
+	data, err := runQuery("Alice")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"q": [{"name": "Alice"}]}`, data)
+	data, err = runQuery("Bob")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"q": [{"name": "Bob"}]}`, data)
+
+	// Registering a query under the same id replaces it.
+	addQuery(`query q($name: string!) { bob(func: eq(name, $name)) { name } }`)
+	data, err = runQuery("Bob")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"bob": [{"name": "Bob"}]}`, data)
+
+	// A query can't be given along with a persisted query.
+	_, _, err = runWithRetries("POST", "application/graphql+-",
+		addr+"/query?persisted_query=byName", `{ q(func: has(name)) { name } }`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a query can't be given along with a persisted query")
+
+	resp := testutil.MakeGQLRequestWithAccessJwt(t, &testutil.GraphQLParams{
+		Query: `mutation {
+			deletePersistedQuery(id: "byName") {
+				response {
+					code
+				}
+			}
+		}`,
+	}, grootJwt)
+	resp.RequireNoGraphQLErrors(t)
+	_, err = runQuery("Bob")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `persisted query "byName" not found`)
+}
+
 func TestHealth(t *testing.T) {
 	url := fmt.Sprintf("%s/health", addr)
 	resp, err := http.Get(url)
@@ -170,11 +170,20 @@ they form a Raft group and provide synchronous replication.
 	flag.Uint64("query_edge_limit", 1e6,
 		"Limit for the maximum number of edges that can be returned in a query."+
 			" This applies to shortest path and recursive queries.")
+	flag.Uint64("shortest_frontier_limit", 1e5,
+		"Limit for the maximum number of nodes in the frontier of the breadth first search used"+
+			" by unweighted shortest path queries.")
 	flag.Uint64("normalize_node_limit", 1e4,
 		"Limit for the maximum number of nodes that can be returned in a query that uses the "+
 			"normalize directive.")
 	flag.Uint64("mutations_nquad_limit", 1e6,
 		"Limit for the maximum number of nquads that can be inserted in a mutation request")
+	flag.Int("query_cache_mb", 0,
+		"Size of the cache of query results in MB. The result of a query is served from the"+
+			" cache until a commit changes the predicates it read. Zero disables the cache.")
+	flag.Bool("persisted_queries_only", false,
+		"If true, clients can only run the DQL queries registered as persisted queries through"+
+			" the /admin GraphQL endpoint. Mutations without a query are still allowed.")
 
 	// TLS configurations
 	flag.String("tls_dir", "", "Path to directory that has TLS certificates and keys.")
@@ -198,6 +207,13 @@ they form a Raft group and provide synchronous replication.
 	flag.Bool("graphql_extensions", true, "Set to false if extensions not required in GraphQL response body")
 	flag.Duration("graphql_poll_interval", time.Second, "polling interval for graphql subscription.")
 
+	// Change data capture
+	flag.String("cdc", "",
+		"Destination of the change data capture stream of committed mutations. Each group leader "+
+			"writes JSON lines events to it, and resumes from the checkpoint stored there after a "+
+			"leader change. Commits are held back while the sink is too far behind. "+
+			"Supported: file:///path/to/dir. Empty value disables CDC.")
+
 	// Cache flags
 	flag.String("cache_percentage", "0,65,35,0",
 		`Cache percentages summing up to 100 for various caches (FORMAT:
@@ -617,6 +633,7 @@ func run() {
 		MutationsMode:  worker.AllowMutations,
 		AuthToken:      Alpha.Conf.GetString("auth_token"),
 		AllottedMemory: Alpha.Conf.GetFloat64("lru_mb"),
+		CDCSink:        Alpha.Conf.GetString("cdc"),
 	}
 
 	opts.BadgerTables = Alpha.Conf.GetString("badger.tables")
@@ -685,8 +702,11 @@ func run() {
 	x.Init()
 	x.Config.PortOffset = Alpha.Conf.GetInt("port_offset")
 	x.Config.QueryEdgeLimit = cast.ToUint64(Alpha.Conf.GetString("query_edge_limit"))
+	x.Config.ShortestFrontierLimit = cast.ToUint64(Alpha.Conf.GetString("shortest_frontier_limit"))
 	x.Config.NormalizeNodeLimit = cast.ToInt(Alpha.Conf.GetString("normalize_node_limit"))
 	x.Config.MutationsNQuadLimit = cast.ToInt(Alpha.Conf.GetString("mutations_nquad_limit"))
+	x.Config.QueryCacheMB = Alpha.Conf.GetInt("query_cache_mb")
+	x.Config.PersistedQueriesOnly = Alpha.Conf.GetBool("persisted_queries_only")
 	x.Config.PollInterval = Alpha.Conf.GetDuration("graphql_poll_interval")
 	x.Config.GraphqlExtension = Alpha.Conf.GetBool("graphql_extensions")
 	x.Config.GraphqlDebug = Alpha.Conf.GetBool("graphql_debug")
@@ -748,7 +768,7 @@ func run() {
 		}
 	}()
 
-	updaters := z.NewCloser(4)
+	updaters := z.NewCloser(5)
 	go func() {
 		worker.StartRaftNodes(worker.State.WALstore, bindall)
 		atomic.AddUint32(&initDone, 1)
@@ -771,6 +791,8 @@ func run() {
 	}()
 	// Listen for any new cors origin update.
 	go listenForCorsUpdate(updaters)
+	// Listen for any change to the persisted queries.
+	go listenForPersistedQueryUpdate(updaters)
 
 	// Graphql subscribes to alpha to get schema updates. We need to close that before we
 	// close alpha. This closer is for closing and waiting that subscription.
@@ -836,3 +858,16 @@ func listenForCorsUpdate(closer *z.Closer) {
 		x.UpdateCorsOrigins(origins)
 	}, 1, closer)
 }
+
+// listenForPersistedQueryUpdate listens for any change to the persisted queries, and clears
+// the persisted queries cached by this Alpha, as they might have been changed through another
+// Alpha.
+func listenForPersistedQueryUpdate(closer *z.Closer) {
+	prefix := x.DataKey("dgraph.dql.query", 0)
+	// Remove uid from the key, to get the correct prefix
+	prefix = prefix[:len(prefix)-8]
+	worker.SubscribeForUpdates([][]byte{prefix}, func(kvs *badgerpb.KVList) {
+		glog.Infof("Persisted queries changed, clearing the cached persisted queries.")
+		edgraph.ResetPersistedQueries()
+	}, 1, closer)
+}
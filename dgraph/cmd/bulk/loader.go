@@ -49,6 +49,7 @@ type options struct {
 	DataFormat       string
 	SchemaFile       string
 	GqlSchemaFile    string
+	CSVMapping       string
 	OutDir           string
 	ReplaceOutDir    bool
 	TmpDir           string
@@ -90,7 +91,8 @@ type state struct {
 	schema        *schemaStore
 	shards        *shardMap
 	readerChunkCh chan *bytes.Buffer
-	mapFileId     uint32 // Used atomically to name the output files of the mappers.
+	csvMapping    *chunker.CSVMapping // Only set when loading CSV files.
+	mapFileId     uint32              // Used atomically to name the output files of the mappers.
 	dbs           []*badger.DB
 	tmpDbs        []*badger.DB // Temporary DB to write the split lists to avoid ordering issues.
 	writeTs       uint64       // All badger writes use this timestamp
@@ -187,21 +189,31 @@ func (ld *loader) mapStage() {
 	}
 	ld.xids = xidmap.New(ld.zero, db)
 
-	files := x.FindDataFiles(ld.opt.DataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz"})
+	files := x.FindDataFiles(ld.opt.DataFiles,
+		[]string{".rdf", ".rdf.gz", ".json", ".json.gz", ".csv", ".csv.gz"})
 	if len(files) == 0 {
 		fmt.Printf("No data files found in %s.\n", ld.opt.DataFiles)
 		os.Exit(1)
 	}
 
 	// Because mappers must handle chunks that may be from different input files, they must all
-	// assume the same data format, either RDF or JSON. Use the one specified by the user or by
-	// the first load file.
+	// assume the same data format, either RDF, JSON or CSV. Use the one specified by the user or
+	// by the first load file.
 	loadType := chunker.DataFormat(files[0], ld.opt.DataFormat)
 	if loadType == chunker.UnknownFormat {
 		// Dont't try to detect JSON input in bulk loader.
-		fmt.Printf("Need --format=rdf or --format=json to load %s", files[0])
+		fmt.Printf("Need --format=rdf, --format=json or --format=csv to load %s\n", files[0])
 		os.Exit(1)
 	}
+	if loadType == chunker.CsvFormat {
+		if ld.opt.CSVMapping == "" {
+			fmt.Printf("Need --csv_mapping to load %s\n", files[0])
+			os.Exit(1)
+		}
+		var err error
+		ld.csvMapping, err = chunker.ReadCSVMapping(ld.opt.CSVMapping)
+		x.Check(err)
+	}
 
 	var mapperWg sync.WaitGroup
 	mapperWg.Add(len(ld.mappers))
@@ -228,7 +240,7 @@ func (ld *loader) mapStage() {
 			r, cleanup := chunker.FileReader(file, key)
 			defer cleanup()
 
-			chunk := chunker.NewChunker(loadType, 1000)
+			chunk := ld.newChunker(loadType, file)
 			for {
 				chunkBuf, err := chunk.Chunk(r)
 				if chunkBuf != nil && chunkBuf.Len() > 0 {
@@ -302,10 +314,21 @@ func (ld *loader) processGqlSchema(loadType chunker.InputFormat) {
 		x.Check2(gqlBuf.Write([]byte(fmt.Sprintf(rdfSchema, schema))))
 	case chunker.JsonFormat:
 		x.Check2(gqlBuf.Write([]byte(fmt.Sprintf(jsonSchema, schema))))
+	case chunker.CsvFormat:
+		gqlBuf = chunker.GraphQLSchemaCSVChunk(string(buf))
 	}
 	ld.readerChunkCh <- gqlBuf
 }
 
+// newChunker returns a chunker for the given format. The file is only needed to chunk CSV
+// files, whose mapping depends on the file.
+func (st *state) newChunker(loadType chunker.InputFormat, file string) chunker.Chunker {
+	if loadType == chunker.CsvFormat {
+		return chunker.NewCSVChunker(st.csvMapping, file, 1000)
+	}
+	return chunker.NewChunker(loadType, 1000)
+}
+
 func (ld *loader) reduceStage() {
 	ld.prog.setPhase(reducePhase)
 
@@ -219,7 +219,7 @@ func (m *mapper) writeMapEntriesToFile(cbuf *z.Buffer, shardIdx int) {
 }
 
 func (m *mapper) run(inputFormat chunker.InputFormat) {
-	chunk := chunker.NewChunker(inputFormat, 1000)
+	chunk := m.newChunker(inputFormat, "")
 	nquads := chunk.NQuads()
 	go func() {
 		for chunkBuf := range m.readerChunkCh {
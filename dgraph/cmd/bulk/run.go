@@ -53,12 +53,15 @@ func init() {
 
 	flag := Bulk.Cmd.Flags()
 	flag.StringP("files", "f", "",
-		"Location of *.rdf(.gz) or *.json(.gz) file(s) to load.")
+		"Location of *.rdf(.gz), *.json(.gz) or *.csv(.gz) file(s) to load.")
 	flag.StringP("schema", "s", "",
 		"Location of schema file.")
 	flag.StringP("graphql_schema", "g", "", "Location of the GraphQL schema file.")
 	flag.String("format", "",
-		"Specify file format (rdf or json) instead of getting it from filename.")
+		"Specify file format (rdf, json or csv) instead of getting it from filename.")
+	flag.String("csv_mapping", "",
+		"Location of the JSON file describing how the columns of CSV files map to predicates "+
+			"and edges. Required to load CSV files.")
 	flag.Bool("encrypted", false,
 		"Flag to indicate whether schema and data files are encrypted. "+
 			"Must be specified with --encryption_key_file or vault option(s).")
@@ -125,6 +128,7 @@ func run() {
 		DataFormat:       Bulk.Conf.GetString("format"),
 		SchemaFile:       Bulk.Conf.GetString("schema"),
 		GqlSchemaFile:    Bulk.Conf.GetString("graphql_schema"),
+		CSVMapping:       Bulk.Conf.GetString("csv_mapping"),
 		Encrypted:        Bulk.Conf.GetBool("encrypted"),
 		EncryptedOut:     Bulk.Conf.GetBool("encrypted_out"),
 		OutDir:           Bulk.Conf.GetString("out"),
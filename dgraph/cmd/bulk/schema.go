@@ -69,9 +69,21 @@ func newSchemaStore(initial *schema.ParsedSchema, opt *options, state *state) *s
 			fmt.Printf("Predicate %q already exists in schema\n", p)
 			continue
 		}
+		if sch.Unique {
+			fmt.Printf("The values of predicate %q aren't checked by the bulk loader, so @unique "+
+				"is dropped. It can be added again once the data is loaded.\n", p)
+			sch.Unique = false
+		}
 		s.schemaMap[p] = sch
 	}
 
+	for _, typ := range initial.Types {
+		if len(typ.Indexes) > 0 {
+			fmt.Printf("Composite indexes of type %q are not built by the bulk loader and are "+
+				"dropped. They can be declared again once the data is loaded.\n", typ.TypeName)
+			typ.Indexes = nil
+		}
+	}
 	s.types = initial.Types
 
 	return s
@@ -34,6 +34,7 @@ import (
 	"github.com/dgraph-io/badger/v2"
 	"github.com/dgraph-io/dgo/v200"
 	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/chunker"
 	"github.com/dgraph-io/dgraph/dgraph/cmd/zero"
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -89,6 +90,8 @@ type loader struct {
 	reqs     chan *request
 	zeroconn *grpc.ClientConn
 	schema   *schema
+	// Mapping of the CSV files to N-Quads, if any.
+	csvMapping *chunker.CSVMapping
 
 	upsertLock sync.RWMutex
 }
@@ -326,7 +329,13 @@ func (l *loader) conflictKeysForNQuad(nq *api.NQuad) ([]uint64, error) {
 		}
 
 		for _, t := range toks {
-			keys = append(keys, farm.Fingerprint64(x.IndexKey(nq.Predicate, t))^sid)
+			key := farm.Fingerprint64(x.IndexKey(nq.Predicate, t))
+			if pred.Unique {
+				// Different nodes getting the same value of a @unique predicate must conflict.
+				keys = append(keys, key)
+				continue
+			}
+			keys = append(keys, key^sid)
 		}
 
 	}
@@ -73,6 +73,7 @@ type options struct {
 	bufferSize      int
 	ludicrousMode   bool
 	upsertPredicate string
+	csvMapping      string
 	key             x.SensitiveByteSlice
 }
 
@@ -87,6 +88,7 @@ type predicate struct {
 	Upsert     bool     `json:"upsert,omitempty"`
 	Reverse    bool     `json:"reverse,omitempty"`
 	NoConflict bool     `json:"no_conflict,omitempty"`
+	Unique     bool     `json:"unique,omitempty"`
 	ValueType  types.TypeID
 }
 
@@ -131,10 +133,13 @@ func init() {
 	Live.EnvPrefix = "DGRAPH_LIVE"
 
 	flag := Live.Cmd.Flags()
-	flag.StringP("files", "f", "", "Location of *.rdf(.gz) or *.json(.gz) file(s) to load")
+	flag.StringP("files", "f", "", "Location of *.rdf(.gz), *.json(.gz) or *.csv(.gz) file(s) "+
+		"to load")
 	flag.StringP("schema", "s", "", "Location of schema file")
-	flag.String("format", "", "Specify file format (rdf or json) instead of getting it "+
+	flag.String("format", "", "Specify file format (rdf, json or csv) instead of getting it "+
 		"from filename")
+	flag.String("csv_mapping", "", "Location of the JSON file describing how the columns of "+
+		"CSV files map to predicates and edges. Required to load CSV files.")
 	flag.StringP("alpha", "a", "127.0.0.1:9080",
 		"Comma-separated list of Dgraph alpha gRPC server addresses")
 	flag.StringP("zero", "z", "127.0.0.1:5080", "Dgraph zero gRPC server address")
@@ -416,6 +421,13 @@ func (l *loader) processFile(ctx context.Context, filename string, key x.Sensiti
 			}
 		}
 	}
+	if loadType == chunker.CsvFormat {
+		if l.csvMapping == nil {
+			return errors.Errorf("need --csv_mapping to load %s", filename)
+		}
+		return l.processLoadFile(ctx, rd,
+			chunker.NewCSVChunker(l.csvMapping, filename, opt.batchSize))
+	}
 
 	return l.processLoadFile(ctx, rd, chunker.NewChunker(loadType, opt.batchSize))
 }
@@ -595,6 +607,7 @@ func run() error {
 		bufferSize:      Live.Conf.GetInt("bufferSize"),
 		ludicrousMode:   Live.Conf.GetBool("ludicrous_mode"),
 		upsertPredicate: Live.Conf.GetString("upsertPredicate"),
+		csvMapping:      Live.Conf.GetString("csv_mapping"),
 	}
 	if opt.key, err = enc.ReadKey(Live.Conf); err != nil {
 		fmt.Printf("unable to read key %v", err)
@@ -641,10 +654,16 @@ func run() error {
 	}
 
 	if opt.dataFiles == "" {
-		return errors.New("RDF or JSON file(s) location must be specified")
+		return errors.New("RDF, JSON or CSV file(s) location must be specified")
+	}
+	if opt.csvMapping != "" {
+		if l.csvMapping, err = chunker.ReadCSVMapping(opt.csvMapping); err != nil {
+			return err
+		}
 	}
 
-	filesList := x.FindDataFiles(opt.dataFiles, []string{".rdf", ".rdf.gz", ".json", ".json.gz"})
+	filesList := x.FindDataFiles(opt.dataFiles,
+		[]string{".rdf", ".rdf.gz", ".json", ".json.gz", ".csv", ".csv.gz"})
 	totalFiles := len(filesList)
 	if totalFiles == 0 {
 		return errors.Errorf("No data files found in %s", opt.dataFiles)
@@ -0,0 +1,208 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A checkpoint records the progress of a dump. The tables are dumped in alphabetical order,
+// first their values and then their constraints, so the progress of each table in each of
+// the two passes is enough to resume the dump.
+type checkpoint struct {
+	// Since is the --since option of the dump, which has to be the same when resuming
+	Since string `json:"since,omitempty"`
+	// Shard is the index of the next shard to write
+	Shard       int                       `json:"shard"`
+	Values      map[string]*tableProgress `json:"values"`
+	Constraints map[string]*tableProgress `json:"constraints"`
+}
+
+// tableProgress is the progress of one of the passes over a table.
+type tableProgress struct {
+	// Rows is the number of rows read so far
+	Rows int `json:"rows"`
+	// LastKey holds the values of the primary key columns of the last row read, if the table
+	// has a primary key
+	LastKey []string `json:"last_key,omitempty"`
+	Done    bool     `json:"done"`
+}
+
+func newCheckpoint(since string) *checkpoint {
+	return &checkpoint{
+		Since:       since,
+		Values:      make(map[string]*tableProgress),
+		Constraints: make(map[string]*tableProgress),
+	}
+}
+
+// readCheckpoint reads the checkpoint saved in the file, or returns a new checkpoint if the file
+// does not exist.
+func readCheckpoint(file string, since string) (*checkpoint, error) {
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return newCheckpoint(since), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := newCheckpoint("")
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, errors.Wrapf(err, "while reading checkpoint file %s", file)
+	}
+	if c.Since != since {
+		return nil, errors.Errorf("the checkpoint file %s was saved by a dump with --since %q",
+			file, c.Since)
+	}
+	return c, nil
+}
+
+// save writes the checkpoint to a temporary file, which then replaces the file, so that the
+// file always holds a complete checkpoint.
+func (c *checkpoint) save(file string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpFile := file + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, file)
+}
+
+func (c *checkpoint) progress(pass map[string]*tableProgress, table string) *tableProgress {
+	progress, ok := pass[table]
+	if !ok {
+		progress = &tableProgress{}
+		pass[table] = progress
+	}
+	return progress
+}
+
+// rdfShards writes the RDF output to a sequence of gzipped files, each of which holds the
+// entries generated for up to rowsPerShard table rows.
+type rdfShards struct {
+	output       string
+	rowsPerShard int
+
+	rows int // the number of rows in the current shard
+	file *os.File
+	gz   *gzip.Writer
+}
+
+// shardName returns the name of the n-th shard, which is the output file name with the shard
+// index inserted before its extensions, e.g. sql-00001.rdf.gz for the output sql.rdf.
+func shardName(output string, n int) string {
+	dir, base := filepath.Split(strings.TrimSuffix(output, ".gz"))
+	ext := ""
+	if idx := strings.Index(base, "."); idx >= 0 {
+		base, ext = base[:idx], base[idx:]
+	}
+	return filepath.Join(dir, fmt.Sprintf("%s-%05d%s.gz", base, n, ext))
+}
+
+func (s *rdfShards) open(n int) (*bufio.Writer, error) {
+	file, err := os.OpenFile(shardName(s.output, n), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+	s.file = file
+	s.gz = gzip.NewWriter(file)
+	s.rows = 0
+	return bufio.NewWriter(s.gz), nil
+}
+
+func (s *rdfShards) close(w *bufio.Writer) error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := s.gz.Close(); err != nil {
+		return err
+	}
+	if err := s.file.Sync(); err != nil {
+		return err
+	}
+	return s.file.Close()
+}
+
+// startRow opens the next shard if the previous one is full. It is called before generating
+// the RDF entries of a row.
+func (m *dumpMeta) startRow() error {
+	if m.dataWriter != nil {
+		return nil
+	}
+	var err error
+	m.dataWriter, err = m.shards.open(m.checkpoint.Shard)
+	return err
+}
+
+// endRow closes the current shard and saves the checkpoint once the shard is full. It is
+// called after generating the RDF entries of a row.
+func (m *dumpMeta) endRow() error {
+	if m.shards == nil {
+		return nil
+	}
+	m.shards.rows++
+	if m.shards.rows < m.shards.rowsPerShard {
+		return nil
+	}
+	if err := m.shards.close(m.dataWriter); err != nil {
+		return errors.Wrapf(err, "while closing shard %s",
+			shardName(m.shards.output, m.checkpoint.Shard))
+	}
+	m.dataWriter = nil
+	m.checkpoint.Shard++
+	if len(m.checkpointFile) == 0 {
+		return nil
+	}
+	return m.checkpoint.save(m.checkpointFile)
+}
+
+// finishData flushes the data written so far, and removes the checkpoint file once the dump
+// is complete.
+func (m *dumpMeta) finishData() error {
+	if m.dataWriter != nil {
+		var err error
+		if m.shards == nil {
+			err = m.dataWriter.Flush()
+		} else {
+			err = m.shards.close(m.dataWriter)
+			m.dataWriter = nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if len(m.checkpointFile) == 0 {
+		return nil
+	}
+	if err := os.Remove(m.checkpointFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
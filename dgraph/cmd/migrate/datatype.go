@@ -16,6 +16,8 @@
 
 package migrate
 
+import "strings"
+
 const (
 	unknownType dataType = iota
 	intType
@@ -23,6 +25,7 @@ const (
 	floatType
 	doubleType
 	datetimeType
+	boolType
 	uidType // foreign key reference, which would corrspond to uid type in Dgraph
 )
 
@@ -32,6 +35,11 @@ var typeToString map[dataType]string
 // the sqlTypeToInternal map is used to parse date types in SQL schema
 var sqlTypeToInternal map[string]dataType
 
+// the postgresTypeToInternal map is used to parse the types of PostgreSQL columns, keyed by
+// the udt_name of the column type. Serial and identity columns use the int2, int4 and int8
+// types, and the element type of an array column is its udt_name without the leading _.
+var postgresTypeToInternal map[string]dataType
+
 func initDataTypes() {
 	typeToString = make(map[dataType]string)
 	typeToString[unknownType] = "unknown"
@@ -40,6 +48,7 @@ func initDataTypes() {
 	typeToString[floatType] = "float"
 	typeToString[doubleType] = "double"
 	typeToString[datetimeType] = "datetime"
+	typeToString[boolType] = "bool"
 	typeToString[uidType] = "uid"
 
 	sqlTypeToInternal = make(map[string]dataType)
@@ -53,6 +62,45 @@ func initDataTypes() {
 	sqlTypeToInternal["float"] = floatType
 	sqlTypeToInternal["double"] = doubleType
 	sqlTypeToInternal["decimal"] = floatType
+
+	postgresTypeToInternal = make(map[string]dataType)
+	for _, name := range []string{"int2", "int4", "int8"} {
+		postgresTypeToInternal[name] = intType
+	}
+	for _, name := range []string{"float4", "float8", "numeric"} {
+		postgresTypeToInternal[name] = floatType
+	}
+	// json and jsonb values are kept as their JSON text
+	for _, name := range []string{"varchar", "bpchar", "text", "uuid", "json", "jsonb"} {
+		postgresTypeToInternal[name] = stringType
+	}
+	for _, name := range []string{"date", "time", "timetz", "timestamp", "timestamptz"} {
+		postgresTypeToInternal[name] = datetimeType
+	}
+	postgresTypeToInternal["bool"] = boolType
+}
+
+// getSQLiteDataType infers the data type of a SQLite column from its declared type, using
+// rules similar to the ones SQLite uses to determine the type affinity of a column.
+func getSQLiteDataType(declType string) dataType {
+	declType = strings.ToUpper(declType)
+	switch {
+	case strings.Contains(declType, "INT"):
+		return intType
+	case strings.Contains(declType, "CHAR"), strings.Contains(declType, "CLOB"),
+		strings.Contains(declType, "TEXT"):
+		return stringType
+	case strings.Contains(declType, "DATE"), strings.Contains(declType, "TIME"):
+		return datetimeType
+	case strings.Contains(declType, "BOOL"):
+		return boolType
+	case strings.Contains(declType, "REAL"), strings.Contains(declType, "FLOA"),
+		strings.Contains(declType, "DOUB"), strings.Contains(declType, "NUMERIC"),
+		strings.Contains(declType, "DECIMAL"):
+		return floatType
+	default:
+		return unknownType
+	}
 }
 
 func (t dataType) String() string {
@@ -20,8 +20,10 @@ import (
 	"bufio"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -30,13 +32,30 @@ import (
 // all the tables' generation guide,
 // the writer to output the generated RDF entries,
 // the writer to output the Dgraph schema,
-// and a sqlPool to read information from MySQL
+// a sqlPool to read information from the SQL database,
+// and the source that knows how to query the SQL database
 type dumpMeta struct {
 	tableInfos   map[string]*sqlTable
 	tableGuides  map[string]*tableGuide
 	dataWriter   *bufio.Writer
 	schemaWriter *bufio.Writer
 	sqlPool      *sql.DB
+	source       dbSource
+
+	// the number of rows read from a table with one query, 0 reads a table with one query
+	batchSize int
+	// if set, only the rows whose updatedAtColumn is later than since are dumped
+	since           string
+	updatedAtColumn string
+	// if set, the schema of the predicate storing the blank node labels of upserted nodes
+	// is added to the schema
+	upsertPredicate string
+
+	// if set, the data is written to gzipped shards instead of dataWriter's file
+	shards *rdfShards
+	// the progress of the dump, which is saved to checkpointFile if set
+	checkpoint     *checkpoint
+	checkpointFile string
 
 	buf strings.Builder // reusable buf for building strings, call buf.Reset before use
 }
@@ -61,105 +80,295 @@ func (m *dumpMeta) dumpSchema() error {
 			}
 		}
 	}
+	if len(m.upsertPredicate) > 0 {
+		// dgraph live --upsertPredicate looks up the nodes by their blank node labels
+		// stored in this predicate
+		if _, err := fmt.Fprintf(m.schemaWriter, "%s: string @index(exact) @upsert .\n",
+			m.upsertPredicate); err != nil {
+			return errors.Wrapf(err, "while writing schema")
+		}
+	}
 	return m.schemaWriter.Flush()
 }
 
 // dumpTables goes through all the tables twice. In the first time it generates RDF entries for the
 // column values. In the second time, it follows the foreign key constraints in SQL tables, and
 // generate the corresponding Dgraph edges.
+// The tables are processed in alphabetical order, and the progress within the current table is
+// kept in m.checkpoint, so that the dump can be resumed from the last completed shard.
 func (m *dumpMeta) dumpTables() error {
-	for table := range m.tableInfos {
+	if m.checkpoint == nil {
+		m.checkpoint = newCheckpoint(m.since)
+	}
+	tables := make([]string, 0, len(m.tableInfos))
+	for table, tableInfo := range m.tableInfos {
+		tables = append(tables, table)
+		// populate the predNames
+		for _, column := range tableInfo.columnNames {
+			tableInfo.predNames = append(tableInfo.predNames,
+				predicateName(tableInfo, column))
+		}
+	}
+	sort.Strings(tables)
+
+	filters := make(map[string]bool)
+	for _, table := range tables {
+		filter, skip := m.sinceFilter(m.tableInfos[table])
+		if skip {
+			m.checkpoint.progress(m.checkpoint.Values, table).Done = true
+			m.checkpoint.progress(m.checkpoint.Constraints, table).Done = true
+		}
+		filters[table] = filter
+	}
+
+	// recorded tracks the tables whose valuesRecorder has seen all of their rows
+	recorded := make(map[string]bool)
+	for _, table := range tables {
+		progress := m.checkpoint.progress(m.checkpoint.Values, table)
+		if progress.Done {
+			continue
+		}
 		fmt.Printf("Dumping table %s\n", table)
-		if err := m.dumpTable(table); err != nil {
+		complete := progress.Rows == 0 && !filters[table]
+		if err := m.dumpTable(table, progress, filters[table]); err != nil {
 			return errors.Wrapf(err, "while dumping table %s", table)
 		}
+		recorded[table] = complete
 	}
 
-	for table := range m.tableInfos {
+	for _, table := range tables {
+		progress := m.checkpoint.progress(m.checkpoint.Constraints, table)
+		if progress.Done || len(m.tableInfos[table].foreignKeyConstraints) == 0 {
+			continue
+		}
+		// the rows of the referenced tables have to be recorded before the constraints can
+		// be followed, which might not be the case if they were skipped or filtered above
+		for dstTable := range m.tableInfos[table].dstTables {
+			if recorded[dstTable] {
+				continue
+			}
+			fmt.Printf("Reading referenced table %s\n", dstTable)
+			if err := m.recordTable(dstTable); err != nil {
+				return errors.Wrapf(err, "while reading table %s", dstTable)
+			}
+			recorded[dstTable] = true
+		}
+
 		fmt.Printf("Dumping table constraints %s\n", table)
-		if err := m.dumpTableConstraints(table); err != nil {
+		if err := m.dumpTableConstraints(table, progress, filters[table]); err != nil {
 			return errors.Wrapf(err, "while dumping table %s", table)
 		}
 	}
 
-	return m.dataWriter.Flush()
+	return m.finishData()
+}
+
+// sinceFilter returns whether only the rows of the table that changed since m.since should be
+// dumped, and whether the table should be skipped altogether, because its changed rows cannot
+// be told apart or cannot be upserted.
+func (m *dumpMeta) sinceFilter(tableInfo *sqlTable) (bool, bool) {
+	if len(m.since) == 0 {
+		return false, false
+	}
+	if _, ok := tableInfo.columns[m.updatedAtColumn]; !ok {
+		if !quiet {
+			logger.Printf("skipping table %s because it has no %s column\n",
+				tableInfo.tableName, m.updatedAtColumn)
+		}
+		return false, true
+	}
+	// the blank node labels of tables without primary keys come from a row counter, and
+	// would not match the nodes of previous runs
+	if _, ok := m.tableGuides[tableInfo.tableName].blankNode.(*usingCounter); ok {
+		if !quiet {
+			logger.Printf("skipping table %s because it has no primary key\n",
+				tableInfo.tableName)
+		}
+		return false, true
+	}
+	return true, false
 }
 
 // dumpTable converts the cells in a SQL table into RDF entries,
 // and sends entries to the m.dataWriter
-func (m *dumpMeta) dumpTable(table string) error {
+func (m *dumpMeta) dumpTable(table string, progress *tableProgress, filter bool) error {
 	tableGuide := m.tableGuides[table]
 	tableInfo := m.tableInfos[table]
 
-	query := fmt.Sprintf(`select %s from %s`, strings.Join(tableInfo.columnNames, ","), table)
-	rows, err := m.sqlPool.Query(query)
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
-	// populate the predNames
-	for _, column := range tableInfo.columnNames {
-		tableInfo.predNames = append(tableInfo.predNames,
-			predicateName(tableInfo, column))
-	}
-
 	row := &sqlRow{
 		tableInfo: tableInfo,
 	}
-
-	for rows.Next() {
+	return m.scanTable(tableInfo, progress, filter, func(colValues []interface{}) error {
 		// step 1: read the row's column values
-		colValues, err := getColumnValues(tableInfo.columnNames, tableInfo.columnDataTypes, rows)
-		if err != nil {
-			return err
-		}
 		row.values = colValues
 
 		// step 2: output the column values in RDF format
+		if err := m.startRow(); err != nil {
+			return err
+		}
 		row.blankNodeLabel = tableGuide.blankNode.generate(tableInfo, colValues)
 		m.outputRow(row, tableInfo)
 
 		// step 3: record mappings to the blankNodeLabel so that future tables can look up the
 		// blankNodeLabel
 		tableGuide.valuesRecorder.record(tableInfo, colValues, row.blankNodeLabel)
+		return m.endRow()
+	})
+}
+
+// recordTable reads all the rows of a table and records the mappings to their blank node
+// labels without generating any RDF entries.
+func (m *dumpMeta) recordTable(table string) error {
+	tableGuide := m.tableGuides[table]
+	tableInfo := m.tableInfos[table]
+
+	return m.scanTable(tableInfo, &tableProgress{}, false, func(colValues []interface{}) error {
+		blankNodeLabel := tableGuide.blankNode.generate(tableInfo, colValues)
+		tableGuide.valuesRecorder.record(tableInfo, colValues, blankNodeLabel)
+		return nil
+	})
+}
+
+// selectQuery returns the query that reads all the columns of a table
+func (m *dumpMeta) selectQuery(tableInfo *sqlTable) string {
+	columns := make([]string, 0, len(tableInfo.columnNames))
+	for _, column := range tableInfo.columnNames {
+		columns = append(columns, m.source.quote(column))
+	}
+	return fmt.Sprintf(`select %s from %s`, strings.Join(columns, ","), tableInfo.sqlName)
+}
+
+// scanQuery returns the query that reads the next batch of rows of a table, along with its
+// arguments. Tables with a primary key are paginated by the key values of the last row read,
+// the other tables by the number of rows read so far.
+func (m *dumpMeta) scanQuery(tableInfo *sqlTable, keyIndices []*columnIdx,
+	progress *tableProgress, filter bool) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	placeholder := func(arg interface{}) string {
+		args = append(args, arg)
+		return m.source.placeholder(len(args))
 	}
 
-	return nil
+	if filter {
+		conditions = append(conditions, fmt.Sprintf("%s > %s",
+			m.source.quote(m.updatedAtColumn), placeholder(m.since)))
+	}
+	keyColumns := make([]string, 0, len(keyIndices))
+	for _, columnIndex := range keyIndices {
+		keyColumns = append(keyColumns, m.source.quote(columnIndex.name))
+	}
+	if len(progress.LastKey) > 0 {
+		keyValues := make([]string, 0, len(progress.LastKey))
+		for _, value := range progress.LastKey {
+			keyValues = append(keyValues, placeholder(value))
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s) > (%s)",
+			strings.Join(keyColumns, ","), strings.Join(keyValues, ",")))
+	}
+
+	var buf strings.Builder
+	buf.WriteString(m.selectQuery(tableInfo))
+	if len(conditions) > 0 {
+		fmt.Fprintf(&buf, " where %s", strings.Join(conditions, " and "))
+	}
+	if len(keyColumns) > 0 {
+		fmt.Fprintf(&buf, " order by %s", strings.Join(keyColumns, ","))
+	}
+	if m.batchSize > 0 {
+		fmt.Fprintf(&buf, " limit %d", m.batchSize)
+		if len(keyColumns) == 0 && progress.Rows > 0 {
+			fmt.Fprintf(&buf, " offset %d", progress.Rows)
+		}
+	}
+	return buf.String(), args
+}
+
+// scanTable reads the rows of a table in batches of m.batchSize rows, starting after the
+// position recorded in progress, and calls fn with the values of each row. If filter is true,
+// only the rows that changed since m.since are read. The progress is updated before fn is
+// called on a row.
+// Tables without a primary key are paginated without an order, which relies on the database
+// returning their rows in the same order every time, as the row counters generating the blank
+// node labels of these tables already do.
+func (m *dumpMeta) scanTable(tableInfo *sqlTable, progress *tableProgress, filter bool,
+	fn func(colValues []interface{}) error) error {
+	keyIndices := getColumnIndices(tableInfo, func(info *sqlTable, column string) bool {
+		return info.columns[column].keyType == primary
+	})
+	// the row counter has to continue from the rows already read, so that the blank node
+	// labels are the same every time the table is read
+	if counter, ok := m.tableGuides[tableInfo.tableName].blankNode.(*usingCounter); ok {
+		counter.rowCounter = progress.Rows
+	}
+
+	for {
+		query, args := m.scanQuery(tableInfo, keyIndices, progress, filter)
+		count, err := m.scanRows(tableInfo, keyIndices, progress, query, args, fn)
+		if err != nil {
+			return err
+		}
+		if m.batchSize == 0 || count < m.batchSize {
+			progress.Done = true
+			return nil
+		}
+	}
+}
+
+func (m *dumpMeta) scanRows(tableInfo *sqlTable, keyIndices []*columnIdx,
+	progress *tableProgress, query string, args []interface{},
+	fn func(colValues []interface{}) error) (int, error) {
+	rows, err := m.sqlPool.Query(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		colValues, err := getColumnValues(tableInfo, rows)
+		if err != nil {
+			return 0, err
+		}
+		count++
+		progress.Rows++
+		if len(keyIndices) > 0 {
+			if progress.LastKey, err = getKeyValues(tableInfo, keyIndices,
+				colValues); err != nil {
+				return 0, err
+			}
+		}
+		if err := fn(colValues); err != nil {
+			return 0, err
+		}
+	}
+	return count, rows.Err()
 }
 
 // dumpTableConstraints reads data from a table, and then generate RDF entries
 // from a row to another row in a foreign table by following columns with foreign key constraints.
 // It then sends the generated RDF entries to the m.dataWriter
-func (m *dumpMeta) dumpTableConstraints(table string) error {
+func (m *dumpMeta) dumpTableConstraints(table string, progress *tableProgress,
+	filter bool) error {
 	tableGuide := m.tableGuides[table]
 	tableInfo := m.tableInfos[table]
 
-	query := fmt.Sprintf(`select %s from %s`, strings.Join(tableInfo.columnNames, ","), table)
-	rows, err := m.sqlPool.Query(query)
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
 	row := &sqlRow{
 		tableInfo: tableInfo,
 	}
-	for rows.Next() {
+	return m.scanTable(tableInfo, progress, filter, func(colValues []interface{}) error {
 		// step 1: read the row's column values
-		colValues, err := getColumnValues(tableInfo.columnNames, tableInfo.columnDataTypes, rows)
-		if err != nil {
-			return err
-		}
 		row.values = colValues
 
 		// step 2: output the constraints in RDF format
+		if err := m.startRow(); err != nil {
+			return err
+		}
 		row.blankNodeLabel = tableGuide.blankNode.generate(tableInfo, colValues)
 
 		m.outputConstraints(row, tableInfo)
-	}
-
-	return nil
+		return m.endRow()
+	})
 }
 
 // outputRow takes a row with its metadata as well as the table metadata, and
@@ -192,10 +401,18 @@ func (m *dumpMeta) dumpTableConstraints(table string) error {
 func (m *dumpMeta) outputRow(row *sqlRow, tableInfo *sqlTable) {
 	for i, colValue := range row.values {
 		colName := tableInfo.columnNames[i]
-		if !tableInfo.isForeignKey[colName] {
-			predicate := tableInfo.predNames[i]
-			m.outputPlainCell(row.blankNodeLabel, predicate, tableInfo.columnDataTypes[i], colValue)
+		if tableInfo.isForeignKey[colName] {
+			continue
+		}
+		predicate := tableInfo.predNames[i]
+		if tableInfo.columns[colName].isList {
+			// each element of an array is stored as one value of a list predicate
+			for _, elem := range colValue.(pq.StringArray) {
+				m.outputPlainCell(row.blankNodeLabel, predicate, stringType, elem)
+			}
+			continue
 		}
+		m.outputPlainCell(row.blankNodeLabel, predicate, tableInfo.columnDataTypes[i], colValue)
 	}
 }
 
@@ -0,0 +1,274 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func sortedLines(s string) []string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	sort.Strings(lines)
+	return lines
+}
+
+func newTestDB(t *testing.T, dir string) (dbSource, *sql.DB) {
+	src, err := getSource("sqlite")
+	require.NoError(t, err)
+	pool, err := src.open("", "", "", "", filepath.Join(dir, "test.db"))
+	if err == errNoSQLite {
+		t.Skip(err)
+	}
+	require.NoError(t, err)
+
+	for _, stmt := range []string{
+		`create table person (fname varchar(50), lname varchar(50), company text,
+			employee_id int, active boolean, primary key (fname, lname),
+			unique (company, employee_id))`,
+		`create table salary (person_company text, person_employee_id integer, salary real,
+			foreign key (person_company, person_employee_id)
+			references person (company, employee_id))`,
+		`create table boss (id integer primary key, p_fname text, p_lname text,
+			updated_at datetime, foreign key (p_fname, p_lname) references person)`,
+		`insert into person values ('John', 'Doe', 'Google', 100, 1)`,
+		`insert into salary values ('Google', 100, 50.5)`,
+		`insert into boss values (7, 'John', 'Doe', '2020-01-01 00:00:00')`,
+		`insert into boss values (8, 'John', 'Doe', '2020-06-01 00:00:00')`,
+	} {
+		_, err := pool.Exec(stmt)
+		require.NoError(t, err)
+	}
+	initDataTypes()
+	return src, pool
+}
+
+func newTestDumpMeta(t *testing.T, src dbSource, pool *sql.DB) *dumpMeta {
+	tableInfos, err := readTables(src, pool, []string{"boss", "person", "salary"}, "")
+	require.NoError(t, err)
+	return &dumpMeta{
+		tableInfos:      tableInfos,
+		tableGuides:     getTableGuides(tableInfos),
+		sqlPool:         pool,
+		source:          src,
+		updatedAtColumn: "updated_at",
+	}
+}
+
+// readShards returns the contents of the shards written by a dump, by shard index.
+func readShards(t *testing.T, output string) map[int]string {
+	shards := make(map[int]string)
+	for n := 0; n < 100; n++ {
+		f, err := os.Open(shardName(output, n))
+		if os.IsNotExist(err) {
+			continue
+		}
+		require.NoError(t, err)
+		gz, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(gz)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		shards[n] = string(data)
+	}
+	return shards
+}
+
+var testRDF = []string{
+	`_:boss.7 <boss.id> "7" .`,
+	`_:boss.7 <boss.p_fname.p_lname> _:person.John.Doe .`,
+	`_:boss.7 <boss.updated_at> "2020-01-01T00:00:00Z" .`,
+	`_:boss.8 <boss.id> "8" .`,
+	`_:boss.8 <boss.p_fname.p_lname> _:person.John.Doe .`,
+	`_:boss.8 <boss.updated_at> "2020-06-01T00:00:00Z" .`,
+	`_:person.John.Doe <person.active> "true" .`,
+	`_:person.John.Doe <person.company> "Google" .`,
+	`_:person.John.Doe <person.employee_id> "100" .`,
+	`_:person.John.Doe <person.fname> "John" .`,
+	`_:person.John.Doe <person.lname> "Doe" .`,
+	`_:salary.1 <salary.person_company.person_employee_id> _:person.John.Doe .`,
+	`_:salary.1 <salary.salary> "50.5" .`,
+}
+
+func TestMigrateSQLite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	src, pool := newTestDB(t, dir)
+	defer pool.Close()
+
+	tables, err := showTables(src, pool, "", "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"boss", "person", "salary"}, tables)
+
+	_, err = readTables(src, pool, []string{"salary"}, "")
+	require.Error(t, err)
+	_, err = readTables(src, pool, []string{"missing"}, "")
+	require.Error(t, err)
+
+	var schema, data bytes.Buffer
+	meta := newTestDumpMeta(t, src, pool)
+	meta.schemaWriter = bufio.NewWriter(&schema)
+	meta.dataWriter = bufio.NewWriter(&data)
+	meta.batchSize = 1
+	meta.upsertPredicate = "xid"
+	require.NoError(t, meta.dumpSchema())
+	require.NoError(t, meta.dumpTables())
+
+	require.Equal(t, []string{
+		"boss.id: int .",
+		"boss.p_fname.p_lname: [uid] .",
+		"boss.updated_at: datetime .",
+		"person.active: bool .",
+		"person.company: string .",
+		"person.employee_id: int .",
+		"person.fname: string .",
+		"person.lname: string .",
+		"salary.person_company.person_employee_id: [uid] .",
+		"salary.salary: float .",
+		"xid: string @index(exact) @upsert .",
+	}, sortedLines(schema.String()))
+	require.Equal(t, testRDF, sortedLines(data.String()))
+}
+
+func TestMigrateShardsAndCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	src, pool := newTestDB(t, dir)
+	defer pool.Close()
+
+	// every row goes to its own shard, in the order boss (2 rows), person, salary for the
+	// values, and boss (2 rows), salary for the constraints
+	output := filepath.Join(dir, "full", "sql.rdf")
+	require.NoError(t, os.Mkdir(filepath.Dir(output), 0700))
+	meta := newTestDumpMeta(t, src, pool)
+	meta.batchSize = 1
+	meta.shards = &rdfShards{output: output, rowsPerShard: 1}
+	meta.checkpointFile = filepath.Join(dir, "full.checkpoint")
+	require.NoError(t, meta.dumpTables())
+	_, err = os.Stat(meta.checkpointFile)
+	require.True(t, os.IsNotExist(err))
+
+	full := readShards(t, output)
+	require.Len(t, full, 7)
+	var all strings.Builder
+	for _, shard := range full {
+		all.WriteString(shard)
+	}
+	require.Equal(t, testRDF, sortedLines(all.String()))
+
+	// resume after the values of boss and person have been dumped, which requires reading
+	// the person table again to follow the foreign keys of boss
+	output = filepath.Join(dir, "resumed", "sql.rdf")
+	require.NoError(t, os.Mkdir(filepath.Dir(output), 0700))
+	checkpointFile := filepath.Join(dir, "resumed.checkpoint")
+	saved := newCheckpoint("")
+	saved.Shard = 3
+	saved.Values["boss"] = &tableProgress{Rows: 2, LastKey: []string{"8"}, Done: true}
+	saved.Values["person"] = &tableProgress{Rows: 1, LastKey: []string{"John", "Doe"}}
+	require.NoError(t, saved.save(checkpointFile))
+	progress, err := readCheckpoint(checkpointFile, "")
+	require.NoError(t, err)
+	require.Equal(t, saved, progress)
+	_, err = readCheckpoint(checkpointFile, "2020-01-01")
+	require.Error(t, err)
+
+	meta = newTestDumpMeta(t, src, pool)
+	meta.batchSize = 1
+	meta.shards = &rdfShards{output: output, rowsPerShard: 1}
+	meta.checkpoint = progress
+	meta.checkpointFile = checkpointFile
+	require.NoError(t, meta.dumpTables())
+
+	resumed := readShards(t, output)
+	require.Len(t, resumed, 4)
+	for n, shard := range resumed {
+		require.Equal(t, full[n], shard)
+	}
+}
+
+func TestMigrateSince(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	src, pool := newTestDB(t, dir)
+	defer pool.Close()
+
+	// only boss has an updated_at column, and the person table is read to follow the
+	// foreign key of the changed row
+	var data bytes.Buffer
+	meta := newTestDumpMeta(t, src, pool)
+	meta.dataWriter = bufio.NewWriter(&data)
+	meta.batchSize = 1
+	meta.since = "2020-03-01 00:00:00"
+	require.NoError(t, meta.dumpTables())
+	require.Equal(t, []string{
+		`_:boss.8 <boss.id> "8" .`,
+		`_:boss.8 <boss.p_fname.p_lname> _:person.John.Doe .`,
+		`_:boss.8 <boss.updated_at> "2020-06-01T00:00:00Z" .`,
+	}, sortedLines(data.String()))
+}
+
+func TestShardName(t *testing.T) {
+	require.Equal(t, "sql-00001.rdf.gz", shardName("sql.rdf", 1))
+	require.Equal(t, "out/sql-00012.rdf.gz", shardName("out/sql.rdf.gz", 12))
+	require.Equal(t, "data-00000.gz", shardName("data", 0))
+}
+
+func TestPostgresTableNames(t *testing.T) {
+	schema, table := splitPostgresTableName("orders")
+	require.Equal(t, "public", schema)
+	require.Equal(t, "orders", table)
+	require.Equal(t, "orders", postgresTableName(schema, table))
+
+	schema, table = splitPostgresTableName("sales.orders")
+	require.Equal(t, "sales", schema)
+	require.Equal(t, "orders", table)
+	require.Equal(t, "sales.orders", postgresTableName(schema, table))
+
+	initDataTypes()
+	require.Equal(t, intType, postgresTypeToInternal["int8"])
+	require.Equal(t, stringType, postgresTypeToInternal["jsonb"])
+	require.Equal(t, `"my ""table"""`, (&postgresSource{}).quote(`my "table"`))
+}
+
+func TestSQLiteDataType(t *testing.T) {
+	for declType, want := range map[string]dataType{
+		"INTEGER":          intType,
+		"bigint":           intType,
+		"varchar(50)":      stringType,
+		"TEXT":             stringType,
+		"DATETIME":         datetimeType,
+		"BOOLEAN":          boolType,
+		"DOUBLE PRECISION": floatType,
+		"decimal(10,2)":    floatType,
+		"BLOB":             unknownType,
+	} {
+		require.Equal(t, want, getSQLiteDataType(declType), declType)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// mysqlSource reads tables from a MySQL database.
+type mysqlSource struct{}
+
+func (s *mysqlSource) open(host, port, user, password, db string) (*sql.DB, error) {
+	return sql.Open("mysql",
+		fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true", user, password, host, port, db))
+}
+
+func (s *mysqlSource) defaultPort() string {
+	return "3306"
+}
+
+func (s *mysqlSource) listTables(pool *sql.DB, db string) ([]string, error) {
+	rows, err := pool.Query("show tables")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables := make([]string, 0)
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, errors.Wrapf(err, "while scanning table name")
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+func (s *mysqlSource) quote(ident string) string {
+	return "`" + strings.Replace(ident, "`", "``", -1) + "`"
+}
+
+func (s *mysqlSource) placeholder(n int) string {
+	return "?"
+}
+
+func (s *mysqlSource) parseTable(pool *sql.DB, tableName string, database string) (*sqlTable,
+	error) {
+	query := fmt.Sprintf(`select COLUMN_NAME,DATA_TYPE from INFORMATION_SCHEMA.
+COLUMNS where TABLE_NAME = "%s" AND TABLE_SCHEMA="%s" ORDER BY COLUMN_NAME`, tableName, database)
+	columns, err := pool.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer columns.Close()
+
+	table := newSQLTable(tableName, s.quote(tableName))
+	for columns.Next() {
+		/*
+			each row represents info about a column, for example
+			+---------------+-----------+
+			| COLUMN_NAME   | DATA_TYPE |
+			+---------------+-----------+
+			| p_company     | varchar   |
+			| p_employee_id | int       |
+			| p_fname       | varchar   |
+			| p_lname       | varchar   |
+			| title         | varchar   |
+			+---------------+-----------+
+		*/
+		var fieldName, dbType string
+		if err := columns.Scan(&fieldName, &dbType); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan table description result for table %s",
+				tableName)
+		}
+		table.addColumn(fieldName, getDataType(dbType), false)
+	}
+
+	// query indices
+	indexQuery := fmt.Sprintf(`select INDEX_NAME,COLUMN_NAME from INFORMATION_SCHEMA.`+
+		`STATISTICS where TABLE_NAME = "%s" AND index_schema="%s"`, tableName, database)
+	indices, err := pool.Query(indexQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer indices.Close()
+	for indices.Next() {
+		var indexName, columnName string
+		err := indices.Scan(&indexName, &columnName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to scan index info for table %s", tableName)
+		}
+		switch indexName {
+		case "PRIMARY":
+			table.setKeyType(columnName, primary)
+		default:
+			table.setKeyType(columnName, secondary)
+		}
+	}
+
+	foreignKeysQuery := fmt.Sprintf(`select COLUMN_NAME,CONSTRAINT_NAME,REFERENCED_TABLE_NAME,
+		REFERENCED_COLUMN_NAME from INFORMATION_SCHEMA.KEY_COLUMN_USAGE where TABLE_NAME = "%s"
+        AND CONSTRAINT_SCHEMA="%s" AND REFERENCED_TABLE_NAME IS NOT NULL
+        ORDER BY CONSTRAINT_NAME, ORDINAL_POSITION`, tableName, database)
+	fkeys, err := pool.Query(foreignKeysQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer fkeys.Close()
+	for fkeys.Next() {
+		/* example output from MySQL
+		+---------------+-----------------+-----------------------+------------------------+
+		| COLUMN_NAME   | CONSTRAINT_NAME | REFERENCED_TABLE_NAME | REFERENCED_COLUMN_NAME |
+		+---------------+-----------------+-----------------------+------------------------+
+		| p_fname       | role_ibfk_1     | person                | fname                  |
+		| p_lname       | role_ibfk_1     | person                | lname                  |
+		| p_company     | role_ibfk_2     | person                | company                |
+		| p_employee_id | role_ibfk_2     | person                | employee_id            |
+		+---------------+-----------------+-----------------------+------------------------+
+		*/
+		var col, constraintName, dstTable, dstCol string
+		if err := fkeys.Scan(&col, &constraintName, &dstTable, &dstCol); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan usage info for table %s", tableName)
+		}
+		table.addForeignKeyPart(constraintName, col, dstTable, dstCol)
+	}
+	return table, nil
+}
@@ -0,0 +1,196 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"database/sql"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const postgresDefaultSchema = "public"
+
+// postgresSource reads tables from a PostgreSQL database. Tables outside of the public schema
+// are named by their schema-qualified names, e.g. sales.orders.
+type postgresSource struct{}
+
+func (s *postgresSource) open(host, port, user, password, db string) (*sql.DB, error) {
+	// The SSL mode and other connection settings can be given through the PG* environment
+	// variables, e.g. PGSSLMODE=disable.
+	dsn := &url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(user, password),
+		Host:   net.JoinHostPort(host, port),
+		Path:   db,
+	}
+	return sql.Open("postgres", dsn.String())
+}
+
+func (s *postgresSource) defaultPort() string {
+	return "5432"
+}
+
+func (s *postgresSource) listTables(pool *sql.DB, db string) ([]string, error) {
+	rows, err := pool.Query(`select table_schema, table_name from information_schema.tables
+		where table_type = 'BASE TABLE'
+		and table_schema not in ('pg_catalog', 'information_schema')
+		order by table_schema, table_name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables := make([]string, 0)
+	for rows.Next() {
+		var schema, table string
+		if err := rows.Scan(&schema, &table); err != nil {
+			return nil, errors.Wrapf(err, "while scanning table name")
+		}
+		tables = append(tables, postgresTableName(schema, table))
+	}
+	return tables, rows.Err()
+}
+
+func (s *postgresSource) quote(ident string) string {
+	return pq.QuoteIdentifier(ident)
+}
+
+func (s *postgresSource) placeholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+// splitPostgresTableName returns the schema and the name of a possibly schema-qualified table.
+func splitPostgresTableName(name string) (string, string) {
+	if idx := strings.Index(name, "."); idx >= 0 {
+		return name[:idx], name[idx+1:]
+	}
+	return postgresDefaultSchema, name
+}
+
+// postgresTableName returns the name used for a table in the generated schema and data.
+func postgresTableName(schema, table string) string {
+	if schema == postgresDefaultSchema {
+		return table
+	}
+	return schema + "." + table
+}
+
+func (s *postgresSource) parseTable(pool *sql.DB, name string, database string) (*sqlTable,
+	error) {
+	schema, tableName := splitPostgresTableName(name)
+	table := newSQLTable(postgresTableName(schema, tableName),
+		s.quote(schema)+"."+s.quote(tableName))
+
+	columns, err := pool.Query(`select column_name, data_type, udt_name
+		from information_schema.columns where table_schema = $1 and table_name = $2
+		order by column_name`, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer columns.Close()
+	for columns.Next() {
+		/*
+			each row represents info about a column, for example
+			+-------------+-------------------+----------+
+			| column_name | data_type         | udt_name |
+			+-------------+-------------------+----------+
+			| id          | integer           | int4     |
+			| name        | character varying | varchar  |
+			| profile     | jsonb             | jsonb    |
+			| tags        | ARRAY             | _text    |
+			+-------------+-------------------+----------+
+		*/
+		var fieldName, dbType, udtName string
+		if err := columns.Scan(&fieldName, &dbType, &udtName); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan table description result for table %s",
+				name)
+		}
+		isList := dbType == "ARRAY"
+		if isList {
+			udtName = strings.TrimPrefix(udtName, "_")
+		}
+		table.addColumn(fieldName, postgresTypeToInternal[udtName], isList)
+	}
+	if err := columns.Err(); err != nil {
+		return nil, err
+	}
+	if len(table.columnNames) == 0 {
+		return nil, errors.Errorf("table %s does not exist or has no columns", name)
+	}
+
+	indices, err := pool.Query(`select tc.constraint_type, kcu.column_name
+		from information_schema.table_constraints tc
+		join information_schema.key_column_usage kcu
+		on kcu.constraint_schema = tc.constraint_schema
+		and kcu.constraint_name = tc.constraint_name
+		and kcu.table_name = tc.table_name
+		where tc.table_schema = $1 and tc.table_name = $2
+		and tc.constraint_type in ('PRIMARY KEY', 'UNIQUE')`, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer indices.Close()
+	for indices.Next() {
+		var constraintType, columnName string
+		if err := indices.Scan(&constraintType, &columnName); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan index info for table %s", name)
+		}
+		switch constraintType {
+		case "PRIMARY KEY":
+			table.setKeyType(columnName, primary)
+		default:
+			table.setKeyType(columnName, secondary)
+		}
+	}
+	if err := indices.Err(); err != nil {
+		return nil, err
+	}
+
+	// The columns of a multi-column foreign key are matched with the referenced columns
+	// through their positions in the referenced primary key or unique constraint.
+	fkeys, err := pool.Query(`select kcu.column_name, kcu.constraint_name,
+		rkcu.table_schema, rkcu.table_name, rkcu.column_name
+		from information_schema.referential_constraints rc
+		join information_schema.key_column_usage kcu
+		on kcu.constraint_schema = rc.constraint_schema
+		and kcu.constraint_name = rc.constraint_name
+		join information_schema.key_column_usage rkcu
+		on rkcu.constraint_schema = rc.unique_constraint_schema
+		and rkcu.constraint_name = rc.unique_constraint_name
+		and rkcu.ordinal_position = kcu.position_in_unique_constraint
+		where kcu.table_schema = $1 and kcu.table_name = $2
+		order by kcu.constraint_name, kcu.ordinal_position`, schema, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer fkeys.Close()
+	for fkeys.Next() {
+		var col, constraintName, dstSchema, dstTable, dstCol string
+		if err := fkeys.Scan(&col, &constraintName, &dstSchema, &dstTable,
+			&dstCol); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan usage info for table %s", name)
+		}
+		table.addForeignKeyPart(constraintName, col, postgresTableName(dstSchema, dstTable),
+			dstCol)
+	}
+	return table, fkeys.Err()
+}
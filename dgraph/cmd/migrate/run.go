@@ -49,20 +49,41 @@ func init() {
 	Migrate.EnvPrefix = "DGRAPH_MIGRATE"
 
 	flag := Migrate.Cmd.Flags()
+	flag.StringP("db_type", "", "mysql", "The type of the database to import, "+
+		"one of mysql, postgres and sqlite")
 	flag.StringP("user", "", "", "The user for logging in")
 	flag.StringP("password", "", "", "The password used for logging in")
-	flag.StringP("db", "", "", "The database to import")
+	flag.StringP("db", "", "", "The database to import, "+
+		"or the path of the database file for sqlite")
 	flag.StringP("tables", "", "", "The comma separated list of "+
-		"tables to import, an empty string means importing all tables in the database")
+		"tables to import, an empty string means importing all tables in the database. "+
+		"Tables outside of the public schema in postgres are given as schema.table")
 	flag.StringP("output_schema", "s", "schema.txt", "The schema output file")
 	flag.StringP("output_data", "o", "sql.rdf", "The data output file")
 	flag.StringP("separator", "p", ".", "The separator for constructing predicate names")
 	flag.BoolP("quiet", "q", false, "Enable quiet mode to suppress the warning logs")
 	flag.StringP("host", "", "localhost", "The hostname or IP address of the database server.")
-	flag.StringP("port", "", "3306", "The port of the database server.")
+	flag.StringP("port", "", "", "The port of the database server, "+
+		"3306 for mysql and 5432 for postgres if not set.")
+	flag.IntP("batch_size", "", 10000, "The number of rows read from a table with one query, "+
+		"0 reads each table with a single query")
+	flag.IntP("shard_rows", "", 0, "If positive, the data is written to gzipped shards "+
+		"named after the --output_data file, each holding the data of up to this many rows")
+	flag.StringP("checkpoint", "", "", "The file in which the progress is saved whenever a "+
+		"shard is completed. If the file exists, the migration resumes from the saved progress. "+
+		"Requires --shard_rows")
+	flag.StringP("since", "", "", "Only migrate the rows whose --updated_at_column is later "+
+		"than the given time, e.g. 2020-10-01 00:00:00. Tables without that column or a "+
+		"primary key are skipped")
+	flag.StringP("updated_at_column", "", "updated_at", "The column holding the last time "+
+		"a row was updated, used by --since")
+	flag.StringP("upsert_predicate", "", "", "If set, the schema of this predicate is added to "+
+		"the schema file, so that the data can be loaded with "+
+		"dgraph live --upsertPredicate <predicate>")
 }
 
 func run(conf *viper.Viper) error {
+	dbType := conf.GetString("db_type")
 	user := conf.GetString("user")
 	db := conf.GetString("db")
 	password := conf.GetString("password")
@@ -73,57 +94,102 @@ func run(conf *viper.Viper) error {
 	port := conf.GetString("port")
 	quiet = conf.GetBool("quiet")
 	separator = conf.GetString("separator")
+	batchSize := conf.GetInt("batch_size")
+	shardRows := conf.GetInt("shard_rows")
+	checkpointFile := conf.GetString("checkpoint")
+	since := conf.GetString("since")
+
+	src, err := getSource(dbType)
+	if err != nil {
+		return err
+	}
+	// a sqlite database is a local file, which doesn't need any credentials
+	_, isSQLite := src.(*sqliteSource)
 
 	switch {
-	case len(user) == 0:
+	case len(user) == 0 && !isSQLite:
 		logger.Fatalf("The user property should not be empty.")
 	case len(db) == 0:
 		logger.Fatalf("The db property should not be empty.")
-	case len(password) == 0:
+	case len(password) == 0 && !isSQLite:
 		logger.Fatalf("The password property should not be empty.")
 	case len(schemaOutput) == 0:
 		logger.Fatalf("Please use the --output_schema option to " +
 			"provide the schema output file.")
 	case len(dataOutput) == 0:
 		logger.Fatalf("Please use the --output_data option to provide the data output file.")
+	case len(checkpointFile) > 0 && (shardRows <= 0 || batchSize <= 0):
+		logger.Fatalf("The --checkpoint option requires positive --shard_rows and " +
+			"--batch_size options.")
 	}
 
-	if err := checkFile(schemaOutput); err != nil {
-		return err
+	progress := newCheckpoint(since)
+	resuming := false
+	if len(checkpointFile) > 0 {
+		_, err := os.Stat(checkpointFile)
+		resuming = err == nil
+		if progress, err = readCheckpoint(checkpointFile, since); err != nil {
+			return err
+		}
 	}
-	if err := checkFile(dataOutput); err != nil {
-		return err
+	var shards *rdfShards
+	if shardRows > 0 {
+		shards = &rdfShards{
+			output:       dataOutput,
+			rowsPerShard: shardRows,
+		}
+		dataOutput = ""
+	}
+
+	// the output files of a resumed migration are expected to exist
+	if !resuming {
+		if err := checkFile(schemaOutput); err != nil {
+			return err
+		}
+		firstData := dataOutput
+		if shards != nil {
+			firstData = shardName(shards.output, 0)
+		}
+		if err := checkFile(firstData); err != nil {
+			return err
+		}
 	}
 
 	initDataTypes()
 
-	pool, err := getPool(host, port, user, password, db)
+	if len(port) == 0 {
+		port = src.defaultPort()
+	}
+	pool, err := src.open(host, port, user, password, db)
 	if err != nil {
 		return err
 	}
 	defer pool.Close()
 
-	tablesToRead, err := showTables(pool, tables)
+	tablesToRead, err := showTables(src, pool, tables, db)
 	if err != nil {
 		return err
 	}
 
-	tableInfos := make(map[string]*sqlTable)
-	for _, table := range tablesToRead {
-		tableInfo, err := parseTables(pool, table, db)
-		if err != nil {
-			return err
-		}
-		tableInfos[tableInfo.tableName] = tableInfo
+	tableInfos, err := readTables(src, pool, tablesToRead, db)
+	if err != nil {
+		return err
 	}
-	populateReferencedByColumns(tableInfos)
 
 	tableGuides := getTableGuides(tableInfos)
 
 	return generateSchemaAndData(&dumpMeta{
-		tableInfos:  tableInfos,
-		tableGuides: tableGuides,
-		sqlPool:     pool,
+		tableInfos:      tableInfos,
+		tableGuides:     tableGuides,
+		sqlPool:         pool,
+		source:          src,
+		batchSize:       batchSize,
+		since:           since,
+		updatedAtColumn: conf.GetString("updated_at_column"),
+		upsertPredicate: conf.GetString("upsert_predicate"),
+		shards:          shards,
+		checkpoint:      progress,
+		checkpointFile:  checkpointFile,
 	}, schemaOutput, dataOutput)
 }
 
@@ -157,20 +223,23 @@ func checkFile(file string) error {
 
 // generateSchemaAndData opens the two files schemaOutput and dataOutput,
 // then it dumps schema to the writer backed by schemaOutput, and data in RDF format
-// to the writer backed by dataOutput
+// to the writer backed by dataOutput. If dataOutput is empty, the data is written to
+// dumpMeta's shards.
 func generateSchemaAndData(dumpMeta *dumpMeta, schemaOutput string, dataOutput string) error {
 	schemaWriter, schemaCancelFunc, err := getFileWriter(schemaOutput)
 	if err != nil {
 		return err
 	}
 	defer schemaCancelFunc()
-	dataWriter, dataCancelFunc, err := getFileWriter(dataOutput)
-	if err != nil {
-		return err
+	if len(dataOutput) > 0 {
+		dataWriter, dataCancelFunc, err := getFileWriter(dataOutput)
+		if err != nil {
+			return err
+		}
+		defer dataCancelFunc()
+		dumpMeta.dataWriter = dataWriter
 	}
-	defer dataCancelFunc()
 
-	dumpMeta.dataWriter = dataWriter
 	dumpMeta.schemaWriter = schemaWriter
 
 	if err := dumpMeta.dumpSchema(); err != nil {
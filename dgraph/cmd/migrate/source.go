@@ -0,0 +1,79 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A dbSource reads the metadata of the tables in one kind of SQL database. Once the tables
+// have been parsed into sqlTables, the schema and data are generated the same way regardless
+// of the database they come from.
+type dbSource interface {
+	// open returns a pool of connections to the database.
+	open(host, port, user, password, db string) (*sql.DB, error)
+	// defaultPort is the port used when the --port option is not set.
+	defaultPort() string
+	// listTables returns the names of all the tables in the database.
+	listTables(pool *sql.DB, db string) ([]string, error)
+	// parseTable reads the columns, indices and foreign key constraints of a table.
+	parseTable(pool *sql.DB, tableName string, db string) (*sqlTable, error)
+	// quote quotes an identifier so that it can be used in a query.
+	quote(ident string) string
+	// placeholder returns the placeholder of the n-th argument of a query, starting from 1.
+	placeholder(n int) string
+}
+
+func getSource(dbType string) (dbSource, error) {
+	switch strings.ToLower(dbType) {
+	case "mysql":
+		return &mysqlSource{}, nil
+	case "postgres", "postgresql":
+		return &postgresSource{}, nil
+	case "sqlite", "sqlite3":
+		return &sqliteSource{}, nil
+	default:
+		return nil, errors.Errorf("unsupported database type %q, the supported types are "+
+			"mysql, postgres and sqlite", dbType)
+	}
+}
+
+// readTables parses the given tables and links them through their foreign key constraints.
+func readTables(src dbSource, pool *sql.DB, tables []string,
+	db string) (map[string]*sqlTable, error) {
+	tableInfos := make(map[string]*sqlTable)
+	for _, table := range tables {
+		tableInfo, err := src.parseTable(pool, table, db)
+		if err != nil {
+			return nil, err
+		}
+		tableInfos[tableInfo.tableName] = tableInfo
+	}
+	for _, tableInfo := range tableInfos {
+		for dstTable := range tableInfo.dstTables {
+			if _, ok := tableInfos[dstTable]; !ok {
+				return nil, errors.Errorf("table %s references the table %s, which is not "+
+					"being imported", tableInfo.tableName, dstTable)
+			}
+		}
+	}
+	populateReferencedByColumns(tableInfos)
+	return tableInfos, nil
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrate
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// errNoSQLite is returned when dgraph was built without cgo, which the SQLite driver needs. The
+// driver is registered in sqlite_cgo.go.
+var errNoSQLite = errors.New("the sqlite source is only available in a dgraph built with cgo")
+
+// sqliteSource reads tables from a SQLite database file, which is given through the --db
+// option.
+type sqliteSource struct{}
+
+func (s *sqliteSource) open(host, port, user, password, db string) (*sql.DB, error) {
+	for _, driver := range sql.Drivers() {
+		if driver == "sqlite3" {
+			return sql.Open("sqlite3", db)
+		}
+	}
+	return nil, errNoSQLite
+}
+
+func (s *sqliteSource) defaultPort() string {
+	return ""
+}
+
+func (s *sqliteSource) listTables(pool *sql.DB, db string) ([]string, error) {
+	rows, err := pool.Query(`select name from sqlite_master
+		where type = 'table' and name not like 'sqlite_%' order by name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables := make([]string, 0)
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, errors.Wrapf(err, "while scanning table name")
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+func (s *sqliteSource) quote(ident string) string {
+	return `"` + strings.Replace(ident, `"`, `""`, -1) + `"`
+}
+
+func (s *sqliteSource) placeholder(n int) string {
+	return "?"
+}
+
+// primaryKey returns the columns of the primary key of a table in the order they are
+// declared in the key.
+func (s *sqliteSource) primaryKey(pool *sql.DB, tableName string) ([]string, error) {
+	rows, err := pool.Query(`select name from pragma_table_info(?) where pk > 0 order by pk`,
+		tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan the primary key of table %s",
+				tableName)
+		}
+		columns = append(columns, column)
+	}
+	return columns, rows.Err()
+}
+
+func (s *sqliteSource) parseTable(pool *sql.DB, tableName string, database string) (*sqlTable,
+	error) {
+	table := newSQLTable(tableName, s.quote(tableName))
+
+	columns, err := pool.Query(`select name, type, pk from pragma_table_info(?) order by name`,
+		tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer columns.Close()
+	for columns.Next() {
+		var fieldName, declType string
+		var pk int
+		if err := columns.Scan(&fieldName, &declType, &pk); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan table description result for table %s",
+				tableName)
+		}
+		table.addColumn(fieldName, getSQLiteDataType(declType), false)
+		if pk > 0 {
+			table.setKeyType(fieldName, primary)
+		}
+	}
+	if err := columns.Err(); err != nil {
+		return nil, err
+	}
+	if len(table.columnNames) == 0 {
+		return nil, errors.Errorf("table %s does not exist or has no columns", tableName)
+	}
+
+	indices, err := pool.Query(`select ii.name from pragma_index_list(?) il,
+		pragma_index_info(il.name) ii`, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer indices.Close()
+	for indices.Next() {
+		var columnName sql.NullString // null for indices on expressions
+		if err := indices.Scan(&columnName); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan index info for table %s", tableName)
+		}
+		if columnName.Valid {
+			table.setKeyType(columnName.String, secondary)
+		}
+	}
+	if err := indices.Err(); err != nil {
+		return nil, err
+	}
+
+	fkeys, err := pool.Query(`select id, "from", "table", "to"
+		from pragma_foreign_key_list(?) order by id, seq`, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer fkeys.Close()
+	type fkPart struct {
+		constraintName, col, dstTable string
+		dstCol                        sql.NullString
+	}
+	var parts []fkPart
+	for fkeys.Next() {
+		var id int
+		var part fkPart
+		if err := fkeys.Scan(&id, &part.col, &part.dstTable, &part.dstCol); err != nil {
+			return nil, errors.Wrapf(err, "unable to scan usage info for table %s", tableName)
+		}
+		part.constraintName = fmt.Sprintf("fk_%d", id)
+		parts = append(parts, part)
+	}
+	if err := fkeys.Err(); err != nil {
+		return nil, err
+	}
+
+	// A foreign key that doesn't name the referenced columns references the primary key
+	// of the remote table.
+	primaryKeys := make(map[string][]string)
+	for i, part := range parts {
+		dstCol := part.dstCol.String
+		if !part.dstCol.Valid {
+			if _, ok := primaryKeys[part.dstTable]; !ok {
+				if primaryKeys[part.dstTable], err = s.primaryKey(pool, part.dstTable); err != nil {
+					return nil, err
+				}
+			}
+			pk := primaryKeys[part.dstTable]
+			// the parts of a constraint are consecutive, and ordered by their position
+			pos := 0
+			for j := i - 1; j >= 0 && parts[j].constraintName == part.constraintName; j-- {
+				pos++
+			}
+			if pos >= len(pk) {
+				return nil, errors.Errorf("the foreign key %s of table %s does not match the "+
+					"primary key of table %s", part.constraintName, tableName, part.dstTable)
+			}
+			dstCol = pk[pos]
+		}
+		table.addForeignKeyPart(part.constraintName, part.col, part.dstTable, dstCol)
+	}
+	return table, nil
+}
@@ -0,0 +1,27 @@
+// +build cgo
+
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// The SQLite driver wraps the SQLite C library, so it's only compiled in when cgo is enabled.
+// This keeps CGO_ENABLED=0 builds of dgraph working, with the sqlite source returning
+// errNoSQLite.
+
+package migrate
+
+import (
+	_ "github.com/mattn/go-sqlite3" // register the sqlite3 driver
+)
@@ -20,6 +20,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
@@ -177,14 +178,20 @@ func getValue(dataType dataType, value interface{}) (string, error) {
 		if !value.(mysql.NullTime).Valid {
 			return "", errors.Errorf("found invalid nulltime")
 		}
-		dateVal, _ := value.(mysql.NullTime).Value()
-		return fmt.Sprintf("%v", dateVal), nil
+		// Dgraph parses datetime values in the RFC 3339 format
+		return value.(mysql.NullTime).Time.Format(time.RFC3339Nano), nil
 	case floatType:
 		if !value.(sql.NullFloat64).Valid {
 			return "", errors.Errorf("found invalid nullfloat")
 		}
 		floatVal, _ := value.(sql.NullFloat64).Value()
 		return fmt.Sprintf("%v", floatVal), nil
+	case boolType:
+		if !value.(sql.NullBool).Valid {
+			return "", errors.Errorf("found invalid nullbool")
+		}
+		boolVal, _ := value.(sql.NullBool).Value()
+		return fmt.Sprintf("%v", boolVal), nil
 	default:
 		return fmt.Sprintf("%v", value), nil
 	}
@@ -226,6 +233,11 @@ func createDgraphSchema(info *sqlTable) []string {
 
 		dataType := info.columns[column].dataType
 
+		if info.columns[column].isList {
+			dgraphIndices = append(dgraphIndices, fmt.Sprintf("%s: [%s] .\n",
+				predicate, dataType))
+			continue
+		}
 		dgraphIndices = append(dgraphIndices, fmt.Sprintf("%s: %s .\n",
 			predicate, dataType))
 	}
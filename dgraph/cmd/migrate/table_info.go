@@ -17,12 +17,9 @@
 package migrate
 
 import (
-	"database/sql"
-	"fmt"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/x"
-	"github.com/pkg/errors"
 )
 
 type keyType int
@@ -39,6 +36,7 @@ type columnInfo struct {
 	name     string
 	keyType  keyType
 	dataType dataType
+	isList   bool // whether the column holds an array of values of dataType
 }
 
 // fkConstraint represents a foreign key constraint
@@ -63,7 +61,9 @@ type constraintPart struct {
 // the info of each column etc
 type sqlTable struct {
 	tableName string
-	columns   map[string]*columnInfo
+	// the quoted, and possibly schema-qualified, name used to refer to the table in queries
+	sqlName string
+	columns map[string]*columnInfo
 
 	// The following 3 columns are used by the rowMeta when converting rows
 	columnDataTypes []dataType
@@ -90,24 +90,10 @@ func getDataType(dbType string) dataType {
 	return unknownType
 }
 
-func getColumnInfo(fieldName string, dbType string) *columnInfo {
-	columnInfo := columnInfo{}
-	columnInfo.name = fieldName
-	columnInfo.dataType = getDataType(dbType)
-	return &columnInfo
-}
-
-func parseTables(pool *sql.DB, tableName string, database string) (*sqlTable, error) {
-	query := fmt.Sprintf(`select COLUMN_NAME,DATA_TYPE from INFORMATION_SCHEMA.
-COLUMNS where TABLE_NAME = "%s" AND TABLE_SCHEMA="%s" ORDER BY COLUMN_NAME`, tableName, database)
-	columns, err := pool.Query(query)
-	if err != nil {
-		return nil, err
-	}
-	defer columns.Close()
-
-	table := &sqlTable{
+func newSQLTable(tableName, sqlName string) *sqlTable {
+	return &sqlTable{
 		tableName:             tableName,
+		sqlName:               sqlName,
 		columns:               make(map[string]*columnInfo),
 		columnNames:           make([]string, 0),
 		isForeignKey:          make(map[string]bool),
@@ -116,99 +102,53 @@ COLUMNS where TABLE_NAME = "%s" AND TABLE_SCHEMA="%s" ORDER BY COLUMN_NAME`, tab
 		dstTables:             make(map[string]interface{}),
 		foreignKeyConstraints: make(map[string]*fkConstraint),
 	}
+}
 
-	for columns.Next() {
-		/*
-			each row represents info about a column, for example
-			+---------------+-----------+
-			| COLUMN_NAME   | DATA_TYPE |
-			+---------------+-----------+
-			| p_company     | varchar   |
-			| p_employee_id | int       |
-			| p_fname       | varchar   |
-			| p_lname       | varchar   |
-			| title         | varchar   |
-			+---------------+-----------+
-		*/
-		var fieldName, dbType string
-		if err := columns.Scan(&fieldName, &dbType); err != nil {
-			return nil, errors.Wrapf(err, "unable to scan table description result for table %s",
-				tableName)
-		}
-
-		// TODO, should store the column data types into the table info as an array
-		// and the RMI should simply get the data types from the table info
-		table.columns[fieldName] = getColumnInfo(fieldName, dbType)
-		table.columnNames = append(table.columnNames, fieldName)
-		table.columnDataTypes = append(table.columnDataTypes, getDataType(dbType))
-	}
-
-	// query indices
-	indexQuery := fmt.Sprintf(`select INDEX_NAME,COLUMN_NAME from INFORMATION_SCHEMA.`+
-		`STATISTICS where TABLE_NAME = "%s" AND index_schema="%s"`, tableName, database)
-	indices, err := pool.Query(indexQuery)
-	if err != nil {
-		return nil, err
-	}
-	defer indices.Close()
-	for indices.Next() {
-		var indexName, columnName string
-		err := indices.Scan(&indexName, &columnName)
-		if err != nil {
-			return nil, errors.Wrapf(err, "unable to scan index info for table %s", tableName)
-		}
-		switch indexName {
-		case "PRIMARY":
-			table.columns[columnName].keyType = primary
-		default:
-			table.columns[columnName].keyType = secondary
-		}
-
+// addColumn adds a column to the table. The columns should be added in alphabetical order.
+// If isList is true, the column holds an array of values of the given data type.
+func (table *sqlTable) addColumn(fieldName string, dataType dataType, isList bool) {
+	// TODO, should store the column data types into the table info as an array
+	// and the RMI should simply get the data types from the table info
+	table.columns[fieldName] = &columnInfo{
+		name:     fieldName,
+		dataType: dataType,
+		isList:   isList,
 	}
+	table.columnNames = append(table.columnNames, fieldName)
+	table.columnDataTypes = append(table.columnDataTypes, dataType)
+}
 
-	foreignKeysQuery := fmt.Sprintf(`select COLUMN_NAME,CONSTRAINT_NAME,REFERENCED_TABLE_NAME,
-		REFERENCED_COLUMN_NAME from INFORMATION_SCHEMA.KEY_COLUMN_USAGE where TABLE_NAME = "%s"
-        AND CONSTRAINT_SCHEMA="%s" AND REFERENCED_TABLE_NAME IS NOT NULL`, tableName, database)
-	fkeys, err := pool.Query(foreignKeysQuery)
-	if err != nil {
-		return nil, err
+// setKeyType marks the column as part of the primary key or of another index. A column that
+// is part of the primary key stays a primary key column.
+func (table *sqlTable) setKeyType(columnName string, keyType keyType) {
+	column, ok := table.columns[columnName]
+	if !ok || column.keyType == primary {
+		return
 	}
-	defer fkeys.Close()
-	for fkeys.Next() {
-		/* example output from MySQL
-		+---------------+-----------------+-----------------------+------------------------+
-		| COLUMN_NAME   | CONSTRAINT_NAME | REFERENCED_TABLE_NAME | REFERENCED_COLUMN_NAME |
-		+---------------+-----------------+-----------------------+------------------------+
-		| p_fname       | role_ibfk_1     | person                | fname                  |
-		| p_lname       | role_ibfk_1     | person                | lname                  |
-		| p_company     | role_ibfk_2     | person                | company                |
-		| p_employee_id | role_ibfk_2     | person                | employee_id            |
-		+---------------+-----------------+-----------------------+------------------------+
-		*/
-		var col, constraintName, dstTable, dstCol string
-		if err := fkeys.Scan(&col, &constraintName, &dstTable, &dstCol); err != nil {
-			return nil, errors.Wrapf(err, "unable to scan usage info for table %s", tableName)
-		}
+	column.keyType = keyType
+}
 
-		table.dstTables[dstTable] = struct{}{}
-		var constraint *fkConstraint
-		var ok bool
-		if constraint, ok = table.foreignKeyConstraints[constraintName]; !ok {
-			constraint = &fkConstraint{
-				parts: make([]*constraintPart, 0),
-			}
-			table.foreignKeyConstraints[constraintName] = constraint
+// addForeignKeyPart adds the column col referencing the column dstCol of the table dstTable
+// to the foreign key constraint with the given name. The parts of a multi-column constraint
+// should be added in the order in which they are declared.
+func (table *sqlTable) addForeignKeyPart(constraintName, col, dstTable, dstCol string) {
+	table.dstTables[dstTable] = struct{}{}
+	var constraint *fkConstraint
+	var ok bool
+	if constraint, ok = table.foreignKeyConstraints[constraintName]; !ok {
+		constraint = &fkConstraint{
+			parts: make([]*constraintPart, 0),
 		}
-		constraint.parts = append(constraint.parts, &constraintPart{
-			tableName:        tableName,
-			columnName:       col,
-			remoteTableName:  dstTable,
-			remoteColumnName: dstCol,
-		})
-
-		table.isForeignKey[col] = true
+		table.foreignKeyConstraints[constraintName] = constraint
 	}
-	return table, nil
+	constraint.parts = append(constraint.parts, &constraintPart{
+		tableName:        table.tableName,
+		columnName:       col,
+		remoteTableName:  dstTable,
+		remoteColumnName: dstCol,
+	})
+
+	table.isForeignKey[col] = true
 }
 
 // validateAndGetReverse flip the foreign key reference direction in a constraint.
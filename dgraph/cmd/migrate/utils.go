@@ -19,48 +19,26 @@ package migrate
 import (
 	"bufio"
 	"database/sql"
-	"fmt"
 	"os"
 	"reflect"
 	"strings"
 
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
-func getPool(host, port, user, password, db string) (*sql.DB,
-	error) {
-	return sql.Open("mysql",
-		fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true", user, password, host, port, db))
-}
-
 // showTables will return a slice of table names using one of the following logic
 // 1) if the parameter tables is not empty, this function will return a slice of table names
 // by splitting the parameter with the separate comma
 // 2) if the parameter is empty, this function will read all the tables under the given
 // database and then return the result
-func showTables(pool *sql.DB, tableNames string) ([]string, error) {
+func showTables(src dbSource, pool *sql.DB, tableNames string, db string) ([]string, error) {
 	if len(tableNames) > 0 {
 		return strings.Split(tableNames, ","), nil
 	}
-	query := "show tables"
-	rows, err := pool.Query(query)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	tables := make([]string, 0)
-	for rows.Next() {
-		var table string
-		if err := rows.Scan(&table); err != nil {
-			return nil, errors.Wrapf(err, "while scanning table name")
-		}
-		tables = append(tables, table)
-	}
-
-	return tables, nil
+	return src.listTables(pool, db)
 }
 
 type criteriaFunc func(info *sqlTable, column string) bool
@@ -94,8 +72,10 @@ func getFileWriter(filename string) (*bufio.Writer, func(), error) {
 	return bufio.NewWriter(output), func() { _ = output.Close() }, nil
 }
 
-func getColumnValues(columns []string, dataTypes []dataType,
-	rows *sql.Rows) ([]interface{}, error) {
+// getColumnValues reads the values in the current row of the given table. The values of array
+// columns are read as pq.StringArray.
+func getColumnValues(info *sqlTable, rows *sql.Rows) ([]interface{}, error) {
+	columns, dataTypes := info.columnNames, info.columnDataTypes
 	// ptrToValues takes a slice of pointers, deference them, and return the values referenced
 	// by these pointers
 	ptrToValues := func(ptrs []interface{}) []interface{} {
@@ -110,6 +90,10 @@ func getColumnValues(columns []string, dataTypes []dataType,
 
 	valuePtrs := make([]interface{}, 0, len(columns))
 	for i := 0; i < len(columns); i++ {
+		if info.columns[columns[i]].isList {
+			valuePtrs = append(valuePtrs, new(pq.StringArray))
+			continue
+		}
 		switch dataTypes[i] {
 		case stringType:
 			valuePtrs = append(valuePtrs, new([]byte)) // the value can be nil
@@ -119,6 +103,8 @@ func getColumnValues(columns []string, dataTypes []dataType,
 			valuePtrs = append(valuePtrs, new(sql.NullFloat64))
 		case datetimeType:
 			valuePtrs = append(valuePtrs, new(mysql.NullTime))
+		case boolType:
+			valuePtrs = append(valuePtrs, new(sql.NullBool))
 		default:
 			x.Panic(errors.Errorf("detected unsupported type %s on column %s",
 				dataTypes[i], columns[i]))
@@ -130,3 +116,39 @@ func getColumnValues(columns []string, dataTypes []dataType,
 	colValues := ptrToValues(valuePtrs)
 	return colValues, nil
 }
+
+// getKeyValues returns the values of the key columns at the given indices in a row, in a form
+// that can be saved in a checkpoint and compared with the key columns in a query.
+func getKeyValues(info *sqlTable, keyIndices []*columnIdx, values []interface{}) ([]string,
+	error) {
+	keyValues := make([]string, 0, len(keyIndices))
+	for _, columnIndex := range keyIndices {
+		dataType := info.columns[columnIndex.name].dataType
+		value := values[columnIndex.index]
+		var keyValue string
+		switch dataType {
+		case datetimeType:
+			if !value.(mysql.NullTime).Valid {
+				return nil, errors.Errorf("found invalid nulltime in key column %s",
+					columnIndex.name)
+			}
+			keyValue = value.(mysql.NullTime).Time.Format("2006-01-02 15:04:05.999999999")
+		case boolType:
+			if !value.(sql.NullBool).Valid {
+				return nil, errors.Errorf("found invalid nullbool in key column %s",
+					columnIndex.name)
+			}
+			keyValue = "0"
+			if value.(sql.NullBool).Bool {
+				keyValue = "1"
+			}
+		default:
+			var err error
+			if keyValue, err = getValue(dataType, value); err != nil {
+				return nil, errors.Wrapf(err, "while reading key column %s", columnIndex.name)
+			}
+		}
+		keyValues = append(keyValues, keyValue)
+	}
+	return keyValues, nil
+}
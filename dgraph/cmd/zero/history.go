@@ -0,0 +1,212 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/ristretto/z"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	otrace "go.opencensus.io/trace"
+)
+
+// maxTsSamples is the maximum number of ts samples kept in the membership state. The samples get
+// sparser as the history retention grows, so they never take more than a few hundred KBs.
+const maxTsSamples = 1 << 14
+
+// sampleInterval returns how often the max assigned ts is sampled.
+func sampleInterval() time.Duration {
+	every := opts.historyRetention / maxTsSamples
+	if every < time.Second {
+		every = time.Second
+	}
+	return every
+}
+
+// recordHistoryPeriodically makes the leader sample the max assigned ts, so that point-in-time
+// queries can be given a time. The samples are proposed to the group, hence they're known to
+// every Zero and survive restarts.
+func (n *node) recordHistoryPeriodically(closer *z.Closer) {
+	defer closer.Done()
+	ticker := time.NewTicker(sampleInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !n.AmLeader() {
+				continue
+			}
+			update := n.server.historyUpdate(time.Now())
+			if update == nil {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			if err := n.proposeAndWait(ctx, &pb.ZeroProposal{History: update}); err != nil {
+				glog.Errorf("While proposing history update: %v", err)
+			}
+			cancel()
+
+		case <-closer.HasBeenClosed():
+			return
+		}
+	}
+}
+
+// historyUpdate returns the update recording the max assigned ts at time now, or nil if there's
+// nothing new to record.
+func (s *Server) historyUpdate(now time.Time) *pb.HistoryUpdate {
+	s.RLock()
+	defer s.RUnlock()
+
+	if opts.historyRetention <= 0 {
+		if len(s.state.TsSamples) == 0 && s.state.HistoryTs == 0 {
+			return nil
+		}
+		// History retention has been disabled since the last run.
+		return &pb.HistoryUpdate{}
+	}
+	ts := s.orc.MaxPending()
+	if ts == 0 {
+		return nil
+	}
+	// Reads at any time since the last sample see the same data, as nothing was committed.
+	if n := len(s.state.TsSamples); n > 0 && s.state.TsSamples[n-1].Ts >= ts {
+		return nil
+	}
+	return &pb.HistoryUpdate{
+		Sample: &pb.TsSample{UnixNano: now.UnixNano(), Ts: ts},
+		Since:  now.Add(-opts.historyRetention).UnixNano(),
+	}
+}
+
+// applyHistoryUpdate records the sample of the update in the state, and drops the samples that
+// are out of the retention window. It must be called with the lock held.
+func applyHistoryUpdate(state *pb.MembershipState, update *pb.HistoryUpdate) {
+	if update.Sample == nil {
+		state.TsSamples = nil
+		state.HistoryTs = 0
+		return
+	}
+	samples := state.TsSamples
+	if n := len(samples); n == 0 || samples[n-1].Ts < update.Sample.Ts {
+		samples = append(samples, update.Sample)
+	}
+
+	// Keep the last sample before the window starts, it tells the ts at the start of the window.
+	idx := sort.Search(len(samples), func(i int) bool {
+		return samples[i].UnixNano > update.Since
+	})
+	if idx > 1 {
+		samples = samples[idx-1:]
+	}
+	if len(samples) > maxTsSamples {
+		samples = samples[len(samples)-maxTsSamples:]
+	}
+	state.TsSamples = samples
+	// If the window isn't covered yet, the versions can be discarded up to the first sample, as
+	// no time before it can be mapped to a ts anyway.
+	state.HistoryTs = samples[0].Ts
+}
+
+// historyWatermark returns the lowest ts that point-in-time queries can read at. The alphas
+// discard the versions below the snapshot ts of their group, or below the history ts if history
+// retention is enabled and the history ts is older.
+func (s *Server) historyWatermark() uint64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	var watermark uint64
+	for _, group := range s.state.Groups {
+		watermark = x.Max(watermark, group.SnapshotTs)
+	}
+	if s.state.HistoryTs > 0 {
+		watermark = x.Min(watermark, s.state.HistoryTs)
+	}
+	return watermark
+}
+
+// tsAt returns the max assigned ts at time t, i.e. the ts at which reads see the data as it was
+// at that time. The result misses the transactions committed between the previous sample and t.
+func (s *Server) tsAt(t time.Time) (uint64, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	samples := s.state.TsSamples
+	if len(samples) == 0 {
+		return 0, errors.Errorf("No ts is known for time %s. Is history retention enabled on "+
+			"Zero (--history_retention)?", t.Format(time.RFC3339))
+	}
+	if t.After(time.Now()) {
+		return 0, errors.Errorf("Time %s is in the future", t.Format(time.RFC3339))
+	}
+	nano := t.UnixNano()
+	idx := sort.Search(len(samples), func(i int) bool {
+		return samples[i].UnixNano > nano
+	})
+	if idx == 0 {
+		return 0, errors.Errorf("Time %s is older than the history kept since %s",
+			t.Format(time.RFC3339), time.Unix(0, samples[0].UnixNano).Format(time.RFC3339))
+	}
+	return samples[idx-1].Ts, nil
+}
+
+// AsOfTs returns the ts to read at for a point-in-time query, given either a ts or, if the ts
+// is zero, a unix time in nanoseconds.
+func (s *Server) AsOfTs(ctx context.Context, in *pb.TsSample) (*pb.TsSample, error) {
+	ctx, span := otrace.StartSpan(ctx, "Zero.AsOfTs")
+	defer span.End()
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if !s.Node.AmLeader() {
+		return nil, errors.Errorf("Only the leader can resolve point-in-time queries")
+	}
+	ts, err := s.asOfTs(in)
+	if err != nil {
+		return nil, err
+	}
+	span.Annotatef(nil, "as_of ts: %d", ts)
+	return &pb.TsSample{UnixNano: in.UnixNano, Ts: ts}, nil
+}
+
+// asOfTs resolves the ts of a point-in-time query. It returns an error if the versions needed to
+// read at that ts might have been discarded already.
+func (s *Server) asOfTs(in *pb.TsSample) (uint64, error) {
+	ts := in.Ts
+	if ts == 0 {
+		var err error
+		if ts, err = s.tsAt(time.Unix(0, in.UnixNano)); err != nil {
+			return 0, err
+		}
+	}
+	if maxTs := s.orc.MaxPending(); ts > maxTs {
+		return 0, errors.Errorf("as_of ts %d is ahead of the max assigned ts %d", ts, maxTs)
+	}
+	if watermark := s.historyWatermark(); ts < watermark {
+		return 0, errors.Errorf("as_of ts %d is below the history watermark %d. Older "+
+			"versions have been discarded. Consider increasing --history_retention on Zero.",
+			ts, watermark)
+	}
+	return ts, nil
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryUpdate(t *testing.T) {
+	defer func(retention time.Duration) { opts.historyRetention = retention }(opts.historyRetention)
+	opts.historyRetention = time.Hour
+	s := &Server{state: &pb.MembershipState{}, orc: &Oracle{}}
+	now := time.Now()
+
+	// Nothing to record before any ts is assigned.
+	require.Nil(t, s.historyUpdate(now))
+
+	s.orc.maxAssigned = 10
+	update := s.historyUpdate(now)
+	require.Equal(t, &pb.TsSample{UnixNano: now.UnixNano(), Ts: 10}, update.Sample)
+	require.Equal(t, now.Add(-time.Hour).UnixNano(), update.Since)
+	applyHistoryUpdate(s.state, update)
+
+	// Nothing to record if the ts hasn't advanced.
+	require.Nil(t, s.historyUpdate(now.Add(time.Second)))
+
+	// Disabling history retention clears the samples.
+	opts.historyRetention = 0
+	update = s.historyUpdate(now.Add(time.Second))
+	require.NotNil(t, update)
+	require.Nil(t, update.Sample)
+	applyHistoryUpdate(s.state, update)
+	require.Empty(t, s.state.TsSamples)
+	require.Zero(t, s.state.HistoryTs)
+	require.Nil(t, s.historyUpdate(now.Add(2*time.Second)))
+}
+
+func TestApplyHistoryUpdate(t *testing.T) {
+	state := &pb.MembershipState{}
+	start := time.Now().Add(-time.Hour).UnixNano()
+	sample := func(sec int64, ts uint64) *pb.HistoryUpdate {
+		return &pb.HistoryUpdate{
+			Sample: &pb.TsSample{UnixNano: start + sec*int64(time.Second), Ts: ts},
+			Since:  start + (sec-10)*int64(time.Second),
+		}
+	}
+
+	applyHistoryUpdate(state, sample(0, 5))
+	applyHistoryUpdate(state, sample(5, 8))
+	require.Len(t, state.TsSamples, 2)
+	require.Equal(t, uint64(5), state.HistoryTs)
+
+	// A stale sample isn't recorded.
+	applyHistoryUpdate(state, sample(6, 8))
+	require.Len(t, state.TsSamples, 2)
+
+	// The samples out of the window are dropped, except for the last one before it starts.
+	applyHistoryUpdate(state, sample(12, 20))
+	applyHistoryUpdate(state, sample(20, 30))
+	require.Equal(t, []*pb.TsSample{
+		{UnixNano: start + 5*int64(time.Second), Ts: 8},
+		{UnixNano: start + 12*int64(time.Second), Ts: 20},
+		{UnixNano: start + 20*int64(time.Second), Ts: 30},
+	}, state.TsSamples)
+	require.Equal(t, uint64(8), state.HistoryTs)
+
+	// The number of samples is capped.
+	state = &pb.MembershipState{}
+	for i := 0; i < maxTsSamples+10; i++ {
+		applyHistoryUpdate(state, &pb.HistoryUpdate{
+			Sample: &pb.TsSample{UnixNano: start + int64(i), Ts: uint64(i + 1)},
+			Since:  start,
+		})
+	}
+	require.Len(t, state.TsSamples, maxTsSamples)
+	require.Equal(t, uint64(11), state.HistoryTs)
+}
+
+func TestTsAt(t *testing.T) {
+	s := &Server{state: &pb.MembershipState{}}
+	now := time.Now()
+	_, err := s.tsAt(now)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "--history_retention")
+
+	start := now.Add(-time.Hour)
+	for i, ts := range []uint64{10, 20, 30} {
+		at := start.Add(time.Duration(i) * time.Minute)
+		applyHistoryUpdate(s.state, &pb.HistoryUpdate{
+			Sample: &pb.TsSample{UnixNano: at.UnixNano(), Ts: ts},
+			Since:  start.UnixNano(),
+		})
+	}
+
+	tests := []struct {
+		at  time.Time
+		ts  uint64
+		err string
+	}{
+		{at: start.Add(-time.Second), err: "older than the history kept"},
+		{at: start, ts: 10},
+		{at: start.Add(30 * time.Second), ts: 10},
+		{at: start.Add(time.Minute), ts: 20},
+		{at: start.Add(90 * time.Second), ts: 20},
+		{at: now, ts: 30},
+		{at: now.Add(time.Hour), err: "in the future"},
+	}
+	for _, tc := range tests {
+		ts, err := s.tsAt(tc.at)
+		if tc.err != "" {
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.ts, ts, "at: %s", tc.at)
+	}
+}
+
+func TestAsOfTs(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	s := &Server{
+		state: &pb.MembershipState{
+			Groups: map[uint32]*pb.Group{
+				1: {SnapshotTs: 50},
+				2: {SnapshotTs: 40},
+			},
+		},
+		orc: &Oracle{maxAssigned: 100},
+	}
+
+	// Without history retention, the versions below the latest snapshot may be discarded.
+	ts, err := s.asOfTs(&pb.TsSample{Ts: 60})
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), ts)
+	_, err = s.asOfTs(&pb.TsSample{Ts: 45})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "below the history watermark 50")
+	_, err = s.asOfTs(&pb.TsSample{Ts: 101})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "ahead of the max assigned ts 100")
+	_, err = s.asOfTs(&pb.TsSample{UnixNano: start.UnixNano()})
+	require.Error(t, err)
+
+	// With history retention, the versions since the history ts are kept.
+	applyHistoryUpdate(s.state, &pb.HistoryUpdate{
+		Sample: &pb.TsSample{UnixNano: start.UnixNano(), Ts: 20},
+		Since:  start.Add(-time.Hour).UnixNano(),
+	})
+	applyHistoryUpdate(s.state, &pb.HistoryUpdate{
+		Sample: &pb.TsSample{UnixNano: start.Add(time.Minute).UnixNano(), Ts: 70},
+		Since:  start.Add(-time.Hour).UnixNano(),
+	})
+	require.Equal(t, uint64(20), s.historyWatermark())
+
+	ts, err = s.asOfTs(&pb.TsSample{Ts: 45})
+	require.NoError(t, err)
+	require.Equal(t, uint64(45), ts)
+	_, err = s.asOfTs(&pb.TsSample{Ts: 15})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "below the history watermark 20")
+	ts, err = s.asOfTs(&pb.TsSample{UnixNano: start.Add(30 * time.Second).UnixNano()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), ts)
+}
+
+func TestHistoryPersisted(t *testing.T) {
+	s := &Server{state: &pb.MembershipState{}}
+	start := time.Now().Add(-time.Hour)
+	applyHistoryUpdate(s.state, &pb.HistoryUpdate{
+		Sample: &pb.TsSample{UnixNano: start.UnixNano(), Ts: 10},
+		Since:  start.UnixNano(),
+	})
+
+	// The samples are part of the state kept in the snapshots of Zero, but aren't streamed to
+	// the alphas.
+	data, err := s.MarshalMembershipState()
+	require.NoError(t, err)
+	require.Empty(t, s.membershipState().TsSamples)
+	require.Equal(t, uint64(10), s.membershipState().HistoryTs)
+	require.Len(t, s.state.TsSamples, 1)
+
+	var state pb.MembershipState
+	require.NoError(t, state.Unmarshal(data))
+	restarted := &Server{}
+	restarted.SetMembershipState(&state)
+	ts, err := restarted.tsAt(start.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), ts)
+}
@@ -386,6 +386,9 @@ func (n *node) applyProposal(e raftpb.Entry) (string, error) {
 	if p.Txn != nil {
 		n.server.orc.updateCommitStatus(e.Index, p.Txn)
 	}
+	if p.History != nil {
+		applyHistoryUpdate(state, p.History)
+	}
 
 	return p.Key, nil
 }
@@ -689,7 +692,7 @@ func (n *node) Run() {
 	// snapshot can cause select loop to block while deleting entries, so run
 	// it in goroutine
 	readStateCh := make(chan raft.ReadState, 100)
-	closer := z.NewCloser(5)
+	closer := z.NewCloser(6)
 	defer func() {
 		closer.SignalAndWait()
 		n.closer.Done()
@@ -699,6 +702,7 @@ func (n *node) Run() {
 	go n.snapshotPeriodically(closer)
 	go n.updateEnterpriseState(closer)
 	go n.updateZeroMembershipPeriodically(closer)
+	go n.recordHistoryPeriodically(closer)
 	go n.checkQuorum(closer)
 	go n.RunReadIndexLoop(closer, readStateCh)
 	if !x.WorkerConfig.HardSync {
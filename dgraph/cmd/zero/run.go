@@ -51,6 +51,7 @@ type options struct {
 	peer              string
 	w                 string
 	rebalanceInterval time.Duration
+	historyRetention  time.Duration
 
 	totalCache int64
 }
@@ -88,6 +89,10 @@ instances to achieve high-availability.
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
 	flag.Duration("rebalance_interval", 8*time.Minute, "Interval for trying a predicate move.")
 	flag.String("enterprise_license", "", "Path to the enterprise license file.")
+	flag.Duration("history_retention", 0,
+		"How long the alphas keep old versions of the data around for point-in-time queries, "+
+			"which read at the ts or time given by the as_of option. A value of 0 only keeps "+
+			"the versions since the last snapshot, and disables the as_of option given a time.")
 }
 
 func setupListener(addr string, port int, kind string) (listener net.Listener, err error) {
@@ -169,6 +174,7 @@ func run() {
 		peer:              Zero.Conf.GetString("peer"),
 		w:                 Zero.Conf.GetString("wal"),
 		rebalanceInterval: Zero.Conf.GetDuration("rebalance_interval"),
+		historyRetention:  Zero.Conf.GetDuration("history_retention"),
 		totalCache:        int64(Zero.Conf.GetInt("cache_mb")),
 	}
 	glog.Infof("Setting Config to: %+v", opts)
@@ -198,6 +204,10 @@ func run() {
 		log.Fatalf("ERROR: Rebalance interval must be greater than zero. Found: %d",
 			opts.rebalanceInterval)
 	}
+	if opts.historyRetention < 0 {
+		log.Fatalf("ERROR: History retention can't be negative. Found: %s",
+			opts.historyRetention)
+	}
 
 	grpc.EnableTracing = false
 	otrace.ApplyConfig(otrace.Config{
@@ -251,7 +251,10 @@ func (s *Server) MarshalMembershipState() ([]byte, error) {
 func (s *Server) membershipState() *pb.MembershipState {
 	s.RLock()
 	defer s.RUnlock()
-	return proto.Clone(s.state).(*pb.MembershipState)
+	// The ts samples are only used by Zero, don't copy them to every alpha every second.
+	state := *s.state
+	state.TsSamples = nil
+	return proto.Clone(&state).(*pb.MembershipState)
 }
 
 func (s *Server) groupChecksums() map[uint32]uint64 {
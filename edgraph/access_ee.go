@@ -16,6 +16,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -487,6 +488,22 @@ func extractUserAndGroups(ctx context.Context) ([]string, error) {
 	return validateToken(accessJwt[0])
 }
 
+// aclIdentity returns the user and the groups that the request in ctx is run as, so that the
+// results cached for a query are only returned to the same identity. It's empty if ACL isn't
+// enabled.
+func aclIdentity(ctx context.Context) (string, error) {
+	if len(worker.Config.HmacSecret) == 0 {
+		return "", nil
+	}
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return "", err
+	}
+	groupIds := append([]string{}, userData[1:]...)
+	sort.Strings(groupIds)
+	return userData[0] + ":" + strings.Join(groupIds, ","), nil
+}
+
 func authorizePreds(userId string, groupIds, preds []string,
 	aclOp *acl.Operation) (map[string]struct{}, []string) {
 
@@ -0,0 +1,284 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"container/list"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	ostats "go.opencensus.io/stats"
+)
+
+const (
+	// maxResultFraction bounds the size of a cached result to a fraction of the size of the
+	// cache, so that a few large results can't evict all the others.
+	maxResultFraction = 16
+	// resultOverhead is roughly the memory taken by a cached result besides its contents.
+	resultOverhead = 256
+)
+
+// resultCache is an LRU cache of the results of queries. A result read at some ts is returned
+// for a read at another ts as long as no commit changed the predicates read by the query in
+// between, as told by the oracle.
+type resultCache struct {
+	sync.Mutex
+	maxSize int64
+	size    int64
+	lru     *list.List
+	results map[string]*list.Element
+}
+
+// cachedResult is the result of a query read at readTs.
+type cachedResult struct {
+	key     string
+	json    []byte
+	numUids map[string]uint64
+	readTs  uint64
+	// resets is the number of times the oracle was reset before the query was processed.
+	resets uint64
+	// preds are the predicates read by the query. local is true if they're all served by the
+	// group of this Alpha, whose oracle only sees the commits made to the group.
+	preds []string
+	local bool
+	size  int64
+}
+
+var (
+	qcache     *resultCache
+	qcacheOnce sync.Once
+)
+
+// queryCache returns the cache of query results, or nil if it's disabled.
+func queryCache() *resultCache {
+	qcacheOnce.Do(func() {
+		if x.Config.QueryCacheMB > 0 {
+			qcache = newResultCache(int64(x.Config.QueryCacheMB) << 20)
+		}
+	})
+	return qcache
+}
+
+func newResultCache(maxSize int64) *resultCache {
+	return &resultCache{
+		maxSize: maxSize,
+		lru:     list.New(),
+		results: make(map[string]*list.Element),
+	}
+}
+
+// get returns the result cached under key if it holds when read at ts, or nil otherwise.
+func (c *resultCache) get(key string, ts uint64) *cachedResult {
+	c.Lock()
+	defer c.Unlock()
+	elem, ok := c.results[key]
+	if !ok {
+		return nil
+	}
+	res := elem.Value.(*cachedResult)
+	if !res.validAt(ts) {
+		// Reads happen at increasing timestamps, the result isn't likely to be used again.
+		if ts >= res.readTs {
+			c.remove(elem)
+			ostats.Record(context.Background(), x.QueryCacheSize.M(c.size))
+		}
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return res
+}
+
+// set caches res, evicting the least recently used results if the cache is full.
+func (c *resultCache) set(res *cachedResult) {
+	res.size = int64(len(res.key)+len(res.json)) + resultOverhead
+	for _, pred := range res.preds {
+		res.size += int64(len(pred))
+	}
+	for attr := range res.numUids {
+		res.size += int64(len(attr)) + 8
+	}
+	if res.size > c.maxSize/maxResultFraction {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if elem, ok := c.results[res.key]; ok {
+		c.remove(elem)
+	}
+	c.results[res.key] = c.lru.PushFront(res)
+	c.size += res.size
+	for c.size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+	ostats.Record(context.Background(), x.QueryCacheSize.M(c.size))
+}
+
+// remove removes the result in elem from the cache. It must be called with the lock held.
+func (c *resultCache) remove(elem *list.Element) {
+	res := c.lru.Remove(elem).(*cachedResult)
+	delete(c.results, res.key)
+	c.size -= res.size
+}
+
+// validAt returns true if the result still holds when the query is read at ts. That's the case
+// if none of the predicates read by the query were changed by the commits between readTs and
+// ts, and all the commits up to ts have been seen by the oracle.
+func (res *cachedResult) validAt(ts uint64) bool {
+	o := posting.Oracle()
+	switch {
+	case o.Resets() != res.resets || o.MaxAssigned() < ts:
+		return false
+	case !res.local:
+		// The commits made to other groups aren't seen here.
+		return ts == res.readTs
+	}
+	lastCommitTs := o.LastCommitTs(res.preds)
+	return lastCommitTs <= ts && lastCommitTs <= res.readTs
+}
+
+// resultCacheKey returns the key of the result of the query of qc in the query result cache,
+// or an empty string if the result can't be cached. Only the queries that read committed data
+// at a ts given by the oracle, without debug or explain mode, are cached.
+func resultCacheKey(ctx context.Context, qc *queryContext, doAuth AuthMode) string {
+	req := qc.req
+	switch {
+	case queryCache() == nil || x.WorkerConfig.LudicrousMode:
+		return ""
+	case len(req.Query) == 0 || len(req.Mutations) > 0 || qc.gqlRes.Schema != nil:
+		return ""
+	case req.RespFormat != api.Request_JSON || doAuth != NeedAuthorize:
+		return ""
+	case req.StartTs != 0 && !req.ReadOnly:
+		// The query reads the uncommitted data of its transaction.
+		return ""
+	case query.IsDebug(ctx) || ctx.Value(query.ExplainKey) != nil:
+		return ""
+	}
+	identity, err := aclIdentity(ctx)
+	if err != nil {
+		return ""
+	}
+	return cacheKey(req, identity)
+}
+
+// cacheKey returns the key of the result of req when it's run as identity. It's made of the
+// normalized query, along with its variables and identity.
+func cacheKey(req *api.Request, identity string) string {
+	var b strings.Builder
+	write := func(s string) {
+		b.WriteString(strconv.Itoa(len(s)))
+		b.WriteByte(':')
+		b.WriteString(s)
+	}
+	write(identity)
+	write(normalizeQuery(req.Query))
+	names := make([]string, 0, len(req.Vars))
+	for name := range req.Vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		write(name)
+		write(req.Vars[name])
+	}
+	return b.String()
+}
+
+// normalizeQuery collapses the runs of whitespace in the query q into single spaces, outside of
+// strings. It stops at the first character that could start a regular expression, an IRI or a
+// comment, and keeps the rest of the query as it's written, so that queries that mean different
+// things never get the same key.
+func normalizeQuery(q string) string {
+	var b strings.Builder
+	b.Grow(len(q))
+	var inString, escaped, space bool
+	for i, r := range q {
+		switch {
+		case inString:
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == '"':
+				inString = false
+			}
+		case unicode.IsSpace(r):
+			space = true
+			continue
+		case r == '"':
+			inString = true
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		if !inString && (r == '/' || r == '<' || r == '#') {
+			b.WriteString(strings.TrimRightFunc(q[i:], unicode.IsSpace))
+			return b.String()
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// cachedResponse returns the response to the query of qc read at ts from the query result
+// cache, or nil if it's not cached.
+func cachedResponse(ctx context.Context, qc *queryContext, ts uint64) *api.Response {
+	res := queryCache().get(qc.cacheKey, ts)
+	if res == nil {
+		ostats.Record(ctx, x.QueryCacheMisses.M(1))
+		return nil
+	}
+	ostats.Record(ctx, x.QueryCacheHits.M(1))
+	qc.span.Annotatef(nil, "Result read at %d served from the cache", res.readTs)
+	return &api.Response{
+		Json:    res.json,
+		Txn:     &api.TxnContext{StartTs: ts},
+		Metrics: &api.Metrics{NumUids: res.numUids},
+	}
+}
+
+// cacheResponse caches the response to the query of qc, given the result of its execution. The
+// number of resets of the oracle must be read before the query is processed.
+func cacheResponse(qc *queryContext, er *query.ExecutionResult, resp *api.Response,
+	resets uint64) {
+	preds := er.Predicates()
+	if len(worker.Config.HmacSecret) > 0 {
+		// The rules of ACL decide what the query reads.
+		preds = x.Unique(append(preds, x.AllACLPredicates()...))
+	}
+	queryCache().set(&cachedResult{
+		key:     qc.cacheKey,
+		json:    resp.Json,
+		numUids: resp.Metrics.NumUids,
+		readTs:  qc.req.StartTs,
+		resets:  resets,
+		preds:   preds,
+		local:   worker.ServesPredicates(preds),
+	})
+}
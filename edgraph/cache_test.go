@@ -0,0 +1,118 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	tests := []struct {
+		query      string
+		normalized string
+	}{
+		{
+			query:      "{\n  q(func: eq(name, \"Alice\")) {\n    name\n  }\n}\n",
+			normalized: `{ q(func: eq(name, "Alice")) { name } }`,
+		},
+		{
+			// Strings are kept as they're written.
+			query:      `{ q(func: eq(name,  "Alice  \"B\"   C")) { name } }`,
+			normalized: `{ q(func: eq(name, "Alice  \"B\"   C")) { name } }`,
+		},
+		{
+			// So is everything after a regular expression.
+			query:      "{ q(func: regexp(name,  /a  b/)) {\n  name\n}}  ",
+			normalized: "{ q(func: regexp(name, /a  b/)) {\n  name\n}}",
+		},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.normalized, normalizeQuery(tc.query))
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	req := &api.Request{
+		Query: "query q($a: string) {\n  q(func: eq(name, $a)) { name }\n}",
+		Vars:  map[string]string{"$a": "Alice"},
+	}
+	key := cacheKey(req, "")
+	require.Equal(t, key, cacheKey(&api.Request{
+		Query: "query q($a: string) { q(func: eq(name, $a)) { name } }",
+		Vars:  map[string]string{"$a": "Alice"},
+	}, ""))
+
+	require.NotEqual(t, key, cacheKey(req, "alice:dev"))
+	req.Vars["$a"] = "Bob"
+	require.NotEqual(t, key, cacheKey(req, ""))
+}
+
+func TestResultCache(t *testing.T) {
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: 10})
+	resets := posting.Oracle().Resets()
+
+	cache := newResultCache(32 << 10)
+	cache.set(&cachedResult{key: "local", json: []byte(`{}`), readTs: 5, resets: resets,
+		preds: []string{"name"}, local: true})
+	cache.set(&cachedResult{key: "remote", json: []byte(`{}`), readTs: 5, resets: resets,
+		preds: []string{"name"}})
+
+	// No commit changed name, so the local result holds at any ts seen by the oracle.
+	require.NotNil(t, cache.get("local", 3))
+	require.NotNil(t, cache.get("local", 10))
+	require.Nil(t, cache.get("local", 11))
+	// Commits to other groups aren't seen, so a remote result only holds at its read ts.
+	require.NotNil(t, cache.get("remote", 5))
+	require.Nil(t, cache.get("remote", 10))
+	require.Nil(t, cache.get("remote", 5))
+
+	cache.set(&cachedResult{key: "local", json: []byte(`{}`), readTs: 5, resets: resets,
+		preds: []string{"name"}, local: true})
+	posting.Oracle().Reset()
+	require.Nil(t, cache.get("local", 5))
+	require.Zero(t, cache.size)
+}
+
+func TestResultCacheEviction(t *testing.T) {
+	resets := posting.Oracle().Resets()
+	cache := newResultCache(32 << 10)
+	set := func(key string, size int) {
+		cache.set(&cachedResult{key: key, json: make([]byte, size), resets: resets, local: true})
+	}
+
+	// Results larger than a fraction of the cache aren't cached.
+	set("large", 4<<10)
+	require.Nil(t, cache.get("large", 0))
+
+	// The cache holds 25 results of about 1KB.
+	for i := 0; i < 25; i++ {
+		set(strconv.Itoa(i), 1<<10)
+	}
+	require.NotNil(t, cache.get("0", 0))
+	set("25", 1<<10)
+	require.True(t, cache.size <= cache.maxSize)
+	// The least recently used result is evicted first.
+	require.NotNil(t, cache.get("0", 0))
+	require.Nil(t, cache.get("1", 0))
+	require.NotNil(t, cache.get("25", 0))
+}
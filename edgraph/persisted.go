@@ -0,0 +1,226 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// persistedQuery is a DQL query registered under an id, along with the query parsed once for
+// all the requests that run it.
+type persistedQuery struct {
+	query    string
+	prepared *gql.Prepared
+	// resets is the number of resets of the oracle when the query was read. The oracle is reset
+	// whenever the schema changes or data is dropped, which makes the query be read again.
+	resets uint64
+}
+
+// persistedQueries caches the persisted queries run by this Alpha, by id. It's cleared when
+// the persisted queries are changed.
+var persistedQueries = struct {
+	sync.RWMutex
+	queries map[string]*persistedQuery
+	// gen is incremented every time the cache is cleared, so that a query read before the cache
+	// is cleared isn't added to it after.
+	gen uint64
+}{queries: make(map[string]*persistedQuery)}
+
+// ResetPersistedQueries clears the cache of the persisted queries, so that they're read again
+// the next time they're run.
+func ResetPersistedQueries() {
+	persistedQueries.Lock()
+	defer persistedQueries.Unlock()
+	persistedQueries.queries = make(map[string]*persistedQuery)
+	persistedQueries.gen++
+}
+
+// AddPersistedQuery registers the DQL query under id, replacing the query registered under it
+// before, if any. The id defaults to the SHA-256 hash of the query. It returns the id.
+func AddPersistedQuery(ctx context.Context, id, query string) (string, error) {
+	query = strings.TrimSpace(query)
+	if len(query) == 0 {
+		return "", errors.Errorf("persisted query can't be empty")
+	}
+	if _, err := gql.Prepare(query); err != nil {
+		return "", errors.Wrapf(err, "invalid persisted query")
+	}
+	if id == "" {
+		id = fmt.Sprintf("%x", sha256.Sum256([]byte(query)))
+	}
+
+	strVal := func(s string) *api.Value {
+		return &api.Value{Val: &api.Value_StrVal{StrVal: s}}
+	}
+	req := &api.Request{
+		Query: `query q($id: string) {
+			q as var(func: eq(dgraph.dql.id, $id))
+		}`,
+		Vars: map[string]string{"$id": id},
+		Mutations: []*api.Mutation{
+			{
+				Set: []*api.NQuad{
+					{Subject: "uid(q)", Predicate: "dgraph.dql.id", ObjectValue: strVal(id)},
+					{Subject: "uid(q)", Predicate: "dgraph.dql.query", ObjectValue: strVal(query)},
+					{Subject: "uid(q)", Predicate: "dgraph.type", ObjectValue: strVal("dgraph.dql")},
+				},
+			},
+		},
+		CommitNow: true,
+	}
+	if _, err := (&Server{}).doQuery(internalContext(ctx), req, NoAuthorize); err != nil {
+		return "", err
+	}
+	ResetPersistedQueries()
+	return id, nil
+}
+
+// DeletePersistedQuery removes the persisted query registered under id.
+func DeletePersistedQuery(ctx context.Context, id string) error {
+	req := &api.Request{
+		Query: `query q($id: string) {
+			q(func: eq(dgraph.dql.id, $id)) {
+				v as uid
+				dgraph.dql.id
+			}
+		}`,
+		Vars: map[string]string{"$id": id},
+		Mutations: []*api.Mutation{
+			{
+				DelNquads: []byte(`uid(v) * * .`),
+			},
+		},
+		CommitNow: true,
+	}
+	resp, err := (&Server{}).doQuery(internalContext(ctx), req, NoAuthorize)
+	if err != nil {
+		return err
+	}
+	ResetPersistedQueries()
+
+	var res struct {
+		Q []struct {
+			ID string `json:"dgraph.dql.id"`
+		} `json:"q"`
+	}
+	if err := json.Unmarshal(resp.Json, &res); err != nil {
+		return err
+	}
+	if len(res.Q) == 0 {
+		return errors.Errorf("persisted query %q not found", id)
+	}
+	return nil
+}
+
+// getPersistedQuery returns the persisted query registered under id, from the cache if it's
+// there and the schema hasn't changed since it was read.
+func getPersistedQuery(ctx context.Context, id string) (*persistedQuery, error) {
+	resets := posting.Oracle().Resets()
+	persistedQueries.RLock()
+	pq, ok := persistedQueries.queries[id]
+	gen := persistedQueries.gen
+	persistedQueries.RUnlock()
+	if ok && pq.resets == resets {
+		return pq, nil
+	}
+
+	req := &api.Request{
+		Query: `query q($id: string) {
+			q(func: eq(dgraph.dql.id, $id)) {
+				dgraph.dql.query
+			}
+		}`,
+		Vars:     map[string]string{"$id": id},
+		ReadOnly: true,
+	}
+	resp, err := (&Server{}).doQuery(internalContext(ctx), req, NoAuthorize)
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Q []struct {
+			Query string `json:"dgraph.dql.query"`
+		} `json:"q"`
+	}
+	if err := json.Unmarshal(resp.Json, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Q) == 0 {
+		return nil, errors.Errorf("persisted query %q not found", id)
+	}
+	prepared, err := gql.Prepare(res.Q[0].Query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing persisted query %q", id)
+	}
+
+	pq = &persistedQuery{query: res.Q[0].Query, prepared: prepared, resets: resets}
+	persistedQueries.Lock()
+	if persistedQueries.gen == gen {
+		persistedQueries.queries[id] = pq
+	}
+	persistedQueries.Unlock()
+	return pq, nil
+}
+
+// resolvePersistedQuery replaces the query of req with the persisted query whose id is given in
+// the metadata of ctx, if any, and returns the query already parsed. If only persisted queries
+// are allowed, the requests that give their own query are rejected.
+func resolvePersistedQuery(ctx context.Context, req *api.Request) (*gql.Prepared, error) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(x.PersistedQueryKey); len(vals) > 0 {
+			id = vals[0]
+		}
+	}
+	switch {
+	case id == "" && x.Config.PersistedQueriesOnly && len(req.Query) > 0:
+		return nil, errors.Errorf("only persisted queries are allowed")
+	case id == "":
+		return nil, nil
+	case len(req.Query) > 0:
+		return nil, errors.Errorf("a query can't be given along with a persisted query")
+	case len(req.Mutations) > 0:
+		return nil, errors.Errorf("persisted queries can't be used with mutations")
+	}
+
+	pq, err := getPersistedQuery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	req.Query = pq.query
+	return pq.prepared, nil
+}
+
+// internalContext returns the context of the requests made to manage the persisted queries.
+// They can change the reserved predicates, and they don't take the metadata of the request of
+// the client, like the id of its persisted query or the ts to read at, into account.
+func internalContext(ctx context.Context) context.Context {
+	ctx = metadata.NewIncomingContext(ctx, metadata.MD{})
+	return context.WithValue(ctx, IsGraphql, true)
+}
@@ -511,6 +511,14 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 		if err == zero.ErrConflict {
 			err = status.Error(codes.FailedPrecondition, err.Error())
 		}
+		if errors.Is(err, worker.ErrUniqueViolation) {
+			// The duplicate values were already written to the transaction, so it's aborted to
+			// keep them from being committed later on.
+			_, _ = worker.CommitOverNetwork(ctx, &api.TxnContext{
+				StartTs: qc.req.StartTs,
+				Aborted: true,
+			})
+		}
 
 		return err
 	}
@@ -868,6 +876,11 @@ type queryContext struct {
 	// 1B) and resulting in OOM. We are limiting number of nquads which can be inserted in
 	// a single request.
 	nquadsCount int
+	// cacheKey is the key of the result of the query in the query result cache. It's empty if
+	// the result isn't cached.
+	cacheKey string
+	// prepared is the persisted query run by the request, if any, already parsed.
+	prepared *gql.Prepared
 }
 
 // Health handles /health and /health?all requests.
@@ -891,16 +904,17 @@ func (s *Server) Health(ctx context.Context, all bool) (*api.Response, error) {
 	}
 	// Append self.
 	healthAll = append(healthAll, pb.HealthInfo{
-		Instance:   "alpha",
-		Address:    x.WorkerConfig.MyAddr,
-		Status:     "healthy",
-		Group:      strconv.Itoa(int(worker.GroupId())),
-		Version:    x.Version(),
-		Uptime:     int64(time.Since(x.WorkerConfig.StartTime) / time.Second),
-		LastEcho:   time.Now().Unix(),
-		Ongoing:    worker.GetOngoingTasks(),
-		Indexing:   schema.GetIndexingPredicates(),
-		EeFeatures: ee.GetEEFeaturesList(),
+		Instance:         "alpha",
+		Address:          x.WorkerConfig.MyAddr,
+		Status:           "healthy",
+		Group:            strconv.Itoa(int(worker.GroupId())),
+		Version:          x.Version(),
+		Uptime:           int64(time.Since(x.WorkerConfig.StartTime) / time.Second),
+		LastEcho:         time.Now().Unix(),
+		Ongoing:          worker.GetOngoingTasks(),
+		Indexing:         schema.GetIndexingPredicates(),
+		IndexingProgress: posting.IndexingProgress(),
+		EeFeatures:       ee.GetEEFeaturesList(),
 	})
 
 	var err error
@@ -989,6 +1003,13 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 	}
 
 	req.Query = strings.TrimSpace(req.Query)
+	// Only the requests of the clients can run persisted queries, or be restricted to them.
+	var prepared *gql.Prepared
+	if doAuth == NeedAuthorize && !isGraphQL {
+		if prepared, rerr = resolvePersistedQuery(ctx, req); rerr != nil {
+			return
+		}
+	}
 	isQuery := len(req.Query) != 0
 	if !isQuery && !isMutation {
 		span.Annotate(nil, "empty request")
@@ -1006,7 +1027,7 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 		ostats.Record(ctx, x.NumMutations.M(1))
 	}
 
-	qc := &queryContext{req: req, latency: l, span: span, graphql: isGraphQL}
+	qc := &queryContext{req: req, latency: l, span: span, graphql: isGraphQL, prepared: prepared}
 	if rerr = parseRequest(qc); rerr != nil {
 		return
 	}
@@ -1017,6 +1038,13 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 		}
 	}
 
+	if rerr = parseAsOf(ctx, qc); rerr != nil {
+		return
+	}
+	var explain *query.Explain
+	ctx, explain = attachExplain(ctx)
+	qc.cacheKey = resultCacheKey(ctx, qc, doAuth)
+
 	// We use defer here because for queries, startTs will be
 	// assigned in the processQuery function called below.
 	defer annotateStartTs(qc.span, qc.req.StartTs)
@@ -1045,9 +1073,66 @@ func (s *Server) doQuery(ctx context.Context, req *api.Request, doAuth AuthMode)
 	}
 	md := metadata.Pairs(x.DgraphCostHeader, fmt.Sprint(resp.Metrics.NumUids["_total"]))
 	grpc.SendHeader(ctx, md)
+	if explain != nil {
+		e := query.Extensions{Plan: explain.Plans(), Profile: explain.Profiles()}
+		if js, err := json.Marshal(e); err == nil {
+			grpc.SetTrailer(ctx, metadata.Pairs(x.ExplainKey, string(js)))
+		}
+	}
 	return resp, nil
 }
 
+// attachExplain returns a context that collects the plans and profiles of the query, if a gRPC
+// client runs it in explain mode by passing the explain metadata key. HTTP requests collect
+// them on their own, to return them in the extensions of the response.
+func attachExplain(ctx context.Context) (context.Context, *query.Explain) {
+	if ctx.Value(query.ExplainKey) != nil {
+		return ctx, nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	vals := md.Get(x.ExplainKey)
+	if len(vals) == 0 {
+		return ctx, nil
+	}
+	if isExplain, _ := strconv.ParseBool(vals[0]); !isExplain {
+		return ctx, nil
+	}
+	explain := &query.Explain{}
+	return context.WithValue(ctx, query.ExplainKey, explain), explain
+}
+
+// parseAsOf handles the as_of option, which runs a read-only query at the given timestamp or
+// time. It's passed as gRPC metadata, as api.Request has no field for it, and HTTP requests pass
+// it as a query parameter, which x.AttachAsOf moves into the metadata.
+func parseAsOf(ctx context.Context, qc *queryContext) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	vals := md.Get(x.AsOfKey)
+	if len(vals) == 0 {
+		return nil
+	}
+	switch {
+	case len(qc.req.Mutations) > 0:
+		return errors.Errorf("as_of can't be used with mutations")
+	case qc.req.StartTs != 0:
+		return errors.Errorf("as_of can't be used within a transaction")
+	}
+
+	ts, err := worker.AsOfTs(ctx, vals[0])
+	if err != nil {
+		return err
+	}
+	qc.span.Annotatef(nil, "Reading as of ts: %d", ts)
+	qc.req.StartTs = ts
+	qc.req.ReadOnly = true
+	return nil
+}
+
 func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error) {
 	resp := &api.Response{}
 	if len(qc.req.Query) == 0 {
@@ -1100,6 +1185,19 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	qr.ReadTs = qc.req.StartTs
 	resp.Txn = &api.TxnContext{StartTs: qc.req.StartTs}
 
+	var resets uint64
+	if qc.cacheKey != "" {
+		// The cache can only tell if a result holds at the read ts once the oracle has seen all
+		// the commits up to it.
+		if err := posting.Oracle().WaitForTs(ctx, qc.req.StartTs); err != nil {
+			return resp, err
+		}
+		if cached := cachedResponse(ctx, qc, qc.req.StartTs); cached != nil {
+			return cached, nil
+		}
+		resets = posting.Oracle().Resets()
+	}
+
 	// Core processing happens here.
 	er, err := qr.Process(ctx)
 	if err != nil {
@@ -1186,6 +1284,9 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	}
 	resp.Metrics.NumUids["_total"] = total
 
+	if qc.cacheKey != "" {
+		cacheResponse(qc, &er, resp, resets)
+	}
 	return resp, err
 }
 
@@ -1225,10 +1326,15 @@ func parseRequest(qc *queryContext) error {
 
 	// parsing the updated query
 	var err error
-	qc.gqlRes, err = gql.ParseWithNeedVars(gql.Request{
-		Str:       upsertQuery,
-		Variables: qc.req.Vars,
-	}, needVars)
+	if qc.prepared != nil {
+		// A persisted query is parsed once, only its variables are left to substitute.
+		qc.gqlRes, err = qc.prepared.Bind(qc.req.Vars)
+	} else {
+		qc.gqlRes, err = gql.ParseWithNeedVars(gql.Request{
+			Str:       upsertQuery,
+			Variables: qc.req.Vars,
+		}, needVars)
+	}
 	if err != nil {
 		return err
 	}
@@ -1537,6 +1643,14 @@ func formatTypes(typeList []*pb.TypeUpdate) []map[string]interface{} {
 		}
 		typeMap["fields"] = fields
 
+		if len(typ.Indexes) > 0 {
+			indexes := make([][]string, len(typ.Indexes))
+			for i, index := range typ.Indexes {
+				indexes[i] = index.Predicates
+			}
+			typeMap["indexes"] = indexes
+		}
+
 		res = append(res, typeMap)
 	}
 	return res
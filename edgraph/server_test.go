@@ -17,12 +17,14 @@
 package edgraph
 
 import (
+	"context"
 	"testing"
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
 	"github.com/dgraph-io/dgraph/chunker"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 )
 
 func makeNquad(sub, pred string, val *api.Value) *api.NQuad {
@@ -119,3 +121,63 @@ func TestValidateKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAsOf(t *testing.T) {
+	withAsOf := func(asOf string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(x.AsOfKey, asOf))
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		req  *api.Request
+		err  string
+	}{
+		{name: "no metadata", ctx: context.Background(), req: &api.Request{Query: "{}"}},
+		{
+			name: "no as_of",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("foo", "bar")),
+			req:  &api.Request{Query: "{}"},
+		},
+		{
+			name: "with mutations",
+			ctx:  withAsOf("10"),
+			req:  &api.Request{Mutations: []*api.Mutation{{SetNquads: []byte(`_:a <p> "v" .`)}}},
+			err:  "as_of can't be used with mutations",
+		},
+		{
+			name: "within a transaction",
+			ctx:  withAsOf("10"),
+			req:  &api.Request{Query: "{}", StartTs: 5},
+			err:  "as_of can't be used within a transaction",
+		},
+		{
+			name: "invalid value",
+			ctx:  withAsOf("yesterday"),
+			req:  &api.Request{Query: "{}"},
+			err:  "as_of must be a timestamp or a time in RFC 3339 format",
+		},
+		{
+			name: "zero ts",
+			ctx:  withAsOf("0"),
+			req:  &api.Request{Query: "{}"},
+			err:  "as_of must be a timestamp or a time in RFC 3339 format",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			startTs := tc.req.StartTs
+			err := parseAsOf(tc.ctx, &queryContext{req: tc.req})
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+			}
+			// The request is left untouched unless the query is run as of a ts.
+			require.Equal(t, startTs, tc.req.StartTs)
+			require.False(t, tc.req.ReadOnly)
+		})
+	}
+}
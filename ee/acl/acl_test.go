@@ -2090,6 +2090,19 @@ func TestSchemaQueryWithACL(t *testing.T) {
       	],
       	"upsert": true
 	},
+    {
+      "predicate": "dgraph.dql.id",
+      "type": "string",
+      "index": true,
+      "tokenizer": [
+        "exact"
+      ],
+      "upsert": true
+    },
+    {
+      "predicate": "dgraph.dql.query",
+      "type": "string"
+    },
     {
       "predicate": "dgraph.graphql.schema",
       "type": "string"
@@ -2154,6 +2167,17 @@ func TestSchemaQueryWithACL(t *testing.T) {
     }
   ],
   "types": [
+    {
+      "fields": [
+        {
+          "name": "dgraph.dql.id"
+        },
+        {
+          "name": "dgraph.dql.query"
+        }
+      ],
+      "name": "dgraph.dql"
+    },
     {
       "fields": [
         {
@@ -2225,6 +2249,10 @@ func TestSchemaQueryWithACL(t *testing.T) {
     }
   ],
   "types": [
+    {
+      "fields": [],
+      "name": "dgraph.dql"
+    },
     {
       "fields": [],
       "name": "dgraph.graphql"
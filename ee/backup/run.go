@@ -277,7 +277,7 @@ func initExportBackup() {
 	flag.StringVarP(&opt.destination, "destination", "d", "",
 		"The folder to which export the backups.")
 	flag.StringVarP(&opt.format, "format", "f", "rdf",
-		"The format of the export output. Accepts a value of either rdf or json")
+		"The format of the export output. Accepts a value of rdf, json, csv or parquet")
 	enc.RegisterFlags(flag)
 }
 
@@ -37,6 +37,10 @@ const (
 	lenFunc   = "len"
 	countFunc = "count"
 	uidInFunc = "uid_in"
+	simFunc   = "similar_to"
+
+	// CursorAttr asks for the cursor of every node of a block, to resume after it with after.
+	CursorAttr = "_cursor_"
 )
 
 var (
@@ -73,8 +77,11 @@ type GraphQuery struct {
 	Facets           *pb.FacetParams
 	FacetsFilter     *FilterTree
 	GroupbyAttrs     []GroupByAttr
+	GroupbyArgs      GroupbyArgs
 	FacetVar         map[string]string
 	FacetsOrder      []*FacetOrder
+	// Cursor is true if the cursor of every node is asked for with _cursor_.
+	Cursor bool
 
 	// Used for ACL enabled queries to curtail results to only accessible params
 	AllowedPreds []string
@@ -92,10 +99,26 @@ type GraphQuery struct {
 type RecurseArgs struct {
 	Depth     uint64
 	AllowLoop bool
-	varMap    map[string]string //varMap holds the variable args name. So, that we can substitute the
+	// Paths is true if the root-to-leaf paths of the recursion should be returned.
+	Paths bool
+	// DetectCycles is true if the cycles found during the recursion should be returned.
+	DetectCycles bool
+	varMap       map[string]string //varMap holds the variable args name. So, that we can substitute the
 	// argument in the substitution part.
 }
 
+// setFlag sets the boolean argument of @recurse with the given key.
+func (args *RecurseArgs) setFlag(key string, val bool) {
+	switch key {
+	case "loop":
+		args.AllowLoop = val
+	case "paths":
+		args.Paths = val
+	case "detectcycles":
+		args.DetectCycles = val
+	}
+}
+
 // ShortestPathArgs stores the arguments needed to process the shortest path query.
 type ShortestPathArgs struct {
 	// From, To can have a uid or a uid function as the argument.
@@ -104,6 +127,13 @@ type ShortestPathArgs struct {
 	// 3. from: uid(p) // a variable
 	From *Function
 	To   *Function
+	// Avoid and Via can have uids or a uid function as the argument, the path must not go
+	// through any of the nodes in Avoid and must go through at least one of the nodes in Via.
+	// 1. avoid: 0x01
+	// 2. avoid: uid(0x01, 0x02)
+	// 3. avoid: uid(p) // a variable
+	Avoid *Function
+	Via   *Function
 }
 
 // GroupByAttr stores the arguments needed to process the @groupby directive.
@@ -111,6 +141,20 @@ type GroupByAttr struct {
 	Attr  string
 	Alias string
 	Langs []string
+	// Path holds the uid predicates traversed to reach Attr, for attributes like school/name
+	// which are more than one hop away.
+	Path []string
+}
+
+// GroupbyArgs stores the ordering, pagination and having filter of the groups formed by the
+// @groupby directive.
+type GroupbyArgs struct {
+	// Order holds the aggregations or grouping attributes to sort the groups by.
+	Order []*pb.Order
+	// Args holds the first and offset arguments.
+	Args map[string]string
+	// Having filters the groups by their aggregated values.
+	Having *FilterTree
 }
 
 // FacetOrder stores ordering for single facet key.
@@ -223,7 +267,8 @@ var mathOpPrecedence = map[string]int{
 
 // IsAggregator returns true if the function name is an aggregation function.
 func (f *Function) IsAggregator() bool {
-	return isAggregator(f.Name)
+	// count(distinct ...) is parsed as the countdistinct aggregation function.
+	return isAggregator(f.Name) || f.Name == "countdistinct"
 }
 
 // IsPasswordVerifier returns true if the function name is "checkpwd".
@@ -419,6 +464,18 @@ func substituteVariables(gq *GraphQuery, vmap varMap) error {
 			return err
 		}
 	}
+	for k, v := range gq.GroupbyArgs.Args {
+		val := v
+		if err := substituteVar(v, &val, vmap); err != nil {
+			return err
+		}
+		gq.GroupbyArgs.Args[k] = val
+	}
+	if gq.GroupbyArgs.Having != nil {
+		if err := substituteVariablesFilter(gq.GroupbyArgs.Having, vmap); err != nil {
+			return err
+		}
+	}
 	if gq.RecurseArgs.varMap != nil {
 		// Update the depth if the get the depth as a variable in the query.
 		varName, ok := gq.RecurseArgs.varMap["depth"]
@@ -434,18 +491,21 @@ func substituteVariables(gq *GraphQuery, vmap varMap) error {
 			gq.RecurseArgs.Depth = depth
 		}
 
-		// Update the loop if the get the loop as a variable in the query.
-		varName, ok = gq.RecurseArgs.varMap["loop"]
-		if ok {
+		// Update the loop, paths and detectcycles if we get them as variables in the query.
+		for _, key := range []string{"loop", "paths", "detectcycles"} {
+			varName, ok = gq.RecurseArgs.varMap[key]
+			if !ok {
+				continue
+			}
 			val, ok := vmap[varName]
 			if !ok {
 				return errors.Errorf("variable %s not defined", varName)
 			}
-			allowLoop, err := strconv.ParseBool(val.Value)
+			flag, err := strconv.ParseBool(val.Value)
 			if err != nil {
 				return errors.Wrapf(err, varName+"should be type of boolean")
 			}
-			gq.RecurseArgs.AllowLoop = allowLoop
+			gq.RecurseArgs.setFlag(key, flag)
 		}
 
 	}
@@ -563,102 +623,138 @@ func Parse(r Request) (Result, error) {
 // The variable name v needs to be passed through the needVars parameter. Otherwise, an error
 // is reported complaining that the variable v is defined but not used in the query block.
 func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
-	query := r.Str
+	res, fmap, decls, err := parseBlocks(r.Str)
+	if err != nil {
+		return res, err
+	}
 	vmap := convertToVarMap(r.Variables)
+	if decls != nil {
+		if err := declareVariables(vmap, decls); err != nil {
+			return res, err
+		}
+	}
 
+	if len(res.Query) != 0 {
+		res.QueryVars = make([]*Vars, 0, len(res.Query))
+		for i := 0; i < len(res.Query); i++ {
+			qu := res.Query[i]
+			// Try expanding fragments using fragment map.
+			if err := qu.expandFragments(fmap); err != nil {
+				return res, err
+			}
+
+			// Substitute all graphql variables with corresponding values
+			if err := substituteVariables(qu, vmap); err != nil {
+				return res, err
+			}
+
+			res.QueryVars = append(res.QueryVars, &Vars{})
+			// Collect vars used and defined in Result struct.
+			qu.collectVars(res.QueryVars[i])
+		}
+
+		allVars := res.QueryVars
+		// Add the variables that are needed outside the query block.
+		// For example, mutation block in upsert block will be using
+		// variables from the query block that is getting parsed here.
+		if len(needVars) != 0 {
+			allVars = append(allVars, &Vars{Needs: needVars})
+		}
+		if err := checkDependency(allVars); err != nil {
+			return res, err
+		}
+	}
+
+	if err := validateResult(&res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// parseBlocks lexes the query and parses its query, schema and fragment blocks. The variables
+// declared by the query blocks are returned along with their default values, or nil if none
+// of the blocks has a variable list. Neither fragments nor variables are substituted yet.
+func parseBlocks(query string) (res Result, fmap fragmentMap, decls varMap, rerr error) {
 	var lexer lex.Lexer
 	lexer.Reset(query)
 	lexer.Run(lexTopLevel)
 	if err := lexer.ValidateResult(); err != nil {
-		return res, err
+		return res, nil, nil, err
 	}
 
 	var qu *GraphQuery
 	it := lexer.NewIterator()
-	fmap := make(fragmentMap)
+	fmap = make(fragmentMap)
 	for it.Next() {
 		item := it.Item()
 		switch item.Typ {
 		case itemOpType:
 			switch item.Val {
 			case "mutation":
-				return res, item.Errorf("Mutation block no longer allowed.")
+				return res, nil, nil, item.Errorf("Mutation block no longer allowed.")
 			case "schema":
 				if res.Schema != nil {
-					return res, item.Errorf("Only one schema block allowed ")
+					return res, nil, nil, item.Errorf("Only one schema block allowed ")
 				}
 				if res.Query != nil {
-					return res, item.Errorf("Schema block is not allowed with query block")
+					return res, nil, nil, item.Errorf(
+						"Schema block is not allowed with query block")
 				}
 				if res.Schema, rerr = getSchema(it); rerr != nil {
-					return res, rerr
+					return res, nil, nil, rerr
 				}
 			case "fragment":
 				// TODO(jchiu0): This is to be done in ParseSchema once it is ready.
 				fnode, rerr := getFragment(it)
 				if rerr != nil {
-					return res, rerr
+					return res, nil, nil, rerr
 				}
 				fmap[fnode.Name] = fnode
 			case "query":
 				if res.Schema != nil {
-					return res, item.Errorf("Schema block is not allowed with query block")
+					return res, nil, nil, item.Errorf(
+						"Schema block is not allowed with query block")
 				}
-				if qu, rerr = getVariablesAndQuery(it, vmap); rerr != nil {
-					return res, rerr
+				var qdecls varMap
+				if qu, qdecls, rerr = getVariablesAndQuery(it); rerr != nil {
+					return res, nil, nil, rerr
+				}
+				if qdecls != nil && decls == nil {
+					decls = make(varMap)
+				}
+				for name, v := range qdecls {
+					decls[name] = v
 				}
 				res.Query = append(res.Query, qu)
 			}
 		case itemLeftCurl:
 			if qu, rerr = getQuery(it); rerr != nil {
-				return res, rerr
+				return res, nil, nil, rerr
 			}
 			res.Query = append(res.Query, qu)
 		case itemName:
 			it.Prev()
 			if qu, rerr = getQuery(it); rerr != nil {
-				return res, rerr
+				return res, nil, nil, rerr
 			}
 			res.Query = append(res.Query, qu)
 		}
 	}
+	return res, fmap, decls, nil
+}
 
-	if len(res.Query) != 0 {
-		res.QueryVars = make([]*Vars, 0, len(res.Query))
-		for i := 0; i < len(res.Query); i++ {
-			qu := res.Query[i]
-			// Try expanding fragments using fragment map.
-			if err := qu.expandFragments(fmap); err != nil {
-				return res, err
-			}
-
-			// Substitute all graphql variables with corresponding values
-			if err := substituteVariables(qu, vmap); err != nil {
-				return res, err
-			}
-
-			res.QueryVars = append(res.QueryVars, &Vars{})
-			// Collect vars used and defined in Result struct.
-			qu.collectVars(res.QueryVars[i])
-		}
-
-		allVars := res.QueryVars
-		// Add the variables that are needed outside the query block.
-		// For example, mutation block in upsert block will be using
-		// variables from the query block that is getting parsed here.
-		if len(needVars) != 0 {
-			allVars = append(allVars, &Vars{Needs: needVars})
+// declareVariables merges the variables declared by a query into the variables passed with
+// it. The default value of a variable is used if no value was passed for it. The values are
+// then checked against the declared types.
+func declareVariables(vmap, decls varMap) error {
+	for name, decl := range decls {
+		if v := vmap[name].Value; v != "" {
+			decl.Value = v
 		}
-		if err := checkDependency(allVars); err != nil {
-			return res, err
-		}
-	}
-
-	if err := validateResult(&res); err != nil {
-		return res, err
+		vmap[name] = decl
 	}
-
-	return res, nil
+	return checkValueType(vmap)
 }
 
 func validateResult(res *Result) error {
@@ -743,6 +839,14 @@ func (gq *GraphQuery) collectVars(v *Vars) {
 	if shortestPathTo != nil && len(shortestPathTo.NeedsVar) > 0 {
 		v.Needs = append(v.Needs, shortestPathTo.NeedsVar[0].Name)
 	}
+	for _, fn := range []*Function{gq.ShortestPathArgs.Avoid, gq.ShortestPathArgs.Via} {
+		if fn == nil {
+			continue
+		}
+		for _, fv := range fn.NeedsVar {
+			v.Needs = append(v.Needs, fv.Name)
+		}
+	}
 }
 
 func (f *MathTree) collectVars(v *Vars) {
@@ -781,10 +885,11 @@ func (f *FilterTree) hasVars() bool {
 	return false
 }
 
-// getVariablesAndQuery checks if the query has a variable list and stores it in
-// vmap. For variable list to be present, the query should have a name which is
-// also checked for. It also calls getQuery to create the GraphQuery object tree.
-func getVariablesAndQuery(it *lex.ItemIterator, vmap varMap) (gq *GraphQuery, rerr error) {
+// getVariablesAndQuery checks if the query has a variable list and returns the declared
+// variables, or nil if there's no list. For variable list to be present, the query should
+// have a name which is also checked for. It also calls getQuery to create the GraphQuery
+// object tree.
+func getVariablesAndQuery(it *lex.ItemIterator) (gq *GraphQuery, decls varMap, rerr error) {
 	var name string
 L2:
 	for it.Next() {
@@ -792,30 +897,27 @@ L2:
 		switch item.Typ {
 		case itemName:
 			if name != "" {
-				return nil, item.Errorf("Multiple word query name not allowed.")
+				return nil, nil, item.Errorf("Multiple word query name not allowed.")
 			}
 			name = item.Val
 		case itemLeftRound:
 			if name == "" {
-				return nil, item.Errorf("Variables can be defined only in named queries.")
-			}
-
-			if rerr = parseGqlVariables(it, vmap); rerr != nil {
-				return nil, rerr
+				return nil, nil, item.Errorf("Variables can be defined only in named queries.")
 			}
 
-			if rerr = checkValueType(vmap); rerr != nil {
-				return nil, rerr
+			decls = make(varMap)
+			if rerr = parseGqlVariables(it, decls); rerr != nil {
+				return nil, nil, rerr
 			}
 		case itemLeftCurl:
 			if gq, rerr = getQuery(it); rerr != nil {
-				return nil, rerr
+				return nil, nil, rerr
 			}
 			break L2
 		}
 	}
 
-	return gq, nil
+	return gq, decls, nil
 }
 
 // parseVarName returns the variable name.
@@ -891,7 +993,7 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 				}
 				gq.RecurseArgs.Depth = depth
 			}
-		case "loop":
+		case "loop", "paths", "detectcycles":
 			if item.Typ == itemDollar {
 				// Consume the variable name.
 				varName, err := parseVarName(it)
@@ -901,13 +1003,13 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 				if gq.RecurseArgs.varMap == nil {
 					gq.RecurseArgs.varMap = make(map[string]string)
 				}
-				gq.RecurseArgs.varMap["loop"] = varName
+				gq.RecurseArgs.varMap[key] = varName
 			} else {
-				allowLoop, err := strconv.ParseBool(val)
+				flag, err := strconv.ParseBool(val)
 				if err != nil {
-					return errors.New("Value inside loop should be type of boolean")
+					return errors.Errorf("Value inside %s should be type of boolean", key)
 				}
-				gq.RecurseArgs.AllowLoop = allowLoop
+				gq.RecurseArgs.setFlag(key, flag)
 			}
 		default:
 			return item.Errorf("Unexpected key: [%s] inside @recurse block", key)
@@ -924,6 +1026,65 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 	return nil
 }
 
+// parseAggregatorArgs parses the arguments of the aggregation function fname into child,
+// starting from the first item after the opening bracket. Inside @groupby, the function is
+// applied to a predicate, anywhere else it's applied to a value variable. The function
+// percentile also takes the percentile to compute, a number between 0 and 100.
+func parseAggregatorArgs(it *lex.ItemIterator, gq, child *GraphQuery, fname string) error {
+	if gq.IsGroupby {
+		item := it.Item()
+		attr := collectName(it, item.Val)
+		// Get language list, if present
+		items, err := it.Peek(1)
+		if err == nil && items[0].Typ == itemAt {
+			it.Next() // consume '@'
+			it.Next() // move forward
+			if child.Langs, err = parseLanguageList(it); err != nil {
+				return err
+			}
+		}
+		child.Attr = attr
+		child.IsInternal = false
+	} else {
+		if it.Item().Val != valueFunc {
+			return it.Errorf("Only variables allowed in aggregate functions. Got: %v",
+				it.Item().Val)
+		}
+		count, err := parseVarList(it, child)
+		if err != nil {
+			return err
+		}
+		if count != 1 {
+			return it.Errorf("Expected one variable inside val() of"+
+				" aggregator but got %v", count)
+		}
+		child.NeedsVar[len(child.NeedsVar)-1].Typ = ValueVar
+	}
+	child.Func = &Function{
+		Name:     fname,
+		NeedsVar: child.NeedsVar,
+	}
+	it.Next() // Skip the closing ')'
+	if fname != "percentile" {
+		return nil
+	}
+
+	if it.Item().Typ != itemComma || !it.Next() {
+		return it.Errorf("Function percentile expects a number between 0 and 100 as its " +
+			"second argument")
+	}
+	p := it.Item().Val
+	if v, err := strconv.ParseFloat(p, 64); err != nil || v < 0 || v > 100 {
+		return it.Errorf("Function percentile expects a number between 0 and 100 as its "+
+			"second argument. Got: %s", p)
+	}
+	child.Func.Args = append(child.Func.Args, Arg{Value: p})
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return it.Errorf("Expected ) after the arguments of percentile")
+	}
+	return nil
+}
+
 // getQuery creates a GraphQuery object tree by calling getRoot
 // and goDeep functions by looking at '{'.
 func getQuery(it *lex.ItemIterator) (gq *GraphQuery, rerr error) {
@@ -973,6 +1134,10 @@ L:
 				if err := parseGroupby(it, gq); err != nil {
 					return nil, err
 				}
+			case "having":
+				if err := parseHaving(it, gq); err != nil {
+					return nil, err
+				}
 			case "ignorereflex":
 				gq.IgnoreReflex = true
 			case "recurse":
@@ -1156,7 +1321,8 @@ func getSchema(it *lex.ItemIterator) (*pb.SchemaRequest, error) {
 	return nil, it.Errorf("Invalid schema block.")
 }
 
-// parseGqlVariables parses the the graphQL variable declaration.
+// parseGqlVariables parses the the graphQL variable declaration. The type and the default value
+// of every declared variable are stored in vmap.
 func parseGqlVariables(it *lex.ItemIterator, vmap varMap) error {
 	expectArg := true
 	if item, ok := it.PeekOne(); ok && item.Typ == itemRightRound {
@@ -1220,17 +1386,8 @@ loop:
 			it.Next()
 			item = it.Item()
 		}
-		// Insert the variable into the map. The variable might already be defiend
-		// in the variable list passed with the query.
-		if _, ok := vmap[varName]; ok {
-			vmap[varName] = varInfo{
-				Value: vmap[varName].Value,
-				Type:  varType,
-			}
-		} else {
-			vmap[varName] = varInfo{
-				Type: varType,
-			}
+		vmap[varName] = varInfo{
+			Type: varType,
 		}
 
 		// Check for '=' sign and optional default value.
@@ -1246,17 +1403,14 @@ loop:
 				return item.Errorf("Type ending with ! can't have default value: Got: %v", varType)
 			}
 
-			// If value is empty replace, otherwise ignore the default value
-			// as the intialised value will override the default value.
-			if vmap[varName].Value == "" {
-				uq, err := unquoteIfQuoted(it.Val)
-				if err != nil {
-					return err
-				}
-				vmap[varName] = varInfo{
-					Value: uq,
-					Type:  varType,
-				}
+			// The value passed with the query, if any, overrides the default value.
+			uq, err := unquoteIfQuoted(it.Val)
+			if err != nil {
+				return err
+			}
+			vmap[varName] = varInfo{
+				Value: uq,
+				Type:  varType,
 			}
 		case itemRightRound:
 			break loop
@@ -1625,7 +1779,7 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
 		return true
 	}
 	return false
@@ -1783,6 +1937,10 @@ L:
 				case isGeoFunc(function.Name):
 					err = parseGeoArgs(it, function)
 
+				case function.Name == simFunc:
+					// The vector is passed on as a single argument, like a geo point.
+					err = parseGeoArgs(it, function)
+
 				case IsInequalityFn(function.Name):
 					err = parseFuncArgs(it, function)
 
@@ -2217,13 +2375,35 @@ loop:
 					return item.Errorf("Expected predicate after %s:", alias)
 				}
 				if validKey(val) {
-					return item.Errorf("Can't use keyword %s as alias in groupby", val)
+					if val == "after" {
+						return item.Errorf("Can't use keyword %s as alias in groupby", val)
+					}
+					it.Next() // Consume the itemColon
+					if err := parseGroupbyArg(it, gq, val); err != nil {
+						return err
+					}
+					// The arguments don't count as attributes.
+					expectArg = false
+					continue
 				}
 				alias = val
 				it.Next() // Consume the itemColon
 				continue
 			}
 
+			// Attributes more than one hop away are given as a path, like school/name.
+			var path []string
+			for {
+				items, err := it.Peek(2)
+				if err != nil || items[0].Val != "/" || items[1].Typ != itemName {
+					break
+				}
+				path = append(path, val)
+				it.Next() // Consume the '/'
+				it.Next()
+				val = collectName(it, it.Item().Val)
+			}
+
 			var langs []string
 			items, err := it.Peek(1)
 			if err == nil && items[0].Typ == itemAt {
@@ -2238,6 +2418,7 @@ loop:
 				Attr:  val,
 				Alias: alias,
 				Langs: langs,
+				Path:  path,
 			}
 			alias = ""
 			gq.GroupbyAttrs = append(gq.GroupbyAttrs, attrLang)
@@ -2255,6 +2436,55 @@ loop:
 	return nil
 }
 
+// parseGroupbyArg parses the value of the key argument of the groupby directive, which orders
+// or paginates the groups.
+func parseGroupbyArg(it *lex.ItemIterator, gq *GraphQuery, key string) error {
+	if !it.Next() {
+		return it.Errorf("Expected a value for %s in groupby", key)
+	}
+	item := it.Item()
+	val := item.Val
+	if item.Typ == itemDollar {
+		it.Next()
+		item = it.Item()
+		val = "$" + item.Val
+	}
+	if item.Typ != itemName {
+		return item.Errorf("Expected a value for %s in groupby. Got: %v", key, item.Val)
+	}
+
+	switch key {
+	case "orderasc", "orderdesc":
+		// The groups can be sorted by an aggregation with an alias, or by a grouping attribute.
+		val = collectName(it, val)
+		gq.GroupbyArgs.Order = append(gq.GroupbyArgs.Order,
+			&pb.Order{Attr: val, Desc: key == "orderdesc"})
+	default:
+		if gq.GroupbyArgs.Args == nil {
+			gq.GroupbyArgs.Args = make(map[string]string)
+		}
+		if _, ok := gq.GroupbyArgs.Args[key]; ok {
+			return item.Errorf("Repeated %s in groupby", key)
+		}
+		gq.GroupbyArgs.Args[key] = val
+	}
+	return nil
+}
+
+// parseHaving parses the having directive, which filters the groups formed by the groupby
+// directive by their aggregated values, like @having(gt(count, 2)).
+func parseHaving(it *lex.ItemIterator, gq *GraphQuery) error {
+	if gq.GroupbyArgs.Having != nil {
+		return it.Errorf("Use AND, OR and round brackets instead of multiple having directives.")
+	}
+	filter, err := parseFilter(it)
+	if err != nil {
+		return err
+	}
+	gq.GroupbyArgs.Having = filter
+	return nil
+}
+
 // parseFilter parses the filter directive to produce a QueryFilter / parse tree.
 func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
 	it.Next()
@@ -2545,6 +2775,10 @@ func parseDirective(it *lex.ItemIterator, curp *GraphQuery) error {
 			if err := parseGroupby(it, curp); err != nil {
 				return err
 			}
+		case "having":
+			if err := parseHaving(it, curp); err != nil {
+				return err
+			}
 		default:
 			return item.Errorf("Unknown directive [%s]", item.Val)
 		}
@@ -2600,7 +2834,8 @@ func validKeyAtRoot(k string) bool {
 	switch k {
 	case "func", "orderasc", "orderdesc", "first", "offset", "after":
 		return true
-	case "from", "to", "numpaths", "minweight", "maxweight":
+	case "from", "to", "numpaths", "minweight", "maxweight", "allpaths", "avoid", "via",
+		"maxhops":
 		// Specific to shortest path
 		return true
 	case "depth":
@@ -2772,6 +3007,37 @@ loop:
 						" Got: %s", val)
 			}
 			assignShortestPathFn(fn, key)
+		case "avoid", "via":
+			if gq.Alias != "shortest" {
+				return gq, item.Errorf("%s only allowed for shortest path queries", key)
+			}
+
+			fn := &Function{Name: uidFunc}
+			peekIt, err := it.Peek(1)
+			if err != nil {
+				return nil, item.Errorf("Invalid query")
+			}
+			if peekIt[0].Val == uidFunc {
+				// The uids go into the function, and not into the uids of the block.
+				if fn, err = parseFunction(it, nil); err != nil {
+					return gq, err
+				}
+			} else {
+				it.Next()
+				item := it.Item()
+				val := collectName(it, item.Val)
+				uid, err := strconv.ParseUint(val, 0, 64)
+				if err != nil {
+					return nil, item.Errorf("%s in shortest path can only accept uid function or "+
+						"an uid. Got: %s", key, val)
+				}
+				fn.UID = append(fn.UID, uid)
+			}
+			if key == "avoid" {
+				gq.ShortestPathArgs.Avoid = fn
+			} else {
+				gq.ShortestPathArgs.Via = fn
+			}
 
 		default:
 			var val string
@@ -2900,7 +3166,13 @@ func validateEmptyBlockItem(it *lex.ItemIterator, val string) error {
 		fname = item.Val
 	}
 	ok := trySkipItemTyp(it, itemLeftRound)
-	if !ok || (!isMathBlock(fname) && !isAggregator(fname)) {
+	if ok && fname == "count" {
+		// count(distinct val(x)) is an aggregation too.
+		item, _ := tryParseItemType(it, itemName)
+		ok = item.Val == "distinct"
+		fname = "countdistinct"
+	}
+	if !ok || (!isMathBlock(fname) && !isAggregator(fname) && fname != "countdistinct") {
 		return it.Errorf("Only aggregation/math functions allowed inside empty blocks."+
 			" Got: %v", fname)
 	}
@@ -2981,6 +3253,13 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 			}
 
 			switch {
+			case val == CursorAttr:
+				if varName != "" || alias != "" {
+					return item.Errorf("%s can't have an alias or be a variable", CursorAttr)
+				}
+				gq.Cursor = true
+				curp = nil
+				continue
 			case valLower == "checkpwd":
 				child := &GraphQuery{
 					Args:  make(map[string]string),
@@ -3012,40 +3291,39 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					goto Fall
 				}
 				it.Next()
-				if gq.IsGroupby {
-					item = it.Item()
-					attr := collectName(it, item.Val)
-					// Get language list, if present
-					items, err := it.Peek(1)
-					if err == nil && items[0].Typ == itemAt {
-						it.Next() // consume '@'
-						it.Next() // move forward
-						if child.Langs, err = parseLanguageList(it); err != nil {
-							return err
-						}
-					}
-					child.Attr = attr
-					child.IsInternal = false
-				} else {
-					if it.Item().Val != valueFunc {
-						return it.Errorf("Only variables allowed in aggregate functions. Got: %v",
-							it.Item().Val)
-					}
-					count, err := parseVarList(it, child)
-					if err != nil {
-						return err
-					}
-					if count != 1 {
-						return it.Errorf("Expected one variable inside val() of"+
-							" aggregator but got %v", count)
-					}
-					child.NeedsVar[len(child.NeedsVar)-1].Typ = ValueVar
+				if err := parseAggregatorArgs(it, gq, child, valLower); err != nil {
+					return err
+				}
+				gq.Children = append(gq.Children, child)
+				curp = nil
+				continue
+			case isGraphAlgoFunc(valLower):
+				peekIt, err = it.Peek(1)
+				if err != nil {
+					return err
+				}
+				if peekIt[0].Typ != itemLeftRound {
+					goto Fall
+				}
+				if varName == "" && alias == "" {
+					return it.Errorf("Function %s should be used with a variable or have an alias",
+						valLower)
+				}
+				child := &GraphQuery{
+					Args:       make(map[string]string),
+					Var:        varName,
+					Alias:      alias,
+					IsInternal: true,
 				}
-				child.Func = &Function{
-					Name:     valLower,
-					NeedsVar: child.NeedsVar,
+				varName, alias = "", ""
+				it.Prev()
+				if child.Func, err = parseFunction(it, gq); err != nil {
+					return err
 				}
-				it.Next() // Skip the closing ')'
+				if err := validateGraphAlgoFunc(it, child.Func); err != nil {
+					return err
+				}
+				child.Attr = child.Func.Attr
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
@@ -3139,6 +3417,24 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				switch {
 				case peekIt[0].Typ == itemRightRound:
 					return it.Errorf("Cannot use count(), please use count(uid)")
+				case peekIt[0].Val == "distinct" && peekIt[1].Typ == itemName:
+					// count(distinct ...) is an aggregation over the distinct values.
+					count = notSeen
+					child := &GraphQuery{
+						Attr:       valueFunc,
+						Args:       make(map[string]string),
+						Var:        varName,
+						IsInternal: true,
+						Alias:      alias,
+					}
+					varName, alias = "", ""
+					it.Next() // Consume distinct.
+					it.Next()
+					if err := parseAggregatorArgs(it, gq, child, "countdistinct"); err != nil {
+						return err
+					}
+					gq.Children = append(gq.Children, child)
+					curp = nil
 				case peekIt[0].Val == uidFunc && peekIt[1].Typ == itemRightRound:
 					if gq.IsGroupby {
 						// count(uid) case which occurs inside @groupby
@@ -3301,7 +3597,35 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 }
 
 func isAggregator(fname string) bool {
-	return fname == "min" || fname == "max" || fname == "sum" || fname == "avg"
+	switch fname {
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance":
+		return true
+	}
+	return false
+}
+
+// isGraphAlgoFunc returns true if name is one of the graph algorithms that can be run over the
+// nodes of a block, like pagerank(follows).
+func isGraphAlgoFunc(name string) bool {
+	return name == "pagerank" || name == "components"
+}
+
+// validateGraphAlgoFunc checks the arguments of a graph algorithm function. The first argument
+// is always the predicate whose edges form the graph, pagerank can also be given the number of
+// iterations and the damping factor.
+func validateGraphAlgoFunc(it *lex.ItemIterator, f *Function) error {
+	if f.Attr == "" || len(f.NeedsVar) > 0 || f.IsCount {
+		return it.Errorf("Function %s expects a predicate as its first argument", f.Name)
+	}
+	maxArgs := 0
+	if f.Name == "pagerank" {
+		maxArgs = 2
+	}
+	if len(f.Args) > maxArgs {
+		return it.Errorf("Function %s expects at most %d arguments after the predicate. Got: %d",
+			f.Name, maxArgs, len(f.Args))
+	}
+	return nil
 }
 
 func isExpandFunc(name string) bool {
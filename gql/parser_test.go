@@ -1253,6 +1253,53 @@ func TestParseShortestPathWithUidVars(t *testing.T) {
 	require.Equal(t, 1, len(q.ShortestPathArgs.To.NeedsVar))
 }
 
+func TestParseShortestPathConstraints(t *testing.T) {
+	query := `{
+		a as var(func: uid(0x01))
+
+		shortest(from: 0x0a, to: 0x0b, allpaths: true, maxhops: 4, avoid: uid(a, 0x05),
+			via: 0x0c) {
+			friend
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	q := res.Query[1]
+	require.Equal(t, "true", q.Args["allpaths"])
+	require.Equal(t, "4", q.Args["maxhops"])
+	require.Empty(t, q.UID)
+
+	require.NotNil(t, q.ShortestPathArgs.Avoid)
+	require.Equal(t, "uid", q.ShortestPathArgs.Avoid.Name)
+	require.Equal(t, []uint64{0x05}, q.ShortestPathArgs.Avoid.UID)
+	require.Equal(t, 1, len(q.ShortestPathArgs.Avoid.NeedsVar))
+	require.Equal(t, "a", q.ShortestPathArgs.Avoid.NeedsVar[0].Name)
+
+	require.NotNil(t, q.ShortestPathArgs.Via)
+	require.Equal(t, []uint64{0x0c}, q.ShortestPathArgs.Via.UID)
+	require.Equal(t, []string{"a"}, res.QueryVars[1].Needs)
+}
+
+func TestParseShortestPathConstraintsError(t *testing.T) {
+	query := `{
+		me(func: uid(0x0a), avoid: 0x05) {
+			friend
+		}
+	}`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "avoid only allowed for shortest path queries")
+
+	query = `{
+		shortest(from: 0x0a, to: 0x0b, via: eq(name, "a")) {
+			friend
+		}
+	}`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "via in shortest path can only accept uid function or an uid")
+}
+
 func TestParseShortestPathInvalidFnError(t *testing.T) {
 	query := `{
 		shortest(from: eq(a), to: uid(b)) {
@@ -1647,6 +1694,36 @@ func TestParse_alias1(t *testing.T) {
 	require.Equal(t, childAttrs(res.Query[0].Children[1]), []string{"type.object.name.hi"})
 }
 
+func TestParseCursor(t *testing.T) {
+	query := `
+	{
+		me(func: has(name), orderasc: name, first: 2, after: AEAAAAAAAAAAAKACAVAAAA) {
+			name
+			_cursor_
+			friends(orderdesc: age) {
+				_cursor_
+			}
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.True(t, res.Query[0].Cursor)
+	require.Equal(t, "AEAAAAAAAAAAAKACAVAAAA", res.Query[0].Args["after"])
+	require.Len(t, res.Query[0].Children, 2)
+	require.True(t, res.Query[0].Children[1].Cursor)
+	require.Empty(t, res.Query[0].Children[1].Children)
+
+	query = `
+	{
+		me(func: has(name)) {
+			c: _cursor_
+		}
+	}`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "_cursor_ can't have an alias")
+}
+
 func TestParseBadAlias(t *testing.T) {
 	query := `
 		{
@@ -3254,7 +3331,7 @@ func TestParseGroupbyWithAliasForError(t *testing.T) {
 	query := `
 	query {
 		me(func: uid(0x1)) {
-			friends @groupby(first: 10, SchooL: school) {
+			friends @groupby(after: 10, SchooL: school) {
 				count(uid)
 			}
 			hometown
@@ -3263,7 +3340,7 @@ func TestParseGroupbyWithAliasForError(t *testing.T) {
 	}
 `
 	_, err := Parse(Request{Str: query})
-	require.Contains(t, err.Error(), "Can't use keyword first as alias in groupby")
+	require.Contains(t, err.Error(), "Can't use keyword after as alias in groupby")
 }
 
 func TestParseGroupbyError(t *testing.T) {
@@ -3285,6 +3362,108 @@ func TestParseGroupbyError(t *testing.T) {
 	require.Contains(t, err.Error(), "Only aggregator/count functions allowed inside @groupby")
 }
 
+func TestParseGroupbyOrderAndHaving(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(age, orderdesc: c, orderasc: age, first: 2, offset: 1)
+				@having(gt(c, 1) and lt(total, 100)) {
+				c: count(uid)
+				total: sum(salary)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	friends := res.Query[0].Children[0]
+	require.Equal(t, 1, len(friends.GroupbyAttrs))
+	require.Equal(t, "age", friends.GroupbyAttrs[0].Attr)
+	order := friends.GroupbyArgs.Order
+	require.Equal(t, 2, len(order))
+	require.Equal(t, "c", order[0].Attr)
+	require.True(t, order[0].Desc)
+	require.Equal(t, "age", order[1].Attr)
+	require.False(t, order[1].Desc)
+	require.Equal(t, map[string]string{"first": "2", "offset": "1"}, friends.GroupbyArgs.Args)
+	having := friends.GroupbyArgs.Having
+	require.NotNil(t, having)
+	require.Equal(t, "and", having.Op)
+	require.Equal(t, "gt", having.Child[0].Func.Name)
+	require.Equal(t, "c", having.Child[0].Func.Attr)
+	require.Equal(t, "1", having.Child[0].Func.Args[0].Value)
+	require.Equal(t, "total", having.Child[1].Func.Attr)
+}
+
+func TestParseGroupbyArgsWithVariables(t *testing.T) {
+	query := `
+	query test($first: int, $min: int) {
+		me(func: uid(0x1)) @groupby(age, first: $first) @having(ge(count, $min)) {
+			count(uid)
+		}
+	}
+`
+	res, err := Parse(Request{
+		Str:       query,
+		Variables: map[string]string{"$first": "3", "$min": "2"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "3", res.Query[0].GroupbyArgs.Args["first"])
+	require.Equal(t, "2", res.Query[0].GroupbyArgs.Having.Func.Args[0].Value)
+}
+
+func TestParseGroupbyPath(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(city: school/location/name@en, age) {
+				count(uid)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	attrs := res.Query[0].Children[0].GroupbyAttrs
+	require.Equal(t, 2, len(attrs))
+	require.Equal(t, GroupByAttr{
+		Attr:  "name",
+		Alias: "city",
+		Langs: []string{"en"},
+		Path:  []string{"school", "location"},
+	}, attrs[0])
+	require.Equal(t, "age", attrs[1].Attr)
+	require.Nil(t, attrs[1].Path)
+}
+
+func TestParseGroupbyArgsError(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(age, first: 2, first: 3) {
+				count(uid)
+			}
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Repeated first in groupby")
+
+	query = `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(first: 2) {
+				count(uid)
+			}
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected atleast one attribute in groupby")
+}
+
 func TestParseFacetsError1(t *testing.T) {
 	query := `
 	query {
@@ -4104,6 +4283,75 @@ func TestMathWithoutVarAlias(t *testing.T) {
 	require.Contains(t, err.Error(), "Function math should be used with a variable or have an alias")
 }
 
+func TestParseGraphAlgo(t *testing.T) {
+	query := `{
+		var(func: has(follows)) {
+			pr as pagerank(follows, 30, 0.9)
+			cc as components(~follows)
+		}
+		me(func: uid(pr), orderdesc: val(pr)) {
+			name
+			rank: val(pr)
+			component: val(cc)
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := res.Query[0].Children
+	require.Len(t, children, 2)
+
+	require.Equal(t, "follows", children[0].Attr)
+	require.Equal(t, "pr", children[0].Var)
+	require.True(t, children[0].IsInternal)
+	require.Equal(t, "pagerank", children[0].Func.Name)
+	require.Equal(t, "follows", children[0].Func.Attr)
+	require.Equal(t, []Arg{{Value: "30"}, {Value: "0.9"}}, children[0].Func.Args)
+
+	require.Equal(t, "~follows", children[1].Attr)
+	require.Equal(t, "components", children[1].Func.Name)
+	require.Equal(t, "cc", children[1].Var)
+	require.Equal(t, "~follows", children[1].Func.Attr)
+	require.Empty(t, children[1].Func.Args)
+
+	require.Equal(t, []string{"pr", "cc"}, res.QueryVars[0].Defines)
+}
+
+func TestParseGraphAlgoAsPredicate(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			pagerank
+			components
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "pagerank", res.Query[0].Children[0].Attr)
+	require.Nil(t, res.Query[0].Children[0].Func)
+	require.False(t, res.Query[0].Children[0].IsInternal)
+	require.Equal(t, "components", res.Query[0].Children[1].Attr)
+}
+
+func TestParseGraphAlgoErrors(t *testing.T) {
+	tests := []struct {
+		in  string
+		err string
+	}{
+		{`{me(func: uid(1)) { pagerank(follows) }}`,
+			"Function pagerank should be used with a variable or have an alias"},
+		{`{me(func: uid(1)) { cc as components(follows, 10) }}`,
+			"Function components expects at most 0 arguments after the predicate. Got: 1"},
+		{`{me(func: uid(1)) { pr as pagerank(follows, 10, 0.85, 1) }}`,
+			"Function pagerank expects at most 2 arguments after the predicate. Got: 3"},
+		{`{me(func: uid(1)) { pr as pagerank(val(x)) }}`,
+			"Function pagerank expects a predicate as its first argument"},
+	}
+	for _, tc := range tests {
+		_, err := Parse(Request{Str: tc.in})
+		require.Error(t, err, tc.in)
+		require.Contains(t, err.Error(), tc.err, tc.in)
+	}
+}
+
 func TestMathDiv0(t *testing.T) {
 	tests := []struct {
 		in       string
@@ -4422,6 +4670,94 @@ func TestAggRootError3(t *testing.T) {
 	require.Contains(t, err.Error(), "Only aggregation/math functions allowed inside empty blocks. Got: avg")
 }
 
+func TestAggRootStatistics(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				median(val(a))
+				p90 : percentile(val(a), 90)
+				stddev(val(a))
+				variance(val(a))
+				count(distinct val(a))
+			}
+		}
+	`
+	gql, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := gql.Query[1].Children
+	require.Equal(t, 5, len(children))
+	require.Equal(t, "median", children[0].Func.Name)
+	require.Equal(t, "percentile", children[1].Func.Name)
+	require.Equal(t, "p90", children[1].Alias)
+	require.Equal(t, []Arg{{Value: "90"}}, children[1].Func.Args)
+	require.Equal(t, "stddev", children[2].Func.Name)
+	require.Equal(t, "variance", children[3].Func.Name)
+	require.Equal(t, "countdistinct", children[4].Func.Name)
+	require.True(t, children[4].Func.IsAggregator())
+	require.Equal(t, "a", children[4].NeedsVar[0].Name)
+}
+
+func TestAggPercentileError(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				percentile(val(a), 101)
+			}
+		}
+	`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Function percentile expects a number between 0 and 100")
+
+	query = `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				percentile(val(a))
+			}
+		}
+	`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Function percentile expects a number between 0 and 100")
+}
+
+func TestParseGroupbyStatistics(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(age) {
+				median(salary)
+				percentile(salary, 99.5)
+				count(distinct name@en)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := res.Query[0].Children[0].Children
+	require.Equal(t, 3, len(children))
+	require.Equal(t, "salary", children[0].Attr)
+	require.Equal(t, "median", children[0].Func.Name)
+	require.Equal(t, "salary", children[1].Attr)
+	require.Equal(t, []Arg{{Value: "99.5"}}, children[1].Func.Args)
+	require.Equal(t, "name", children[2].Attr)
+	require.Equal(t, []string{"en"}, children[2].Langs)
+	require.Equal(t, "countdistinct", children[2].Func.Name)
+}
+
 func TestEmptyFunction(t *testing.T) {
 	query := `
 		{
@@ -5268,6 +5604,51 @@ func TestRecurseWithError(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Value inside loop should be type of boolean")
 }
+
+func TestRecursePathsAndCycles(t *testing.T) {
+	query := `
+	{
+		me(func: eq(name, "sad")) @recurse(depth: 3, paths: true, detectcycles: true) {
+		}
+	}`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), gq.Query[0].RecurseArgs.Depth)
+	require.True(t, gq.Query[0].RecurseArgs.Paths)
+	require.True(t, gq.Query[0].RecurseArgs.DetectCycles)
+	require.False(t, gq.Query[0].RecurseArgs.AllowLoop)
+
+	query = `
+	{
+		me(func: eq(name, "sad")) @recurse(paths: $paths, detectcycles: $cycles) {
+		}
+	}`
+	gq, err = Parse(Request{Str: query, Variables: map[string]string{"$paths": "false",
+		"$cycles": "true"}})
+	require.NoError(t, err)
+	require.False(t, gq.Query[0].RecurseArgs.Paths)
+	require.True(t, gq.Query[0].RecurseArgs.DetectCycles)
+}
+
+func TestRecursePathsWithError(t *testing.T) {
+	query := `
+	{
+		me(func: eq(name, "sad")) @recurse(paths: yes) {
+		}
+	}`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Value inside paths should be type of boolean")
+
+	query = `
+	{
+		me(func: eq(name, "sad")) @recurse(detectcycles: $cycles) {
+		}
+	}`
+	_, err = Parse(Request{Str: query, Variables: map[string]string{"$cycles": "maybe"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "should be type of boolean")
+}
 func TestParseExpandFilter(t *testing.T) {
 	query := `
 		{
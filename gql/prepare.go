@@ -0,0 +1,189 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gql
+
+import (
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+// Prepared is a query that has been parsed once, without the values of its variables, so that
+// it can be run many times with different values without being parsed again.
+type Prepared struct {
+	// res holds the parsed query, with its fragments expanded. Its variables are substituted
+	// in a copy of it by Bind.
+	res Result
+	// decls holds the types and default values of the variables declared by the query, or nil
+	// if it has no variable list.
+	decls varMap
+}
+
+// Prepare parses the query in str and checks that it's valid, the same way Parse does. Unlike
+// Parse, the values of the variables of the query are only given later, to Bind.
+func Prepare(str string) (*Prepared, error) {
+	res, fmap, decls, err := parseBlocks(str)
+	if err != nil {
+		return nil, err
+	}
+
+	res.QueryVars = make([]*Vars, 0, len(res.Query))
+	for i, qu := range res.Query {
+		if err := qu.expandFragments(fmap); err != nil {
+			return nil, err
+		}
+		res.QueryVars = append(res.QueryVars, &Vars{})
+		qu.collectVars(res.QueryVars[i])
+	}
+	if len(res.Query) != 0 {
+		if err := checkDependency(res.QueryVars); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateResult(&res); err != nil {
+		return nil, err
+	}
+	return &Prepared{res: res, decls: decls}, nil
+}
+
+// Bind returns the result of parsing the prepared query along with the given variables. The
+// result doesn't share anything with the prepared query, it can be changed by the caller.
+func (p *Prepared) Bind(variables map[string]string) (Result, error) {
+	vmap := convertToVarMap(variables)
+	if p.decls != nil {
+		if err := declareVariables(vmap, p.decls); err != nil {
+			return Result{}, err
+		}
+	}
+
+	res := Result{Schema: p.res.Schema}
+	if len(p.res.Query) == 0 {
+		return res, nil
+	}
+	res.Query = make([]*GraphQuery, 0, len(p.res.Query))
+	res.QueryVars = make([]*Vars, 0, len(p.res.QueryVars))
+	for i, qu := range p.res.Query {
+		qu = qu.clone()
+		if err := substituteVariables(qu, vmap); err != nil {
+			return Result{}, err
+		}
+		res.Query = append(res.Query, qu)
+		res.QueryVars = append(res.QueryVars, &Vars{
+			Defines: append([]string(nil), p.res.QueryVars[i].Defines...),
+			Needs:   append([]string(nil), p.res.QueryVars[i].Needs...),
+		})
+	}
+	return res, nil
+}
+
+// clone returns a deep copy of gq. The math expressions and the fields that are never changed
+// once the query is parsed are shared.
+func (gq *GraphQuery) clone() *GraphQuery {
+	if gq == nil {
+		return nil
+	}
+	c := *gq
+	c.UID = append([]uint64(nil), gq.UID...)
+	c.Langs = append([]string(nil), gq.Langs...)
+	c.NeedsVar = append([]VarContext(nil), gq.NeedsVar...)
+	c.Func = gq.Func.clone()
+	c.Args = cloneArgs(gq.Args)
+	c.Order = cloneOrder(gq.Order)
+	if gq.Children != nil {
+		c.Children = make([]*GraphQuery, 0, len(gq.Children))
+		for _, child := range gq.Children {
+			c.Children = append(c.Children, child.clone())
+		}
+	}
+	c.Filter = gq.Filter.clone()
+	c.ShortestPathArgs = ShortestPathArgs{
+		From:  gq.ShortestPathArgs.From.clone(),
+		To:    gq.ShortestPathArgs.To.clone(),
+		Avoid: gq.ShortestPathArgs.Avoid.clone(),
+		Via:   gq.ShortestPathArgs.Via.clone(),
+	}
+	c.Cascade = append([]string(nil), gq.Cascade...)
+	c.FacetsFilter = gq.FacetsFilter.clone()
+	if gq.GroupbyAttrs != nil {
+		c.GroupbyAttrs = make([]GroupByAttr, 0, len(gq.GroupbyAttrs))
+		for _, attr := range gq.GroupbyAttrs {
+			attr.Langs = append([]string(nil), attr.Langs...)
+			attr.Path = append([]string(nil), attr.Path...)
+			c.GroupbyAttrs = append(c.GroupbyAttrs, attr)
+		}
+	}
+	c.GroupbyArgs = GroupbyArgs{
+		Order:  cloneOrder(gq.GroupbyArgs.Order),
+		Args:   cloneArgs(gq.GroupbyArgs.Args),
+		Having: gq.GroupbyArgs.Having.clone(),
+	}
+	c.FacetVar = cloneArgs(gq.FacetVar)
+	if gq.FacetsOrder != nil {
+		c.FacetsOrder = make([]*FacetOrder, 0, len(gq.FacetsOrder))
+		for _, o := range gq.FacetsOrder {
+			fo := *o
+			c.FacetsOrder = append(c.FacetsOrder, &fo)
+		}
+	}
+	c.AllowedPreds = append([]string(nil), gq.AllowedPreds...)
+	return &c
+}
+
+// clone returns a deep copy of the filter tree f.
+func (f *FilterTree) clone() *FilterTree {
+	if f == nil {
+		return nil
+	}
+	c := &FilterTree{Op: f.Op, Func: f.Func.clone()}
+	for _, child := range f.Child {
+		c.Child = append(c.Child, child.clone())
+	}
+	return c
+}
+
+// clone returns a deep copy of the function f.
+func (f *Function) clone() *Function {
+	if f == nil {
+		return nil
+	}
+	c := *f
+	c.Args = append([]Arg(nil), f.Args...)
+	c.UID = append([]uint64(nil), f.UID...)
+	c.NeedsVar = append([]VarContext(nil), f.NeedsVar...)
+	return &c
+}
+
+func cloneArgs(args map[string]string) map[string]string {
+	if args == nil {
+		return nil
+	}
+	c := make(map[string]string, len(args))
+	for k, v := range args {
+		c[k] = v
+	}
+	return c
+}
+
+func cloneOrder(order []*pb.Order) []*pb.Order {
+	if order == nil {
+		return nil
+	}
+	c := make([]*pb.Order, 0, len(order))
+	for _, o := range order {
+		c = append(c, &pb.Order{Attr: o.Attr, Desc: o.Desc,
+			Langs: append([]string(nil), o.Langs...)})
+	}
+	return c
+}
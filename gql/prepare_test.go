@@ -0,0 +1,121 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareBind(t *testing.T) {
+	tests := []struct {
+		query string
+		vars  map[string]string
+	}{
+		{
+			query: `{ me(func: uid(0x1)) { name friend { name } } }`,
+		},
+		{
+			query: `query test($a: string, $b: int = 10) {
+				me(func: eq(name, $a), first: $b) @filter(anyofterms(nick, $a)) {
+					name
+					friend(orderasc: name) { name }
+				}
+			}`,
+			vars: map[string]string{"$a": "Alice"},
+		},
+		{
+			query: `query test($id: string, $re: string, $depth: int) {
+				me(func: uid($id)) @recurse(depth: $depth) {
+					friend @filter(regexp(name, $re))
+				}
+			}`,
+			vars: map[string]string{"$id": "0x1", "$re": "/^Al.*/i", "$depth": "2"},
+		},
+		{
+			query: `query test($a: int) {
+				var(func: has(friend)) { f as friend }
+				me(func: uid(f), first: $a) { ...Name }
+			}
+			fragment Name { name nick }`,
+			vars: map[string]string{"$a": "5"},
+		},
+	}
+	for _, tc := range tests {
+		p, err := Prepare(tc.query)
+		require.NoError(t, err)
+		res, err := p.Bind(tc.vars)
+		require.NoError(t, err)
+		expected, err := Parse(Request{Str: tc.query, Variables: tc.vars})
+		require.NoError(t, err)
+		require.Equal(t, len(expected.Query), len(res.Query))
+		require.Equal(t, expected.QueryVars, res.QueryVars)
+		for i := range expected.Query {
+			require.Equal(t, childAttrs(expected.Query[i]), childAttrs(res.Query[i]))
+			require.Equal(t, expected.Query[i].Args, res.Query[i].Args)
+			require.Equal(t, expected.Query[i].UID, res.Query[i].UID)
+			require.Equal(t, expected.Query[i].Func, res.Query[i].Func)
+			require.Equal(t, expected.Query[i].Filter, res.Query[i].Filter)
+			require.Equal(t, expected.Query[i].RecurseArgs, res.Query[i].RecurseArgs)
+		}
+	}
+}
+
+func TestBindDoesNotChangePrepared(t *testing.T) {
+	p, err := Prepare(`query test($a: string, $id: string) {
+		me(func: eq(name, $a)) @filter(uid($id) and regexp(nick, $a)) { name }
+	}`)
+	require.NoError(t, err)
+
+	res1, err := p.Bind(map[string]string{"$a": "/Alice/", "$id": "0x1"})
+	require.NoError(t, err)
+	res2, err := p.Bind(map[string]string{"$a": "/Bob/", "$id": "0x2"})
+	require.NoError(t, err)
+
+	require.Equal(t, "/Alice/", res1.Query[0].Func.Args[0].Value)
+	require.Equal(t, "/Bob/", res2.Query[0].Func.Args[0].Value)
+	require.Equal(t, []uint64{1}, res1.Query[0].Filter.Child[0].Func.UID)
+	require.Equal(t, []uint64{2}, res2.Query[0].Filter.Child[0].Func.UID)
+	require.Equal(t, []Arg{{Value: "Alice", IsGraphQLVar: true}, {Value: ""}},
+		res1.Query[0].Filter.Child[1].Func.Args)
+
+	// Changing a result doesn't change the results of the next binds.
+	res1.Query[0].Children = nil
+	res3, err := p.Bind(map[string]string{"$a": "/Carol/", "$id": "0x3"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"name"}, childAttrs(res3.Query[0]))
+	require.Equal(t, []uint64{3}, res3.Query[0].Filter.Child[0].Func.UID)
+}
+
+func TestPrepareBindErrors(t *testing.T) {
+	_, err := Prepare(`{ me(func: uid(0x1)) { name `)
+	require.Error(t, err)
+	_, err = Prepare(`{ me(func: uid(0x1)) { f as friend } }`)
+	require.Contains(t, err.Error(), "Some variables are defined but not used")
+
+	p, err := Prepare(`query test($a: int!, $b: bool = true) { me(func: uid(0x1)) { name } }`)
+	require.NoError(t, err)
+	_, err = p.Bind(nil)
+	require.Contains(t, err.Error(), "Variable $a should be initialised")
+	_, err = p.Bind(map[string]string{"$a": "one"})
+	require.Contains(t, err.Error(), "Expected an int but got one")
+	_, err = p.Bind(map[string]string{"$a": "1", "$c": "2"})
+	require.Contains(t, err.Error(), "Type of variable $c not specified")
+	_, err = p.Bind(map[string]string{"$a": "1"})
+	require.NoError(t, err)
+}
@@ -130,12 +130,52 @@ const (
 		"""
 		indexing: [String]
 
+		"""
+		Progress of the index builds running in the background.
+		"""
+		indexing_progress: [IndexingProgress]
+
 		"""
 		List of Enterprise Features that are enabled.
 		"""
 		ee_features: [String]
 	}
 
+	type IndexingProgress {
+		predicate: String
+
+		"""
+		The index being built, like the tokenizers, count, reverse or a composite index.
+		"""
+		index: String
+
+		"""
+		The phase of the build, either reading data or writing index.
+		"""
+		phase: String
+
+		"""
+		The timestamp of the data the index is built from. The mutations committed after it
+		are applied on top of the index.
+		"""
+		start_ts: Int
+
+		"""
+		Number of keys read from the data so far.
+		"""
+		keys_read: Int
+
+		"""
+		Number of index keys written so far.
+		"""
+		keys_written: Int
+
+		"""
+		Time in Unix epoch time at which the build started.
+		"""
+		since: Int
+	}
+
 	type MembershipState {
 		counter: Int
 		groups: [ClusterGroup]
@@ -271,6 +311,32 @@ const (
 		lruMb: Float
 	}
 
+	input AddPersistedQueryInput {
+
+		"""
+		The id to register the query under. It defaults to the SHA-256 hash of the query.
+		"""
+		id: String
+
+		"""
+		The DQL query. Clients run it by its id, giving only the values of its variables.
+		"""
+		query: String!
+	}
+
+	type PersistedQuery {
+		id: String
+		query: String
+	}
+
+	type AddPersistedQueryPayload {
+		persistedQuery: PersistedQuery
+	}
+
+	type DeletePersistedQueryPayload {
+		response: Response
+	}
+
 	` + adminTypes + `
 
 	type Query {
@@ -293,7 +359,7 @@ const (
 
 		"""
 		Starts an export of all data in the cluster.  Export format should be 'rdf' (the default
-		if no format is given), or 'json'.
+		if no format is given), 'json', 'csv' or 'parquet'.
 		See : https://dgraph.io/docs/deploy/#export-database
 		"""
 		export(input: ExportInput!): ExportPayload
@@ -315,6 +381,17 @@ const (
 		
 		replaceAllowedCORSOrigins(origins: [String]): Cors
 
+		"""
+		Register a DQL query as a persisted query, replacing the query registered under the
+		same id, if any.
+		"""
+		addPersistedQuery(input: AddPersistedQueryInput!): AddPersistedQueryPayload
+
+		"""
+		Remove the persisted query registered under the given id.
+		"""
+		deletePersistedQuery(id: String!): DeletePersistedQueryPayload
+
 		` + adminMutations + `
 	}
  `
@@ -353,14 +430,16 @@ var (
 		"getAllowedCORSOrigins": {resolve.IpWhitelistingMW4Query, resolve.LoggingMWQuery},
 	}
 	adminMutationMWConfig = map[string]resolve.MutationMiddlewares{
-		"backup":          commonAdminMutationMWs,
-		"config":          commonAdminMutationMWs,
-		"draining":        commonAdminMutationMWs,
-		"export":          commonAdminMutationMWs,
-		"login":           {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
-		"restore":         commonAdminMutationMWs,
-		"shutdown":        commonAdminMutationMWs,
-		"updateGQLSchema": commonAdminMutationMWs,
+		"backup":               commonAdminMutationMWs,
+		"config":               commonAdminMutationMWs,
+		"draining":             commonAdminMutationMWs,
+		"export":               commonAdminMutationMWs,
+		"login":                {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
+		"restore":              commonAdminMutationMWs,
+		"shutdown":             commonAdminMutationMWs,
+		"updateGQLSchema":      commonAdminMutationMWs,
+		"addPersistedQuery":    commonAdminMutationMWs,
+		"deletePersistedQuery": commonAdminMutationMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"addUser":                   {resolve.IpWhitelistingMW4Mutation, resolve.LoggingMWMutation},
@@ -551,13 +630,15 @@ func newAdminResolver(
 func newAdminResolverFactory() resolve.ResolverFactory {
 
 	adminMutationResolvers := map[string]resolve.MutationResolverFunc{
-		"backup":   resolveBackup,
-		"config":   resolveUpdateConfig,
-		"draining": resolveDraining,
-		"export":   resolveExport,
-		"login":    resolveLogin,
-		"restore":  resolveRestore,
-		"shutdown": resolveShutdown,
+		"backup":               resolveBackup,
+		"config":               resolveUpdateConfig,
+		"draining":             resolveDraining,
+		"export":               resolveExport,
+		"login":                resolveLogin,
+		"restore":              resolveRestore,
+		"shutdown":             resolveShutdown,
+		"addPersistedQuery":    resolveAddPersistedQuery,
+		"deletePersistedQuery": resolveDeletePersistedQuery,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
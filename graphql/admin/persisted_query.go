@@ -0,0 +1,86 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/golang/glog"
+)
+
+type persistedQueryInput struct {
+	ID    string
+	Query string
+}
+
+func resolveAddPersistedQuery(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	glog.Info("Got add persisted query request through GraphQL admin API")
+
+	input, err := getPersistedQueryInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	id, err := edgraph.AddPersistedQuery(ctx, input.ID, input.Query)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{
+			m.Name(): map[string]interface{}{
+				"persistedQuery": map[string]interface{}{
+					"id":    id,
+					"query": input.Query,
+				},
+			},
+		},
+		Field: m,
+	}, true
+}
+
+func resolveDeletePersistedQuery(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
+	bool) {
+	glog.Info("Got delete persisted query request through GraphQL admin API")
+
+	id, _ := m.ArgValue("id").(string)
+	if err := edgraph.DeletePersistedQuery(ctx, id); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return &resolve.Resolved{
+		Data: map[string]interface{}{
+			m.Name(): response("Success", fmt.Sprintf("Persisted query %q deleted", id))},
+		Field: m,
+	}, true
+}
+
+func getPersistedQueryInput(m schema.Mutation) (*persistedQueryInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputByts, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+
+	var input persistedQueryInput
+	err = json.Unmarshal(inputByts, &input)
+	return &input, schema.GQLWrapf(err, "couldn't get input argument")
+}
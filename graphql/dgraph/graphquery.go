@@ -51,6 +51,9 @@ func writeQuery(b *strings.Builder, query *gql.GraphQuery, prefix string) {
 		x.Check2(b.WriteString(query.Alias))
 		x.Check2(b.WriteString(" : "))
 	}
+	if query.IsCount {
+		x.Check2(b.WriteString("count("))
+	}
 	x.Check2(b.WriteString(query.Attr))
 
 	if query.Func != nil {
@@ -63,6 +66,11 @@ func writeQuery(b *strings.Builder, query *gql.GraphQuery, prefix string) {
 		x.Check2(b.WriteRune(')'))
 	}
 
+	if query.IsCount {
+		// A filter on a count goes inside it, as in count(Author.posts @filter(...))
+		x.Check2(b.WriteRune(')'))
+	}
+
 	if query.Func == nil && hasOrderOrPage(query) {
 		x.Check2(b.WriteString(" ("))
 		writeOrderAndPage(b, query, false)
@@ -89,6 +97,9 @@ func writeQuery(b *strings.Builder, query *gql.GraphQuery, prefix string) {
 		for _, c := range query.Children {
 			writeQuery(b, c, prefix+prefixAdd)
 		}
+		if query.Cursor {
+			x.Check2(b.WriteString(prefix + prefixAdd + gql.CursorAttr + "\n"))
+		}
 		if query.Attr != "" {
 			x.Check2(b.WriteString(prefix))
 			x.Check2(b.WriteString("}\n"))
@@ -187,11 +198,12 @@ func writeFilter(b *strings.Builder, ft *gql.FilterTree) {
 func hasOrderOrPage(q *gql.GraphQuery) bool {
 	_, hasFirst := q.Args["first"]
 	_, hasOffset := q.Args["offset"]
-	return len(q.Order) > 0 || hasFirst || hasOffset
+	_, hasAfter := q.Args["after"]
+	return len(q.Order) > 0 || hasFirst || hasOffset || hasAfter
 }
 
 func writeOrderAndPage(b *strings.Builder, query *gql.GraphQuery, root bool) {
-	var wroteOrder, wroteFirst bool
+	var wroteOrder, wroteFirst, wroteOffset bool
 
 	for _, ord := range query.Order {
 		if root {
@@ -221,5 +233,14 @@ func writeOrderAndPage(b *strings.Builder, query *gql.GraphQuery, root bool) {
 		}
 		x.Check2(b.WriteString("offset: "))
 		x.Check2(b.WriteString(offset))
+		wroteOffset = true
+	}
+
+	if after, ok := query.Args["after"]; ok {
+		if root || wroteOrder || wroteFirst || wroteOffset {
+			x.Check2(b.WriteString(", "))
+		}
+		x.Check2(b.WriteString("after: "))
+		x.Check2(b.WriteString(after))
 	}
 }
@@ -53,6 +53,19 @@ const (
             ],
             "upsert": true
         },
+        {
+            "predicate": "dgraph.dql.id",
+            "type": "string",
+            "index": true,
+            "tokenizer": [
+              "exact"
+            ],
+            "upsert": true
+        },
+        {
+            "predicate": "dgraph.dql.query",
+            "type": "string"
+        },
         {
             "predicate": "dgraph.graphql.schema",
             "type": "string"
@@ -85,6 +98,17 @@ const (
         }
     ],
     "types": [
+        {
+            "fields": [
+              {
+                "name": "dgraph.dql.id"
+              },
+              {
+                "name": "dgraph.dql.query"
+              }
+            ],
+            "name": "dgraph.dql"
+        },
         {
             "fields": [
                 {
@@ -128,6 +152,19 @@ const (
             ],
             "upsert": true
         },
+        {
+            "predicate": "dgraph.dql.id",
+            "type": "string",
+            "index": true,
+            "tokenizer": [
+              "exact"
+            ],
+            "upsert": true
+        },
+        {
+            "predicate": "dgraph.dql.query",
+            "type": "string"
+        },
         {
             "predicate": "dgraph.graphql.schema",
             "type": "string"
@@ -168,6 +205,17 @@ const (
             ],
             "name": "A"
         },
+        {
+            "fields": [
+              {
+                "name": "dgraph.dql.id"
+              },
+              {
+                "name": "dgraph.dql.query"
+              }
+            ],
+            "name": "dgraph.dql"
+        },
         {
             "fields": [
                 {
@@ -226,6 +274,19 @@ const (
             ],
             "upsert": true
         },
+        {
+            "predicate": "dgraph.dql.id",
+            "type": "string",
+            "index": true,
+            "tokenizer": [
+              "exact"
+            ],
+            "upsert": true
+        },
+        {
+            "predicate": "dgraph.dql.query",
+            "type": "string"
+        },
         {
             "predicate": "dgraph.graphql.schema",
             "type": "string"
@@ -269,6 +330,17 @@ const (
             ],
             "name": "A"
         },
+        {
+            "fields": [
+              {
+                "name": "dgraph.dql.id"
+              },
+              {
+                "name": "dgraph.dql.query"
+              }
+            ],
+            "name": "dgraph.dql"
+        },
         {
             "fields": [
                 {
@@ -335,6 +407,19 @@ const (
             ],
             "upsert": true
         },
+        {
+            "predicate": "dgraph.dql.id",
+            "type": "string",
+            "index": true,
+            "tokenizer": [
+              "exact"
+            ],
+            "upsert": true
+        },
+        {
+            "predicate": "dgraph.dql.query",
+            "type": "string"
+        },
         {
             "predicate": "dgraph.graphql.schema",
             "type": "string"
@@ -381,6 +466,17 @@ const (
             ],
             "name": "A"
         },
+        {
+            "fields": [
+              {
+                "name": "dgraph.dql.id"
+              },
+              {
+                "name": "dgraph.dql.query"
+              }
+            ],
+            "name": "dgraph.dql"
+        },
         {
             "fields": [
                 {
@@ -220,6 +220,19 @@ func TestConcurrentSchemaUpdates(t *testing.T) {
       		"upsert": true
 		},
 		{
+            "predicate": "dgraph.dql.id",
+            "type": "string",
+            "index": true,
+            "tokenizer": [
+                "exact"
+            ],
+            "upsert": true
+		},
+		{
+            "predicate": "dgraph.dql.query",
+            "type": "string"
+		},
+		{
             "predicate": "dgraph.graphql.schema",
             "type": "string"
 		},
@@ -259,6 +272,16 @@ func TestConcurrentSchemaUpdates(t *testing.T) {
             ],
             "name": "A"
         },
+        {
+            "fields": [
+                {
+                    "name": "dgraph.dql.id"
+                },{
+                    "name": "dgraph.dql.query"
+                }
+            ],
+            "name": "dgraph.dql"
+        },
         {
             "fields": [
                 {
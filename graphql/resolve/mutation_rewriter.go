@@ -927,6 +927,17 @@ func rewriteObject(
 	atTopLevel := srcField == nil
 	topLevelAdd := srcUID == ""
 
+	if typ.IsUnion() {
+		// GraphQL doesn't allow unions in inputs, so the object for a field of union type is
+		// a reference like { "dogRef": { ... } } that says which member type it is.
+		var err error
+		if typ, obj, err = unionMemberObject(typ, obj); err != nil {
+			errFrag := newFragment(nil)
+			errFrag.err = err
+			return &mutationRes{secondPass: []*mutationFragment{errFrag}}
+		}
+	}
+
 	variable := varGen.Next(typ, "", "", false)
 
 	id := typ.IDField()
@@ -934,7 +945,7 @@ func rewriteObject(
 		if idVal, ok := obj[id.Name()]; ok {
 			if idVal != nil {
 				return &mutationRes{secondPass: []*mutationFragment{
-					asIDReference(ctx, idVal, srcField, srcUID, variable,
+					asIDReference(ctx, idVal, srcField, srcUID, typ, variable,
 						withAdditionalDeletes, varGen)}}
 			}
 			delete(obj, id.Name())
@@ -1287,6 +1298,36 @@ func rewriteObject(
 	return results
 }
 
+// unionMemberObject finds the member of union typ that obj is for.  obj must have exactly one
+// field, one of the <member>Ref fields of the union's Ref input type, like
+// { "dogRef": { "name": "Rex" } }
+// and the member type and the object in that field are returned.
+func unionMemberObject(
+	typ schema.Type,
+	obj map[string]interface{}) (schema.Type, map[string]interface{}, error) {
+
+	var member schema.Type
+	var memberObj map[string]interface{}
+	for _, m := range typ.UnionMembers(nil) {
+		val, ok := obj[schema.CamelCase(m.Name())+"Ref"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if member != nil {
+			return nil, nil, errors.Errorf("value for field of union type %s must specify only "+
+				"one of its member references, but found both %sRef and %sRef", typ.Name(),
+				schema.CamelCase(member.Name()), schema.CamelCase(m.Name()))
+		}
+		member, memberObj = m, val
+	}
+
+	if member == nil {
+		return nil, nil, errors.Errorf("value for field of union type %s must specify one of its "+
+			"member references", typ.Name())
+	}
+	return member, memberObj, nil
+}
+
 func invalidObjectFragment(
 	err error,
 	xidFrag *mutationFragment,
@@ -1337,7 +1378,9 @@ func asIDReference(
 	ctx context.Context,
 	val interface{},
 	srcField schema.FieldDefinition,
-	srcUID, variable string,
+	srcUID string,
+	typ schema.Type,
+	variable string,
 	withAdditionalDeletes bool,
 	varGen *VariableGenerator) *mutationFragment {
 
@@ -1360,7 +1403,7 @@ func asIDReference(
 		UID:      []uint64{uid},
 		Children: []*gql.GraphQuery{{Attr: "uid"}},
 	}
-	addTypeFilter(qry, srcField.Type())
+	addTypeFilter(qry, typ)
 	addUIDFunc(qry, []uint64{uid})
 
 	frag.queries = []*gql.GraphQuery{qry}
@@ -1368,7 +1411,7 @@ func asIDReference(
 	frag.check =
 		checkQueryResult(variable,
 			nil,
-			errors.Errorf("ID \"%#x\" isn't a %s", uid, srcField.Type().Name()))
+			errors.Errorf("ID \"%#x\" isn't a %s", uid, typ.Name()))
 
 	if withAdditionalDeletes {
 		addAdditionalDeletes(ctx, frag, varGen, srcField, srcUID, variable)
@@ -1421,7 +1464,7 @@ func asXIDReference(
 	frag.conditions = []string{fmt.Sprintf("eq(len(%s), 1)", xidVariable)}
 	frag.check = checkQueryResult(xidVariable,
 		nil,
-		errors.Errorf("ID \"%s\" isn't a %s", xidString, srcField.Type().Name()))
+		errors.Errorf("ID \"%s\" isn't a %s", xidString, typ.Name()))
 
 	if withAdditionalDeletes {
 		addAdditionalDeletes(ctx, frag, varGen, srcField, srcUID, xidVariable)
@@ -175,6 +175,24 @@ func resolveIntrospection(ctx context.Context, q schema.Query) *Resolved {
 	}
 }
 
+// resolveApolloServiceQuery resolves the _service query, which Apollo gateway uses to fetch
+// the SDL of this service.
+func resolveApolloServiceQuery(ctx context.Context, q schema.Query) *Resolved {
+	sdl, err := schema.ApolloServiceSDL(q.Operation().Schema())
+	if err != nil {
+		return &Resolved{
+			Data:  map[string]interface{}{q.DgraphAlias(): nil},
+			Field: q,
+			Err:   schema.GQLWrapLocationf(err, q.Location(), "couldn't generate the SDL"),
+		}
+	}
+
+	return &Resolved{
+		Data:  map[string]interface{}{q.DgraphAlias(): map[string]interface{}{"sdl": sdl}},
+		Field: q,
+	}
+}
+
 // converts scalar values received from GraphQL arguments to go string
 // If it is a scalar only possible cases are: string, bool, int64, float64 and nil.
 func convertScalarToString(val interface{}) (string, error) {
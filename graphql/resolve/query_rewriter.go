@@ -21,11 +21,13 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/graphql/authorization"
 	"github.com/dgraph-io/dgraph/graphql/schema"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
 	"github.com/pkg/errors"
 )
 
@@ -56,11 +58,18 @@ func NewQueryRewriter() QueryRewriter {
 }
 
 func hasAuthRules(field schema.Field, authRw *authRewriter) bool {
-	rn := authRw.selector(field.Type())
+	rn := authRw.selector(field.ConstructedFor())
 	if rn != nil {
 		return true
 	}
 
+	// The members of a union are each checked against their own rules.
+	for _, member := range field.Type().UnionMembers(nil) {
+		if authRw.selector(member) != nil {
+			return true
+		}
+	}
+
 	for _, childField := range field.SelectionSet() {
 		if authRules := hasAuthRules(childField, authRw); authRules {
 			return true
@@ -87,7 +96,7 @@ func (qr *queryRewriter) Rewrite(
 	ctx context.Context,
 	gqlQuery schema.Query) (*gql.GraphQuery, error) {
 
-	if gqlQuery.Type().InterfaceImplHasAuthRules() {
+	if gqlQuery.ConstructedFor().InterfaceImplHasAuthRules() {
 		return &gql.GraphQuery{Attr: gqlQuery.ResponseName() + "()"}, nil
 	}
 
@@ -105,7 +114,7 @@ func (qr *queryRewriter) Rewrite(
 		authVariables: authVariables,
 		varGen:        NewVariableGenerator(),
 		selector:      queryAuthSelector,
-		parentVarName: gqlQuery.Type().Name() + "Root",
+		parentVarName: gqlQuery.ConstructedFor().Name() + "Root",
 	}
 	authRw.hasAuthRules = hasAuthRules(gqlQuery, authRw)
 	authRw.hasCascade = hasCascadeDirective(gqlQuery)
@@ -132,6 +141,12 @@ func (qr *queryRewriter) Rewrite(
 
 	case schema.FilterQuery:
 		return rewriteAsQuery(gqlQuery, authRw), nil
+	case schema.AggregateQuery:
+		return rewriteAsAggregateQuery(gqlQuery, authRw), nil
+	case schema.ConnectionQuery:
+		return rewriteAsConnectionQuery(gqlQuery, authRw)
+	case schema.EntitiesQuery:
+		return rewriteAsEntitiesQuery(gqlQuery, authRw)
 	case schema.PasswordQuery:
 		return passwordQuery(gqlQuery, authRw)
 	default:
@@ -421,6 +436,458 @@ func rewriteAsQuery(field schema.Field, authRw *authRewriter) *gql.GraphQuery {
 	return dgQuery
 }
 
+// rewriteAsConnectionQuery rewrites a queryTConnection query into a query for the nodes asked
+// for in its edges, like queryT would, that also asks for the _cursor_ of each node.  For
+// example
+//   queryPostConnection(order: { asc: title }, first: 10, after: "...") {
+//     edges { node { title } cursor }
+//     pageInfo { hasNextPage }
+//   }
+// becomes
+//   queryPostConnection(func: type(Post), orderasc: Post.title, first: 11, after: ...) {
+//     title : Post.title
+//     dgraph.uid : uid
+//     _cursor_
+//   }
+// One more node than asked for is fetched to tell whether there's a next page, it's dropped
+// again in completeDgraphResult.
+func rewriteAsConnectionQuery(field schema.Query, authRw *authRewriter) (*gql.GraphQuery, error) {
+	typ := field.ConstructedFor()
+	first, hasFirst, err := connectionFirst(field)
+	if err != nil {
+		return nil, err
+	}
+	after, _ := field.ArgValue("after").(string)
+	if after != "" {
+		// The cursor is written into the Dgraph query as is, so it must be one that Dgraph
+		// gave out.
+		if _, _, err := worker.DecodeCursor(after); err != nil {
+			return nil, err
+		}
+	}
+
+	rbac := authRw.evaluateStaticRules(typ)
+	dgQuery := &gql.GraphQuery{
+		Attr:   field.Name(),
+		Cursor: true,
+	}
+
+	if rbac == schema.Negative {
+		dgQuery.Attr = dgQuery.Attr + "()"
+		return dgQuery, nil
+	}
+
+	filter := extractQueryFilter(field)
+	if ids := idFilter(filter, typ.IDField()); ids != nil {
+		addUIDFunc(dgQuery, ids)
+	} else {
+		addTypeFunc(dgQuery, typ.DgraphName())
+	}
+	addFilter(dgQuery, typ, filter)
+	addOrder(dgQuery, field)
+	dgQuery.Args = make(map[string]string)
+	if hasFirst {
+		dgQuery.Args["first"] = strconv.FormatInt(first+1, 10)
+	}
+	if after != "" {
+		dgQuery.Args["after"] = after
+	}
+
+	var selectionAuth []*gql.GraphQuery
+	if node := connectionNode(field); node != nil {
+		selectionAuth = addSelectionSetFrom(dgQuery, node, authRw)
+		addCascadeDirective(dgQuery, node)
+	}
+	addUID(dgQuery)
+
+	dgQuery = authRw.addAuthQueries(typ, dgQuery, rbac)
+
+	if len(selectionAuth) > 0 {
+		dgQuery = &gql.GraphQuery{Children: append([]*gql.GraphQuery{dgQuery}, selectionAuth...)}
+	}
+
+	return dgQuery, nil
+}
+
+// connectionFirst returns the first argument of a connection query, if it has one.
+func connectionFirst(field schema.Field) (int64, bool, error) {
+	first := field.ArgValue("first")
+	if first == nil {
+		return 0, false, nil
+	}
+	n, err := strconv.ParseInt(fmt.Sprintf("%v", first), 10, 64)
+	if err != nil || n < 0 {
+		return 0, false, errors.Errorf("first can't be negative in %s, but it was %v",
+			field.Name(), first)
+	}
+	return n, true, nil
+}
+
+// connectionNode returns the node field asked for in the edges of a connection query, or nil
+// if the query doesn't ask for the nodes.  Only the first node field is looked at.
+func connectionNode(field schema.Field) schema.Field {
+	for _, edges := range field.SelectionSet() {
+		if edges.Name() != "edges" || edges.Skip() || !edges.Include() {
+			continue
+		}
+		for _, node := range edges.SelectionSet() {
+			if node.Name() == "node" && !node.Skip() && node.Include() {
+				return node
+			}
+		}
+	}
+	return nil
+}
+
+// rewriteAsAggregateQuery rewrites an aggregateT query into a var block that finds the nodes
+// and collects the values to aggregate, and a block without a root function that
+// aggregates them.  For example
+//   aggregatePost(filter: { ... }) { count numLikesMax }
+// becomes
+//   aggregatePost() {
+//     count : max(val(Post1_count))
+//     numLikesMax : max(val(Post1_numLikes))
+//   }
+//   var(func: type(Post)) @filter(...) {
+//     Post1_count as count(uid)
+//     Post1_numLikes as Post.numLikes
+//   }
+func rewriteAsAggregateQuery(field schema.Query, authRw *authRewriter) *gql.GraphQuery {
+	typ := field.ConstructedFor()
+	rbac := authRw.evaluateStaticRules(typ)
+	aggregateQuery := &gql.GraphQuery{
+		Attr: field.Name() + "()",
+	}
+
+	if rbac == schema.Negative {
+		return aggregateQuery
+	}
+
+	varQuery := &gql.GraphQuery{
+		Attr: "var",
+	}
+	if ids := idFilter(extractQueryFilter(field), typ.IDField()); ids != nil {
+		addUIDFunc(varQuery, ids)
+	} else {
+		addTypeFunc(varQuery, typ.DgraphName())
+	}
+	addFilter(varQuery, typ, extractQueryFilter(field))
+
+	varPrefix := authRw.varGen.Next(typ, "", "", authRw.isWritingAuth)
+	aggregateQuery.Children, varQuery.Children = aggregateSelectionSet(field, varPrefix, "")
+	for _, f := range field.SelectionSet() {
+		if f.Name() == "count" && !f.Skip() && f.Include() {
+			countVar := varPrefix + "_count"
+			aggregateQuery.Children = append([]*gql.GraphQuery{{
+				Alias: f.DgraphAlias(),
+				Attr:  "max(val(" + countVar + "))",
+			}}, aggregateQuery.Children...)
+			varQuery.Children = append([]*gql.GraphQuery{{
+				Var:  countVar,
+				Attr: "count(uid)",
+			}}, varQuery.Children...)
+			break
+		}
+	}
+
+	// With auth, the var block is rewritten to start from the nodes that pass the auth rules,
+	// like the user query in rewriteAsQuery.
+	dgQuery := authRw.addAuthQueries(typ, varQuery, rbac)
+	if dgQuery.Attr != "" {
+		dgQuery = &gql.GraphQuery{Children: []*gql.GraphQuery{dgQuery}}
+	}
+	dgQuery.Children = append([]*gql.GraphQuery{aggregateQuery}, dgQuery.Children...)
+
+	return dgQuery
+}
+
+// aggregateSelectionSet builds the min, max, sum and avg aggregations requested in the
+// selection set of an aggregate field or query.  It returns the aggregations, aliased as
+// aliasPrefix + the field's alias, and the queries that fill the value variables they
+// aggregate, e.g.
+//   numLikesMax : max(val(Post1_numLikes))
+// and
+//   Post1_numLikes as Post.numLikes
+// The count of the nodes is different for aggregate fields and queries, so it's left to
+// the caller.
+func aggregateSelectionSet(
+	field schema.Field,
+	varPrefix, aliasPrefix string) ([]*gql.GraphQuery, []*gql.GraphQuery) {
+
+	var aggregates, vars []*gql.GraphQuery
+	typ := field.ConstructedFor()
+	varAdded := make(map[string]bool)
+	for _, f := range field.SelectionSet() {
+		if f.Skip() || !f.Include() {
+			continue
+		}
+
+		var fn, fldName string
+		for _, suffix := range []string{"Min", "Max", "Sum", "Avg"} {
+			if strings.HasSuffix(f.Name(), suffix) {
+				fn = strings.ToLower(suffix)
+				fldName = strings.TrimSuffix(f.Name(), suffix)
+				break
+			}
+		}
+		if fn == "" {
+			// count and __typename
+			continue
+		}
+
+		varName := varPrefix + "_" + fldName
+		if !varAdded[varName] {
+			vars = append(vars, &gql.GraphQuery{
+				Var:  varName,
+				Attr: typ.DgraphPredicate(fldName),
+			})
+			varAdded[varName] = true
+		}
+		aggregates = append(aggregates, &gql.GraphQuery{
+			Alias: aliasPrefix + f.DgraphAlias(),
+			Attr:  fn + "(val(" + varName + "))",
+		})
+	}
+	return aggregates, vars
+}
+
+// addAggregateField adds the DQL for an aggregate field f, like postsAggregate, to the query
+// q for the parent, and returns any extra queries that are needed to satisfy auth rules.  The
+// aggregations over a list of child nodes are done at the parent's level, so
+//   queryAuthor { postsAggregate(filter: { ... }) { count numLikesMax } }
+// becomes
+//   queryAuthor(func: type(Author)) {
+//     postsAggregate : Author.posts @filter(...) {
+//       Post1_numLikes as Post.numLikes
+//     }
+//     postsAggregate.count : count(Author.posts @filter(...))
+//     postsAggregate.numLikesMax : max(val(Post1_numLikes))
+//   }
+// and completion collects the postsAggregate.* values into the result object.
+func addAggregateField(
+	q *gql.GraphQuery,
+	f schema.Field,
+	auth *authRewriter) []*gql.GraphQuery {
+
+	typ := f.ConstructedFor()
+	rbac := auth.evaluateStaticRules(typ)
+	if rbac == schema.Negative {
+		return nil
+	}
+
+	pred := f.DgraphPredicateForAggregateField()
+	filtered := &gql.GraphQuery{}
+	addFilter(filtered, typ, extractQueryFilter(f))
+
+	var authQueries []*gql.GraphQuery
+	if rbac == schema.Uncertain && !auth.writingAuth() && auth.selector(typ) != nil {
+		// The auth queries start from all the nodes linked to the parent's nodes
+		//   var(func: uid(Author1)) {
+		//     Post2 as Author.posts
+		//   }
+		// and their result filters the nodes that are aggregated.
+		parentQryName := auth.varGen.Next(typ, "", "", auth.isWritingAuth)
+		authQueries = append(authQueries, &gql.GraphQuery{
+			Func: &gql.Function{
+				Name: "uid",
+				Args: []gql.Arg{{Value: auth.parentVarName}},
+			},
+			Attr:     "var",
+			Children: []*gql.GraphQuery{{Attr: pred, Var: parentQryName}},
+		})
+		auth.varName = parentQryName
+
+		fieldAuth, authFilter := auth.rewriteAuthQueries(typ)
+		authQueries = append(authQueries, fieldAuth...)
+		if authFilter != nil {
+			addToFilterTree(filtered, authFilter)
+		}
+	}
+
+	aliasPrefix := f.DgraphAlias() + "."
+	varPrefix := auth.varGen.Next(typ, "", "", auth.isWritingAuth)
+	aggregates, vars := aggregateSelectionSet(f, varPrefix, aliasPrefix)
+	if len(vars) > 0 {
+		q.Children = append(q.Children, &gql.GraphQuery{
+			Alias:    f.DgraphAlias(),
+			Attr:     pred,
+			Filter:   filtered.Filter,
+			Children: vars,
+		})
+	}
+	for _, sel := range f.SelectionSet() {
+		if sel.Name() == "count" && !sel.Skip() && sel.Include() {
+			q.Children = append(q.Children, &gql.GraphQuery{
+				Alias:   aliasPrefix + sel.DgraphAlias(),
+				Attr:    pred,
+				IsCount: true,
+				Filter:  filtered.Filter,
+			})
+			break
+		}
+	}
+	q.Children = append(q.Children, aggregates...)
+
+	return authQueries
+}
+
+// entityRepresentation is one of the representations given to an _entities query: the type
+// of the entity and the value of its @key field.
+type entityRepresentation struct {
+	typ schema.Type
+	key string
+}
+
+// entityRepresentations reads the representations argument of an _entities query.  Keys of
+// type ID are returned as hex uids, which is how Dgraph returns uids.
+func entityRepresentations(field schema.Query) ([]entityRepresentation, error) {
+	members := make(map[string]schema.Type)
+	for _, member := range field.Type().UnionMembers(nil) {
+		members[member.Name()] = member
+	}
+
+	reprs, _ := field.ArgValue("representations").([]interface{})
+	result := make([]entityRepresentation, 0, len(reprs))
+	for i, r := range reprs {
+		repr, _ := r.(map[string]interface{})
+		typName, _ := repr[schema.Typename].(string)
+		typ, ok := members[typName]
+		if !ok {
+			return nil, errors.Errorf("representation %d has %s %q, which isn't a type with "+
+				"@key", i, schema.Typename, typName)
+		}
+
+		keyField := typ.KeyField()
+		key, ok := repr[keyField.Name()].(string)
+		if !ok {
+			return nil, errors.Errorf("representation %d doesn't have a value for %s, the "+
+				"@key field of %s", i, keyField.Name(), typName)
+		}
+		if keyField.IsID() {
+			uid, err := strconv.ParseUint(key, 0, 64)
+			if err != nil {
+				return nil, errors.Errorf("ID argument (%s) was not able to be parsed", key)
+			}
+			key = fmt.Sprintf("%#x", uid)
+		}
+		result = append(result, entityRepresentation{typ: typ, key: key})
+	}
+	return result, nil
+}
+
+// entityKeyAlias is the alias of the @key field of typ in the Dgraph query for _entities.
+func entityKeyAlias(typ schema.Type) string {
+	keyField := typ.KeyField()
+	if keyField.IsID() {
+		return "dgraph.uid"
+	}
+	return keyField.DgraphPredicate()
+}
+
+// rewriteAsEntitiesQuery rewrites an _entities query, which Apollo gateway uses to fetch
+// entities by their keys, into a var block per type that finds its nodes by the @key field
+// and a query over all of them.  For example, with the representations
+//   [{ "__typename": "Product", "upc": "1" }, { "__typename": "Review", "id": "0x2" }]
+// it becomes
+//   _entities(func: uid(Product1, Review2)) { dgraph.type ... Product.upc : Product.upc }
+//   Product1 as var(func: eq(Product.upc, ["1"])) @filter(type(Product))
+//   Review2 as var(func: uid(0x2)) @filter(type(Review))
+// The @key fields are always queried, so that the results can be put in the order of the
+// representations once Dgraph returns them.
+func rewriteAsEntitiesQuery(field schema.Query, authRw *authRewriter) (*gql.GraphQuery, error) {
+	reprs, err := entityRepresentations(field)
+	if err != nil {
+		return nil, err
+	}
+
+	// The keys of each type, with the types in the order they first appear in.
+	var types []schema.Type
+	keys := make(map[string][]string)
+	for _, repr := range reprs {
+		if _, ok := keys[repr.typ.Name()]; !ok {
+			types = append(types, repr.typ)
+		}
+		keys[repr.typ.Name()] = append(keys[repr.typ.Name()], repr.key)
+	}
+
+	dgQuery := &gql.GraphQuery{
+		Attr: field.Name(),
+	}
+	if len(types) == 0 {
+		dgQuery.Attr = dgQuery.Attr + "()"
+		return dgQuery, nil
+	}
+
+	var typeQueries []*gql.GraphQuery
+	typeVars := make([]gql.Arg, 0, len(types))
+	for _, typ := range types {
+		typeQuery := &gql.GraphQuery{
+			Var:  authRw.varGen.Next(typ, "", "", authRw.isWritingAuth),
+			Attr: "var",
+		}
+		keyField := typ.KeyField()
+		if keyField.IsID() {
+			uids := make([]uint64, 0, len(keys[typ.Name()]))
+			for _, key := range keys[typ.Name()] {
+				uid, _ := strconv.ParseUint(key, 0, 64)
+				uids = append(uids, uid)
+			}
+			addUIDFunc(typeQuery, uids)
+		} else {
+			vals := make([]string, 0, len(keys[typ.Name()]))
+			for _, key := range keys[typ.Name()] {
+				vals = append(vals, maybeQuoteArg("eq", key))
+			}
+			typeQuery.Func = &gql.Function{
+				Name: "eq",
+				Args: []gql.Arg{
+					{Value: keyField.DgraphPredicate()},
+					{Value: "[" + strings.Join(vals, ", ") + "]"},
+				},
+			}
+		}
+		addTypeFilter(typeQuery, typ)
+		typeQueries = append(typeQueries, typeQuery)
+		typeVars = append(typeVars, gql.Arg{Value: typeQuery.Var})
+	}
+
+	dgQuery.Func = &gql.Function{
+		Name: "uid",
+		Args: typeVars,
+	}
+	selectionAuth := addSelectionSetFrom(dgQuery, field, authRw)
+	for _, typ := range types {
+		alias := entityKeyAlias(typ)
+		hasKey := false
+		for _, child := range dgQuery.Children {
+			hasKey = hasKey || child.Alias == alias
+		}
+		if hasKey {
+			continue
+		}
+		attr := alias
+		if typ.KeyField().IsID() {
+			attr = "uid"
+		}
+		dgQuery.Children = append(dgQuery.Children, &gql.GraphQuery{
+			Alias: alias,
+			Attr:  attr,
+		})
+	}
+	addUID(dgQuery)
+	addCascadeDirective(dgQuery, field)
+
+	// Each member of the _Entity union is checked against its own auth rules.
+	dgQuery = authRw.addAuthQueries(field.Type(), dgQuery, authRw.evaluateStaticRules(field.Type()))
+	if dgQuery.Attr != "" {
+		dgQuery = &gql.GraphQuery{Children: []*gql.GraphQuery{dgQuery}}
+	}
+	dgQuery.Children = append(dgQuery.Children, selectionAuth...)
+	dgQuery.Children = append(dgQuery.Children, typeQueries...)
+
+	return dgQuery, nil
+}
+
 func (authRw *authRewriter) writingAuth() bool {
 	return authRw != nil && authRw.isWritingAuth
 
@@ -443,7 +910,13 @@ func (authRw *authRewriter) addAuthQueries(
 
 	authRw.varName = authRw.varGen.Next(typ, "", "", authRw.isWritingAuth)
 
-	fldAuthQueries, filter := authRw.rewriteAuthQueries(typ)
+	var fldAuthQueries []*gql.GraphQuery
+	var filter *gql.FilterTree
+	if typ.IsUnion() {
+		fldAuthQueries, filter = authRw.rewriteUnionAuthQueries(typ)
+	} else {
+		fldAuthQueries, filter = authRw.rewriteAuthQueries(typ)
+	}
 	if len(fldAuthQueries) == 0 && !authRw.hasAuthRules {
 		return dgQuery
 	}
@@ -532,6 +1005,46 @@ func (authRw *authRewriter) rewriteAuthQueries(typ schema.Type) ([]*gql.GraphQue
 	}).rewriteRuleNode(typ, authRw.selector(typ))
 }
 
+// rewriteUnionAuthQueries builds the auth queries for the members of union typ.  Each member is
+// checked against its own rules, so if Dog has query rules and Parrot doesn't, the filter is like
+// (type(Dog) AND uid(DogAuth2)) OR type(Parrot)
+// Members whose RBAC rules evaluate to Negative are left out.
+func (authRw *authRewriter) rewriteUnionAuthQueries(
+	typ schema.Type) ([]*gql.GraphQuery, *gql.FilterTree) {
+	if authRw == nil || authRw.isWritingAuth {
+		return nil, nil
+	}
+
+	var qrys []*gql.GraphQuery
+	var filts []*gql.FilterTree
+	hasRules := false
+	for _, member := range typ.UnionMembers(nil) {
+		memberFilter := typeFilter(member)
+		if authRw.selector(member) != nil {
+			hasRules = true
+			switch authRw.evaluateStaticRules(member) {
+			case schema.Negative:
+				continue
+			case schema.Uncertain:
+				memberQrys, filter := authRw.rewriteAuthQueries(member)
+				qrys = append(qrys, memberQrys...)
+				if filter != nil {
+					memberFilter = &gql.FilterTree{
+						Op:    "and",
+						Child: []*gql.FilterTree{memberFilter, filter},
+					}
+				}
+			}
+		}
+		filts = append(filts, memberFilter)
+	}
+
+	if !hasRules {
+		return nil, nil
+	}
+	return qrys, unionMembersFilter(typ, filts)
+}
+
 func (authRw *authRewriter) evaluateStaticRules(typ schema.Type) schema.RuleResult {
 	if authRw == nil || authRw.isWritingAuth {
 		return schema.Uncertain
@@ -626,13 +1139,33 @@ func (authRw *authRewriter) rewriteRuleNode(
 }
 
 func addTypeFilter(q *gql.GraphQuery, typ schema.Type) {
-	thisFilter := &gql.FilterTree{
+	addToFilterTree(q, typeFilter(typ))
+}
+
+func typeFilter(typ schema.Type) *gql.FilterTree {
+	return &gql.FilterTree{
 		Func: &gql.Function{
 			Name: "type",
 			Args: []gql.Arg{{Value: typ.DgraphName()}},
 		},
 	}
-	addToFilterTree(q, thisFilter)
+}
+
+// unionMembersFilter joins the filters for the members of union typ with OR.  If there are no
+// members to include, it filters on the type of the union itself, which is never a Dgraph type,
+// so that nothing matches.
+func unionMembersFilter(typ schema.Type, memberFilters []*gql.FilterTree) *gql.FilterTree {
+	switch len(memberFilters) {
+	case 0:
+		return typeFilter(typ)
+	case 1:
+		return memberFilters[0]
+	default:
+		return &gql.FilterTree{
+			Op:    "or",
+			Child: memberFilters,
+		}
+	}
 }
 
 func addToFilterTree(q *gql.GraphQuery, filter *gql.FilterTree) {
@@ -669,12 +1202,12 @@ func addSelectionSetFrom(
 
 	var authQueries []*gql.GraphQuery
 
-	// Only add dgraph.type as a child if this field is an interface type and has some children.
-	// dgraph.type would later be used in completeObject as different objects in the resulting
-	// JSON would return different fields based on their concrete type.
+	// Only add dgraph.type as a child if this field is an interface or union type and has some
+	// children. dgraph.type would later be used in completeObject as different objects in the
+	// resulting JSON would return different fields based on their concrete type.
 	selSet := field.SelectionSet()
 	if len(selSet) > 0 {
-		if field.InterfaceType() {
+		if field.InterfaceType() || field.Type().IsUnion() {
 			q.Children = append(q.Children, &gql.GraphQuery{
 				Attr: "dgraph.type",
 			})
@@ -724,6 +1257,11 @@ func addSelectionSetFrom(
 		}
 		fieldAdded[f.DgraphAlias()] = true
 
+		if f.IsAggregateField() {
+			authQueries = append(authQueries, addAggregateField(q, f, auth)...)
+			continue
+		}
+
 		child := &gql.GraphQuery{
 			Alias: f.DgraphAlias(),
 		}
@@ -794,7 +1332,11 @@ func addSelectionSetFrom(
 
 		// If RBAC rules are evaluated to `Uncertain` then we add the Auth rules.
 		if rbac == schema.Uncertain {
-			fieldAuth, authFilter = auth.rewriteAuthQueries(f.Type())
+			if f.Type().IsUnion() {
+				fieldAuth, authFilter = auth.rewriteUnionAuthQueries(f.Type())
+			} else {
+				fieldAuth, authFilter = auth.rewriteAuthQueries(f.Type())
+			}
 		}
 
 		if authFilter != nil {
@@ -890,10 +1432,10 @@ func addOrder(q *gql.GraphQuery, field schema.Field) {
 
 		if asc, ok := ascArg.(string); ok {
 			q.Order = append(q.Order,
-				&pb.Order{Attr: field.Type().DgraphPredicate(asc)})
+				&pb.Order{Attr: field.ConstructedFor().DgraphPredicate(asc)})
 		} else if desc, ok := descArg.(string); ok {
 			q.Order = append(q.Order,
-				&pb.Order{Attr: field.Type().DgraphPredicate(desc), Desc: true})
+				&pb.Order{Attr: field.ConstructedFor().DgraphPredicate(desc), Desc: true})
 		}
 
 		order, ok = thenArg.(map[string]interface{})
@@ -954,6 +1496,11 @@ func addFilter(q *gql.GraphQuery, typ schema.Type, filter map[string]interface{}
 		return
 	}
 
+	if typ.IsUnion() {
+		q.Filter = buildUnionFilter(typ, filter)
+		return
+	}
+
 	// There are two cases here.
 	// 1. It could be the case of a filter at root.  In this case we would have added a uid
 	// function at root. Lets delete the ids key so that it isn't added in the filter.
@@ -1129,6 +1676,42 @@ func buildFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree
 	}
 }
 
+// buildUnionFilter builds a Dgraph gql.FilterTree from the 'filter' arg of a field of union
+// type.  A filter like
+// filter: { memberTypes: [Dog, Parrot], dogFilter: { breed: { anyofterms: "pug" } } }
+// becomes:
+// @filter((type(Dog) AND anyofterms(Dog.breed, "pug")) OR type(Parrot))
+//
+// If there's no memberTypes, all the members of the union are included.
+func buildUnionFilter(typ schema.Type, filter map[string]interface{}) *gql.FilterTree {
+	var members []schema.Type
+	switch memberTypes := filter["memberTypes"].(type) {
+	case nil:
+		members = typ.UnionMembers(nil)
+	case []interface{}:
+		// memberTypes: [] asks for none of the members, but UnionMembers(nil) would be all of them
+		if len(memberTypes) > 0 {
+			members = typ.UnionMembers(memberTypes)
+		}
+	case string:
+		members = typ.UnionMembers([]interface{}{memberTypes})
+	}
+
+	filts := make([]*gql.FilterTree, 0, len(members))
+	for _, member := range members {
+		memberFilter := typeFilter(member)
+		f, _ := filter[schema.CamelCase(member.Name())+"Filter"].(map[string]interface{})
+		if len(f) > 0 {
+			memberFilter = &gql.FilterTree{
+				Op:    "and",
+				Child: []*gql.FilterTree{memberFilter, buildFilter(member, f)},
+			}
+		}
+		filts = append(filts, memberFilter)
+	}
+	return unionMembersFilter(typ, filts)
+}
+
 func maybeQuoteArg(fn string, arg interface{}) string {
 	switch arg := arg.(type) {
 	case string: // dateTime also parsed as string
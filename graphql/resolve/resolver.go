@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/graphql/dgraph"
 	"github.com/dgraph-io/dgraph/types"
 
@@ -249,12 +250,21 @@ func (rf *resolverFactory) WithConventionResolvers(
 
 	queries := append(s.Queries(schema.GetQuery), s.Queries(schema.FilterQuery)...)
 	queries = append(queries, s.Queries(schema.PasswordQuery)...)
+	queries = append(queries, s.Queries(schema.AggregateQuery)...)
+	queries = append(queries, s.Queries(schema.ConnectionQuery)...)
+	queries = append(queries, s.Queries(schema.EntitiesQuery)...)
 	for _, q := range queries {
 		rf.WithQueryResolver(q, func(q schema.Query) QueryResolver {
 			return NewQueryResolver(fns.Qrw, fns.Ex, StdQueryCompletion())
 		})
 	}
 
+	for _, q := range s.Queries(schema.ServiceQuery) {
+		rf.WithQueryResolver(q, func(q schema.Query) QueryResolver {
+			return QueryResolverFunc(resolveApolloServiceQuery)
+		})
+	}
+
 	for _, q := range s.Queries(schema.HTTPQuery) {
 		rf.WithQueryResolver(q, func(q schema.Query) QueryResolver {
 			return NewHTTPQueryResolver(&http.Client{
@@ -630,6 +640,52 @@ func noopCompletion(ctx context.Context, resolved *Resolved) {}
 //                           "friends": completeValue ( completeList([ completeObject(..), ..]) } )
 //
 
+// connectionResult turns the nodes that Dgraph found for the connection query q into the
+// connection, a page of edges and its page info.
+//
+//   "q":[{ "title": "A", "_cursor_": "..." }, ...]  --->
+//   "q":{ "edges": [{ "node": { "title": "A", ... }, "cursor": "..." }, ...],
+//         "pageInfo": { "startCursor": "...", "endCursor": "...", "hasNextPage": true } }
+//
+// The query asked for one more node than the page has, so that there's a next page if Dgraph
+// found it.  It returns false if the result isn't a list of nodes.
+func connectionResult(q schema.Query, val interface{}) (map[string]interface{}, bool) {
+	nodes, ok := val.([]interface{})
+	if !ok && val != nil {
+		return nil, false
+	}
+
+	hasNextPage := false
+	if first, hasFirst, _ := connectionFirst(q); hasFirst && int64(len(nodes)) > first {
+		nodes = nodes[:first]
+		hasNextPage = true
+	}
+
+	edges := make([]interface{}, 0, len(nodes))
+	var startCursor, endCursor interface{}
+	for i, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		cursor := node[gql.CursorAttr]
+		edges = append(edges, map[string]interface{}{"node": node, "cursor": cursor})
+		if i == 0 {
+			startCursor = cursor
+		}
+		endCursor = cursor
+	}
+
+	return map[string]interface{}{
+		"edges": edges,
+		"pageInfo": map[string]interface{}{
+			"startCursor": startCursor,
+			"endCursor":   endCursor,
+			"hasNextPage": hasNextPage,
+		},
+	}, true
+}
+
 // completeDgraphResult starts the recursion with field as the top level GraphQL
 // query and dgResult as the matching full Dgraph result.  Always returns a valid
 // JSON []byte of the form
@@ -699,8 +755,44 @@ func completeDgraphResult(
 			schema.GQLWrapLocationf(err, field.Location(), "couldn't unmarshal Dgraph result"))
 	}
 
+	if q, ok := field.(schema.Query); ok && q.QueryType() == schema.EntitiesQuery {
+		// Apollo gateway expects an entity, or null, for each of the representations, in the
+		// order of the representations.  Dgraph returns the entities it found ordered by uid.
+		valToComplete[field.DgraphAlias()] =
+			entitiesInRepresentationOrder(q, valToComplete[field.DgraphAlias()])
+	}
+
+	if q, ok := field.(schema.Query); ok && q.QueryType() == schema.ConnectionQuery {
+		conn, ok := connectionResult(q, valToComplete[field.DgraphAlias()])
+		if !ok {
+			return dgraphError()
+		}
+		valToComplete[field.DgraphAlias()] = conn
+	}
+
 	switch val := valToComplete[field.DgraphAlias()].(type) {
 	case []interface{}:
+		if q, ok := field.(schema.Query); ok && q.QueryType() == schema.AggregateQuery {
+			// Dgraph returns each aggregation in a block without a root function as a
+			// separate object, so they are merged into the single result object.
+			//
+			//   "q":[{ "count": 3 }, { "numLikesMax": 10 }]  --->
+			//   "q":[{ "count": 3, "numLikesMax": 10 }]
+			aggregates := make(map[string]interface{})
+			for _, v := range val {
+				aggregate, ok := v.(map[string]interface{})
+				if !ok {
+					return dgraphError()
+				}
+				for k, agg := range aggregate {
+					aggregates[k] = agg
+				}
+			}
+			if len(aggregates) > 0 {
+				val = []interface{}{aggregates}
+			}
+		}
+
 		if field.Type().ListType() == nil {
 			// Turn Dgraph list result to single object
 			// "q":[{ ... }] ---> "q":{ ... }
@@ -1304,6 +1396,9 @@ func completeObject(
 		seenField[f.ResponseName()] = true
 
 		val := res[f.DgraphAlias()]
+		if f.IsAggregateField() {
+			val = aggregateFieldValue(f, res)
+		}
 		if f.Name() == schema.Typename {
 			// From GraphQL spec:
 			// https://graphql.github.io/graphql-spec/June2018/#sec-Type-Name-Introspection
@@ -1351,6 +1446,78 @@ func completeObject(
 	return buf.Bytes(), errs
 }
 
+// aggregateFieldValue collects the aggregations for the aggregate field f, which are
+// returned by Dgraph in the parent object as f.count, f.numLikesMax etc., into the
+// object for f.  It returns nil if there aren't any.
+func aggregateFieldValue(f schema.Field, res map[string]interface{}) interface{} {
+	var aggregates map[string]interface{}
+	prefix := f.DgraphAlias() + "."
+	for _, sel := range f.SelectionSet() {
+		agg, ok := res[prefix+sel.DgraphAlias()]
+		if !ok {
+			continue
+		}
+		if aggregates == nil {
+			aggregates = make(map[string]interface{})
+		}
+		aggregates[sel.DgraphAlias()] = agg
+	}
+
+	if aggregates == nil {
+		return nil
+	}
+	return aggregates
+}
+
+// entitiesInRepresentationOrder returns the entities in val, the Dgraph result of _entities
+// query q, in the order of the representations in q.  Representations that no entity was
+// found for are nil.
+func entitiesInRepresentationOrder(q schema.Query, val interface{}) []interface{} {
+	reprs, err := entityRepresentations(q)
+	if err != nil {
+		// Can't happen, the representations were checked when the query was rewritten.
+		return nil
+	}
+
+	// dgraph.type holds the Dgraph names of the types
+	members := make(map[string]schema.Type)
+	for _, member := range q.Type().UnionMembers(nil) {
+		members[member.DgraphName()] = member
+	}
+
+	// type name -> key -> entity
+	found := make(map[string]map[string]interface{})
+	entities, _ := val.([]interface{})
+	for _, e := range entities {
+		entity, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dgTypes, _ := entity["dgraph.type"].([]interface{})
+		for _, t := range dgTypes {
+			typName, _ := t.(string)
+			typ, ok := members[typName]
+			if !ok {
+				continue
+			}
+			key, ok := entity[entityKeyAlias(typ)].(string)
+			if !ok {
+				continue
+			}
+			if found[typ.Name()] == nil {
+				found[typ.Name()] = make(map[string]interface{})
+			}
+			found[typ.Name()][key] = entity
+		}
+	}
+
+	result := make([]interface{}, len(reprs))
+	for i, repr := range reprs {
+		result[i] = found[repr.typ.Name()][repr.key]
+	}
+	return result
+}
+
 // completeValue applies the value completion algorithm to a single value, which
 // could turn out to be a list or object or scalar value.
 func completeValue(
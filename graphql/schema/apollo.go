@@ -0,0 +1,145 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// apolloDirectives are the directives that Apollo gateway understands, those are the only
+// directives kept in the SDL served to the gateway.  All the others are Dgraph's own.
+var apolloDirectives = map[string]bool{
+	apolloKeyDirective:      true,
+	apolloExtendsDirective:  true,
+	apolloExternalDirective: true,
+	apolloRequiresDirective: true,
+	apolloProvidesDirective: true,
+	deprecatedDirective:     true,
+}
+
+// apolloQueries are the queries that Apollo Federation adds to every service, they aren't
+// part of the SDL the service serves.
+var apolloQueries = map[string]bool{
+	"_entities": true,
+	"_service":  true,
+}
+
+// ApolloServiceSDL returns the SDL of the schema as served by the _service query to Apollo
+// gateway.  It's the generated schema without the Dgraph specific directives, the
+// definitions only used by them and the definitions that Apollo Federation adds itself.
+func ApolloServiceSDL(s Schema) (string, error) {
+	sch, ok := s.(*schema)
+	if !ok {
+		return "", errors.New("couldn't convert schema to internal type " +
+			"this indicates bug. Please let us know by filing an issue.")
+	}
+
+	defns := make(map[string]*ast.Definition)
+	var collect func(name string)
+	collect = func(name string) {
+		defn := sch.schema.Types[name]
+		if defn == nil || defn.BuiltIn || defns[name] != nil {
+			return
+		}
+		defn = apolloDefinition(defn)
+		defns[name] = defn
+
+		for _, fld := range defn.Fields {
+			collect(fld.Type.Name())
+			for _, arg := range fld.Arguments {
+				collect(arg.Type.Name())
+			}
+		}
+		for _, member := range defn.Types {
+			collect(member)
+		}
+		for _, impl := range sch.schema.PossibleTypes[name] {
+			collect(impl.Name)
+		}
+		for _, iface := range defn.Interfaces {
+			collect(iface)
+		}
+	}
+	// Subscriptions aren't supported by Apollo gateway.
+	if sch.schema.Query != nil {
+		collect(sch.schema.Query.Name)
+	}
+	if sch.schema.Mutation != nil && len(sch.schema.Mutation.Fields) > 0 {
+		collect(sch.schema.Mutation.Name)
+	}
+
+	names := make([]string, 0, len(defns))
+	for name := range defns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sdl strings.Builder
+	for _, name := range names {
+		defn := defns[name]
+		switch defn.Kind {
+		case ast.Scalar:
+			x.Check2(sdl.WriteString(fmt.Sprintf("%sscalar %s\n",
+				generateDescription(defn.Description), defn.Name)))
+		case ast.Object:
+			x.Check2(sdl.WriteString(generateObjectString(defn)))
+		case ast.Interface:
+			x.Check2(sdl.WriteString(generateInterfaceString(defn)))
+		case ast.Union:
+			x.Check2(sdl.WriteString(generateUnionString(defn)))
+		case ast.Enum:
+			x.Check2(sdl.WriteString(generateEnumString(defn)))
+		case ast.InputObject:
+			x.Check2(sdl.WriteString(generateInputString(defn)))
+		}
+		x.Check2(sdl.WriteString("\n"))
+	}
+	return sdl.String(), nil
+}
+
+// apolloDefinition returns a copy of defn with only the directives that Apollo gateway
+// understands, and without the Apollo Federation queries if defn is the Query type.
+func apolloDefinition(defn *ast.Definition) *ast.Definition {
+	apolloDefn := *defn
+	apolloDefn.Directives = apolloDirectiveList(defn.Directives)
+	apolloDefn.Fields = make(ast.FieldList, 0, len(defn.Fields))
+	for _, fld := range defn.Fields {
+		if defn.Name == "Query" && apolloQueries[fld.Name] {
+			continue
+		}
+		apolloFld := *fld
+		apolloFld.Directives = apolloDirectiveList(fld.Directives)
+		apolloDefn.Fields = append(apolloDefn.Fields, &apolloFld)
+	}
+	return &apolloDefn
+}
+
+func apolloDirectiveList(dirs ast.DirectiveList) ast.DirectiveList {
+	var result ast.DirectiveList
+	for _, dir := range dirs {
+		if apolloDirectives[dir.Name] {
+			result = append(result, dir)
+		}
+	}
+	return result
+}
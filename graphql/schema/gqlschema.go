@@ -46,6 +46,14 @@ const (
 	cascadeArg            = "fields"
 	SubscriptionDirective = "withSubscription"
 
+	// Apollo Federation directives
+	apolloKeyDirective      = "key"
+	apolloKeyArg            = "fields"
+	apolloExtendsDirective  = "extends"
+	apolloExternalDirective = "external"
+	apolloRequiresDirective = "requires"
+	apolloProvidesDirective = "provides"
+
 	// custom directive args and fields
 	dqlArg = "dql"
 	mode   = "mode"
@@ -80,7 +88,11 @@ enum DgraphIndex {
 	float
 	bool
 	hash
+	hash_ci
+	hash_ci_ai
 	exact
+	exact_ci
+	exact_ci_ai
 	term
 	fulltext
 	trigram
@@ -137,6 +149,29 @@ directive @auth(
 directive @custom(http: CustomHTTP, dql: String) on FIELD_DEFINITION
 directive @remote on OBJECT | INTERFACE
 directive @cascade(fields: [String]) on FIELD
+directive @key(fields: String!) on OBJECT | INTERFACE
+directive @extends on OBJECT | INTERFACE
+directive @external on FIELD_DEFINITION
+directive @requires(fields: String!) on FIELD_DEFINITION
+directive @provides(fields: String!) on FIELD_DEFINITION
+
+"""
+The _Any scalar is used by Apollo Federation for the representations given to _entities.
+"""
+scalar _Any
+
+type _Service {
+	sdl: String
+}
+
+"""
+The page of results returned by a queryTConnection query, as in a Relay connection.
+"""
+type PageInfo {
+	startCursor: String
+	endCursor: String
+	hasNextPage: Boolean!
+}
 
 input IntFilter {
 	eq: Int
@@ -230,20 +265,24 @@ var numUids = &ast.FieldDefinition{
 // search arg -> supported GraphQL type
 // == supported Dgraph index -> GraphQL type it applies to
 var supportedSearches = map[string]searchTypeIndex{
-	"int":      {"Int", "int"},
-	"int64":    {"Int64", "int"},
-	"float":    {"Float", "float"},
-	"bool":     {"Boolean", "bool"},
-	"hash":     {"String", "hash"},
-	"exact":    {"String", "exact"},
-	"term":     {"String", "term"},
-	"fulltext": {"String", "fulltext"},
-	"trigram":  {"String", "trigram"},
-	"regexp":   {"String", "trigram"},
-	"year":     {"DateTime", "year"},
-	"month":    {"DateTime", "month"},
-	"day":      {"DateTime", "day"},
-	"hour":     {"DateTime", "hour"},
+	"int":         {"Int", "int"},
+	"int64":       {"Int64", "int"},
+	"float":       {"Float", "float"},
+	"bool":        {"Boolean", "bool"},
+	"hash":        {"String", "hash"},
+	"hash_ci":     {"String", "hash_ci"},
+	"hash_ci_ai":  {"String", "hash_ci_ai"},
+	"exact":       {"String", "exact"},
+	"exact_ci":    {"String", "exact_ci"},
+	"exact_ci_ai": {"String", "exact_ci_ai"},
+	"term":        {"String", "term"},
+	"fulltext":    {"String", "fulltext"},
+	"trigram":     {"String", "trigram"},
+	"regexp":      {"String", "trigram"},
+	"year":        {"DateTime", "year"},
+	"month":       {"DateTime", "month"},
+	"day":         {"DateTime", "day"},
+	"hour":        {"DateTime", "hour"},
 }
 
 // GraphQL scalar type -> default search arg
@@ -289,22 +328,33 @@ var enumDirectives = map[string]bool{
 	"regexp":  true,
 }
 
+// GraphQL types that can be summed and averaged in TAggregateResult types.
+var numeric = map[string]bool{
+	"Int":   true,
+	"Int64": true,
+	"Float": true,
+}
+
 // index name -> GraphQL input filter for that index
 var builtInFilters = map[string]string{
-	"bool":     "Boolean",
-	"int":      "IntFilter",
-	"int64":    "Int64Filter",
-	"float":    "FloatFilter",
-	"year":     "DateTimeFilter",
-	"month":    "DateTimeFilter",
-	"day":      "DateTimeFilter",
-	"hour":     "DateTimeFilter",
-	"term":     "StringTermFilter",
-	"trigram":  "StringRegExpFilter",
-	"regexp":   "StringRegExpFilter",
-	"fulltext": "StringFullTextFilter",
-	"exact":    "StringExactFilter",
-	"hash":     "StringHashFilter",
+	"bool":        "Boolean",
+	"int":         "IntFilter",
+	"int64":       "Int64Filter",
+	"float":       "FloatFilter",
+	"year":        "DateTimeFilter",
+	"month":       "DateTimeFilter",
+	"day":         "DateTimeFilter",
+	"hour":        "DateTimeFilter",
+	"term":        "StringTermFilter",
+	"trigram":     "StringRegExpFilter",
+	"regexp":      "StringRegExpFilter",
+	"fulltext":    "StringFullTextFilter",
+	"exact":       "StringExactFilter",
+	"exact_ci":    "StringExactFilter",
+	"exact_ci_ai": "StringExactFilter",
+	"hash":        "StringHashFilter",
+	"hash_ci":     "StringHashFilter",
+	"hash_ci_ai":  "StringHashFilter",
 }
 
 // GraphQL scalar -> Dgraph scalar
@@ -339,7 +389,12 @@ var directiveValidators = map[string]directiveValidator{
 	deprecatedDirective:   ValidatorNoOp,
 	SubscriptionDirective: ValidatorNoOp,
 	// Just go get it printed into generated schema
-	authDirective: ValidatorNoOp,
+	authDirective:           ValidatorNoOp,
+	apolloKeyDirective:      ValidatorNoOp,
+	apolloExtendsDirective:  ValidatorNoOp,
+	apolloExternalDirective: apolloExternalValidation,
+	apolloRequiresDirective: apolloRequiresValidation,
+	apolloProvidesDirective: apolloProvidesValidation,
 }
 
 var schemaDocValidations []func(schema *ast.SchemaDocument) gqlerror.List
@@ -543,6 +598,14 @@ func completeSchema(sch *ast.Schema, definitions []string) {
 			continue
 		}
 		defn := sch.Types[key]
+		if defn.Kind == ast.Union {
+			// Unions don't get queries or mutations of their own, only the inputs needed by
+			// fields of the union type.
+			addUnionMemberTypeEnum(sch, defn)
+			addUnionFilterType(sch, defn)
+			addUnionReferenceType(sch, defn)
+			continue
+		}
 		if defn.Kind != ast.Interface && defn.Kind != ast.Object {
 			continue
 		}
@@ -574,6 +637,28 @@ func completeSchema(sch *ast.Schema, definitions []string) {
 		addFieldFilters(sch, defn)
 		addQueries(sch, defn)
 		addTypeHasFilter(sch, defn)
+		addAggregationResultType(sch, defn)
+		addConnectionTypes(sch, defn)
+	}
+
+	// The <field>Aggregate fields are added once all the TAggregateResult types exist, so
+	// that they don't show up in the types and inputs generated from the fields of a type.
+	for _, key := range definitions {
+		if isQueryOrMutation(key) {
+			continue
+		}
+		defn := sch.Types[key]
+		if defn.Kind != ast.Interface && defn.Kind != ast.Object {
+			continue
+		}
+		addAggregateFields(sch, defn)
+	}
+
+	addEntitiesQuery(sch, definitions)
+	// A service without any queries can't be part of a federated graph, so there's no
+	// _service query for it either.
+	if len(sch.Query.Fields) > 0 {
+		addServiceQuery(sch)
 	}
 }
 
@@ -611,6 +696,71 @@ func addReferenceType(schema *ast.Schema, defn *ast.Definition) {
 	}
 }
 
+// addUnionMemberTypeEnum adds `enum UType { ... }`, listing the members of union U, to the schema.
+// It's used to pick which members a field of type U should return.
+func addUnionMemberTypeEnum(schema *ast.Schema, defn *ast.Definition) {
+	enumName := defn.Name + "Type"
+	enum := &ast.Definition{
+		Kind: ast.Enum,
+		Name: enumName,
+	}
+	for _, member := range defn.Types {
+		enum.EnumValues = append(enum.EnumValues, &ast.EnumValueDefinition{Name: member})
+	}
+	schema.Types[enumName] = enum
+}
+
+// addUnionFilterType adds an `input UFilter { ... }` for union U like
+// input UFilter {
+//   memberTypes: [UType!]
+//   dogFilter: DogFilter
+//   humanFilter: HumanFilter
+// }
+// so that a field of type U can be filtered down to some of its members, and each member with
+// its own filter.
+func addUnionFilterType(schema *ast.Schema, defn *ast.Definition) {
+	filterName := defn.Name + "Filter"
+	filter := &ast.Definition{
+		Kind: ast.InputObject,
+		Name: filterName,
+		Fields: ast.FieldList{
+			{
+				Name: "memberTypes",
+				Type: ast.ListType(&ast.Type{NamedType: defn.Name + "Type", NonNull: true}, nil),
+			},
+		},
+	}
+	for _, member := range defn.Types {
+		filter.Fields = append(filter.Fields, &ast.FieldDefinition{
+			Name: CamelCase(member) + "Filter",
+			Type: &ast.Type{NamedType: member + "Filter"},
+		})
+	}
+	schema.Types[filterName] = filter
+}
+
+// addUnionReferenceType adds an `input URef { ... }` for union U like
+// input URef {
+//   dogRef: DogRef
+//   humanRef: HumanRef
+// }
+// GraphQL doesn't allow unions in inputs, so a reference to, or a new node for, a field of type U
+// in a mutation gives exactly one of the member references, which says what type the node is.
+func addUnionReferenceType(schema *ast.Schema, defn *ast.Definition) {
+	refName := defn.Name + "Ref"
+	ref := &ast.Definition{
+		Kind: ast.InputObject,
+		Name: refName,
+	}
+	for _, member := range defn.Types {
+		ref.Fields = append(ref.Fields, &ast.FieldDefinition{
+			Name: CamelCase(member) + "Ref",
+			Type: &ast.Type{NamedType: member + "Ref"},
+		})
+	}
+	schema.Types[refName] = ref
+}
+
 func addUpdateType(schema *ast.Schema, defn *ast.Definition) {
 	if !hasFilterable(defn) {
 		return
@@ -710,8 +860,12 @@ func addFieldFilters(schema *ast.Schema, defn *ast.Definition) {
 }
 
 func addFilterArgument(schema *ast.Schema, fld *ast.FieldDefinition) {
-	fldType := fld.Type.Name()
-	if hasFilterable(schema.Types[fldType]) {
+	addFilterArgumentForType(schema, fld, fld.Type.Name())
+}
+
+// addFilterArgumentForType adds a `filter: TFilter` argument to fld, where T is fldType.
+func addFilterArgumentForType(schema *ast.Schema, fld *ast.FieldDefinition, fldType string) {
+	if schema.Types[fldType].Kind == ast.Union || hasFilterable(schema.Types[fldType]) {
 		fld.Arguments = append(fld.Arguments,
 			&ast.ArgumentDefinition{
 				Name: "filter",
@@ -750,7 +904,11 @@ func addTypeHasFilter(schema *ast.Schema, defn *ast.Definition) {
 }
 
 func addOrderArgument(schema *ast.Schema, fld *ast.FieldDefinition) {
-	fldType := fld.Type.Name()
+	addOrderArgumentForType(schema, fld, fld.Type.Name())
+}
+
+// addOrderArgumentForType adds an `order: TOrder` argument to fld, where T is fldType.
+func addOrderArgumentForType(schema *ast.Schema, fld *ast.FieldDefinition, fldType string) {
 	if hasOrderables(schema.Types[fldType]) {
 		fld.Arguments = append(fld.Arguments,
 			&ast.ArgumentDefinition{
@@ -1035,7 +1193,7 @@ func addTypeOrderable(schema *ast.Schema, defn *ast.Definition) {
 
 func addAddPayloadType(schema *ast.Schema, defn *ast.Definition) {
 	qry := &ast.FieldDefinition{
-		Name: camelCase(defn.Name),
+		Name: CamelCase(defn.Name),
 		Type: ast.ListType(&ast.Type{
 			NamedType: defn.Name,
 		}, nil),
@@ -1065,7 +1223,7 @@ func addUpdatePayloadType(schema *ast.Schema, defn *ast.Definition) {
 	}
 
 	qry := &ast.FieldDefinition{
-		Name: camelCase(defn.Name),
+		Name: CamelCase(defn.Name),
 		Type: &ast.Type{
 			Elem: &ast.Type{
 				NamedType: defn.Name,
@@ -1092,7 +1250,7 @@ func addDeletePayloadType(schema *ast.Schema, defn *ast.Definition) {
 	}
 
 	qry := &ast.FieldDefinition{
-		Name: camelCase(defn.Name),
+		Name: CamelCase(defn.Name),
 		Type: ast.ListType(&ast.Type{
 			NamedType: defn.Name,
 		}, nil),
@@ -1178,6 +1336,27 @@ func addFilterQuery(schema *ast.Schema, defn *ast.Definition) {
 
 }
 
+// addConnectionQuery adds the query
+// queryTConnection(filter: TFilter, order: TOrder, first: Int, after: String): TConnection
+// that pages through the same results as queryT like a Relay connection, each page starting
+// after the cursor of the last edge of the previous page.
+func addConnectionQuery(schema *ast.Schema, defn *ast.Definition) {
+	qry := &ast.FieldDefinition{
+		Name: "query" + defn.Name + connection,
+		Type: &ast.Type{
+			NamedType: defn.Name + connection,
+		},
+	}
+	addFilterArgumentForType(schema, qry, defn.Name)
+	addOrderArgumentForType(schema, qry, defn.Name)
+	qry.Arguments = append(qry.Arguments,
+		&ast.ArgumentDefinition{Name: "first", Type: &ast.Type{NamedType: "Int"}},
+		&ast.ArgumentDefinition{Name: "after", Type: &ast.Type{NamedType: "String"}},
+	)
+
+	schema.Query.Fields = append(schema.Query.Fields, qry)
+}
+
 func addPasswordQuery(schema *ast.Schema, defn *ast.Definition) {
 	hasIDField := hasID(defn)
 	hasXIDField := hasXID(defn)
@@ -1216,10 +1395,181 @@ func addPasswordQuery(schema *ast.Schema, defn *ast.Definition) {
 	schema.Query.Fields = append(schema.Query.Fields, qry)
 }
 
+// addAggregationResultType adds a `type TAggregateResult { ... }` to the schema, if defn is
+// a type T.  It's the result of aggregateT queries and of <field>Aggregate fields of type T.
+// It always has the count of the nodes, each orderable scalar field f of T gets fMin and
+// fMax, and each numeric field also gets fSum and fAvg.  For example:
+// type PostAggregateResult {
+//   count: Int
+//   titleMin: String
+//   titleMax: String
+//   numLikesMin: Int
+//   numLikesMax: Int
+//   numLikesSum: Int
+//   numLikesAvg: Float
+// }
+func addAggregationResultType(schema *ast.Schema, defn *ast.Definition) {
+	aggregateName := defn.Name + aggregateResult
+	aggregate := &ast.Definition{
+		Kind:   ast.Object,
+		Name:   aggregateName,
+		Fields: ast.FieldList{{Name: "count", Type: &ast.Type{NamedType: "Int"}}},
+	}
+
+	for _, fld := range defn.Fields {
+		// lists can't be aggregated and NamedType will be empty for lists. Fields with
+		// @custom aren't stored in Dgraph, so there's nothing to aggregate for them.
+		if !orderable[fld.Type.NamedType] || fld.Directives.ForName(customDirective) != nil {
+			continue
+		}
+
+		typ := fld.Type.NamedType
+		aggregate.Fields = append(aggregate.Fields,
+			&ast.FieldDefinition{Name: fld.Name + "Min", Type: &ast.Type{NamedType: typ}},
+			&ast.FieldDefinition{Name: fld.Name + "Max", Type: &ast.Type{NamedType: typ}})
+		if numeric[typ] {
+			aggregate.Fields = append(aggregate.Fields,
+				&ast.FieldDefinition{Name: fld.Name + "Sum", Type: &ast.Type{NamedType: typ}},
+				&ast.FieldDefinition{Name: fld.Name + "Avg", Type: &ast.Type{NamedType: "Float"}})
+		}
+	}
+
+	schema.Types[aggregateName] = aggregate
+}
+
+// addConnectionTypes adds the types of the results of queryTConnection for defn T
+// type TConnection {
+//   edges: [TEdge!]!
+//   pageInfo: PageInfo!
+// }
+// type TEdge {
+//   node: T!
+//   cursor: String!
+// }
+func addConnectionTypes(schema *ast.Schema, defn *ast.Definition) {
+	edgeName := defn.Name + "Edge"
+	schema.Types[edgeName] = &ast.Definition{
+		Kind: ast.Object,
+		Name: edgeName,
+		Fields: ast.FieldList{
+			{Name: "node", Type: &ast.Type{NamedType: defn.Name, NonNull: true}},
+			{Name: "cursor", Type: &ast.Type{NamedType: "String", NonNull: true}},
+		},
+	}
+
+	connectionName := defn.Name + connection
+	schema.Types[connectionName] = &ast.Definition{
+		Kind: ast.Object,
+		Name: connectionName,
+		Fields: ast.FieldList{
+			{
+				Name: "edges",
+				Type: &ast.Type{Elem: &ast.Type{NamedType: edgeName, NonNull: true}, NonNull: true},
+			},
+			{Name: "pageInfo", Type: &ast.Type{NamedType: "PageInfo", NonNull: true}},
+		},
+	}
+}
+
+// addAggregateFields adds a field
+// fAggregate(filter: RFilter): RAggregateResult
+// to defn for every field f of defn that's a list of some type R, so that a query can
+// aggregate over the R's linked to each result, like:
+// queryAuthor {
+//   name
+//   postsAggregate(filter: { ... }) { count numLikesMax }
+// }
+func addAggregateFields(schema *ast.Schema, defn *ast.Definition) {
+	var aggregateFields ast.FieldList
+	for _, fld := range defn.Fields {
+		if fld.Type.Elem == nil || fld.Directives.ForName(customDirective) != nil {
+			continue
+		}
+		// Only types that we completed have an aggregate result type; scalars and remote types
+		// don't.
+		aggregateName := fld.Type.Name() + aggregateResult
+		if _, ok := schema.Types[aggregateName]; !ok {
+			continue
+		}
+
+		aggregateFld := &ast.FieldDefinition{
+			Name: fld.Name + "Aggregate",
+			Type: &ast.Type{NamedType: aggregateName},
+		}
+		addFilterArgumentForType(schema, aggregateFld, fld.Type.Name())
+		aggregateFields = append(aggregateFields, aggregateFld)
+	}
+	defn.Fields = append(defn.Fields, aggregateFields...)
+}
+
+func addAggregateQuery(schema *ast.Schema, defn *ast.Definition) {
+	qry := &ast.FieldDefinition{
+		Name: "aggregate" + defn.Name,
+		Type: &ast.Type{
+			NamedType: defn.Name + aggregateResult,
+		},
+	}
+	addFilterArgumentForType(schema, qry, defn.Name)
+
+	schema.Query.Fields = append(schema.Query.Fields, qry)
+}
+
+// addEntitiesQuery adds the _Entity union of all the types with @key and the query
+// _entities(representations: [_Any!]!): [_Entity]!
+// that Apollo gateway uses to fetch those types from this service by their keys.  Nothing is
+// added if no type has @key.
+func addEntitiesQuery(schema *ast.Schema, definitions []string) {
+	entity := &ast.Definition{
+		Kind: ast.Union,
+		Name: entityUnion,
+	}
+	for _, key := range definitions {
+		defn := schema.Types[key]
+		if defn.Kind == ast.Object && defn.Directives.ForName(apolloKeyDirective) != nil {
+			entity.Types = append(entity.Types, defn.Name)
+			schema.AddPossibleType(entity.Name, defn)
+		}
+	}
+	if len(entity.Types) == 0 {
+		return
+	}
+	schema.Types[entity.Name] = entity
+
+	qry := &ast.FieldDefinition{
+		Name: "_entities",
+		Type: &ast.Type{
+			Elem:    &ast.Type{NamedType: entity.Name},
+			NonNull: true,
+		},
+		Arguments: []*ast.ArgumentDefinition{
+			{
+				Name: "representations",
+				Type: &ast.Type{
+					Elem:    &ast.Type{NamedType: "_Any", NonNull: true},
+					NonNull: true,
+				},
+			},
+		},
+	}
+	schema.Query.Fields = append(schema.Query.Fields, qry)
+}
+
+// addServiceQuery adds the query `_service: _Service!` that Apollo gateway uses to fetch the
+// SDL of this service.
+func addServiceQuery(schema *ast.Schema) {
+	qry := &ast.FieldDefinition{
+		Name: "_service",
+		Type: &ast.Type{NamedType: "_Service", NonNull: true},
+	}
+	schema.Query.Fields = append(schema.Query.Fields, qry)
+}
+
 func addQueries(schema *ast.Schema, defn *ast.Definition) {
 	addGetQuery(schema, defn)
 	addPasswordQuery(schema, defn)
 	addFilterQuery(schema, defn)
+	addConnectionQuery(schema, defn)
+	addAggregateQuery(schema, defn)
 }
 
 func addAddMutation(schema *ast.Schema, defn *ast.Definition) {
@@ -1296,7 +1646,8 @@ func addMutations(schema *ast.Schema, defn *ast.Definition) {
 
 func createField(schema *ast.Schema, fld *ast.FieldDefinition) *ast.FieldDefinition {
 	if schema.Types[fld.Type.Name()].Kind == ast.Object ||
-		schema.Types[fld.Type.Name()].Kind == ast.Interface {
+		schema.Types[fld.Type.Name()].Kind == ast.Interface ||
+		schema.Types[fld.Type.Name()].Kind == ast.Union {
 		newDefn := &ast.FieldDefinition{
 			Name: fld.Name,
 		}
@@ -1559,6 +1910,12 @@ func generateInterfaceString(typ *ast.Definition) string {
 		genFieldsString(typ.Fields))
 }
 
+func generateUnionString(typ *ast.Definition) string {
+	return fmt.Sprintf("%sunion %s%s = %s\n",
+		generateDescription(typ.Description), typ.Name, genDirectivesString(typ.Directives),
+		strings.Join(typ.Types, " | "))
+}
+
 func generateObjectString(typ *ast.Definition) string {
 	if len(typ.Interfaces) > 0 {
 		interfaces := strings.Join(typ.Interfaces, " & ")
@@ -1587,7 +1944,7 @@ func Stringify(schema *ast.Schema, originalTypes []string) string {
 
 	printed := make(map[string]bool)
 
-	// original defs can only be types and enums, print those in the same order
+	// original defs can only be types, unions and enums, print those in the same order
 	// as the original schema.
 	for _, typName := range originalTypes {
 		if isQueryOrMutation(typName) {
@@ -1600,6 +1957,8 @@ func Stringify(schema *ast.Schema, originalTypes []string) string {
 			x.Check2(original.WriteString(generateInterfaceString(typ) + "\n"))
 		case ast.Object:
 			x.Check2(original.WriteString(generateObjectString(typ) + "\n"))
+		case ast.Union:
+			x.Check2(original.WriteString(generateUnionString(typ) + "\n"))
 		case ast.Enum:
 			x.Check2(original.WriteString(generateEnumString(typ) + "\n"))
 		case ast.InputObject:
@@ -1640,12 +1999,14 @@ func Stringify(schema *ast.Schema, originalTypes []string) string {
 	sort.Strings(typeNames)
 
 	// Now consider the types generated by completeSchema, which can only be
-	// types, inputs and enums
+	// types, unions, inputs and enums
 	for _, typName := range typeNames {
 		typ := schema.Types[typName]
 		switch typ.Kind {
 		case ast.Object:
 			x.Check2(object.WriteString(generateObjectString(typ) + "\n"))
+		case ast.Union:
+			x.Check2(object.WriteString(generateUnionString(typ) + "\n"))
 		case ast.InputObject:
 			x.Check2(input.WriteString(generateInputString(typ) + "\n"))
 		case ast.Enum:
@@ -1727,7 +2088,9 @@ func isGraphqlSpecScalar(typ string) bool {
 	return ok
 }
 
-func camelCase(x string) string {
+// CamelCase lower cases the first letter of x, as in the names generated for queries, and for
+// the fields of union filters and references.
+func CamelCase(x string) string {
 	if x == "" {
 		return ""
 	}
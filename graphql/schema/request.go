@@ -164,6 +164,11 @@ func recursivelyExpandFragmentSelections(field *ast.Field, op *operation) {
 	case ast.Union:
 		// expand fragments on types of which it is a union
 		additionalTypes = getTypeNamesAsMap(op.inSchema.schema.PossibleTypes[typeName])
+		// fields in a union's selection set can only come from fragments on its members, so the
+		// same mapping as for interfaces tells completion which member each field belongs to.
+		for _, f := range field.SelectionSet {
+			addSelectionToInterfaceImplFragFields(typeName, f, additionalTypes, op)
+		}
 	case ast.Object:
 		// expand fragments on interfaces which are implemented by this object
 		additionalTypes = getTypeNamesAsMap(op.inSchema.schema.Implements[typeName])
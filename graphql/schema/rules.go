@@ -39,7 +39,7 @@ func init() {
 	schemaValidations = append(schemaValidations, dgraphDirectivePredicateValidation)
 	typeValidations = append(typeValidations, idCountCheck, dgraphDirectiveTypeValidation,
 		passwordDirectiveValidation, conflictingDirectiveValidation, nonIdFieldsCheck,
-		remoteTypeValidation)
+		remoteTypeValidation, unionTypeValidation, apolloKeyValidation, apolloExtendsValidation)
 	fieldValidations = append(fieldValidations, listValidityCheck, fieldArgumentCheck,
 		fieldNameCheck, isValidFieldForList, hasAuthDirective)
 
@@ -352,6 +352,7 @@ func customQueryNameValidation(schema *ast.SchemaDocument) gqlerror.List {
 		forbiddenNames["get"+defName] = true
 		forbiddenNames["check"+defName+"Password"] = true
 		forbiddenNames["query"+defName] = true
+		forbiddenNames["query"+defName+connection] = true
 	}
 
 	for _, qry := range definedQueries {
@@ -412,13 +413,13 @@ func customMutationNameValidation(schema *ast.SchemaDocument) gqlerror.List {
 
 func dataTypeCheck(schema *ast.Schema, defn *ast.Definition) gqlerror.List {
 	if defn.Kind == ast.Object || defn.Kind == ast.Enum || defn.Kind == ast.Interface || defn.
-		Kind == ast.InputObject {
+		Kind == ast.InputObject || defn.Kind == ast.Union {
 		return nil
 	}
 	return []*gqlerror.Error{gqlerror.ErrorPosf(
 		defn.Position,
 		"You can't add %s definitions. "+
-			"Only type, interface, input and enums are allowed in initial schema.",
+			"Only type, interface, union, input and enums are allowed in initial schema.",
 		strings.ToLower(string(defn.Kind)))}
 }
 
@@ -572,7 +573,7 @@ func dgraphDirectiveTypeValidation(schema *ast.Schema, typ *ast.Definition) gqle
 // to be a valid type. Otherwise its not possible to add objects of that type.
 func nonIdFieldsCheck(schema *ast.Schema, typ *ast.Definition) gqlerror.List {
 	if isQueryOrMutation(typ.Name) || typ.Kind == ast.Enum || typ.Kind == ast.Interface ||
-		typ.Kind == ast.InputObject {
+		typ.Kind == ast.InputObject || typ.Kind == ast.Union {
 		return nil
 	}
 
@@ -658,6 +659,74 @@ func remoteTypeValidation(schema *ast.Schema, typ *ast.Definition) gqlerror.List
 	return nil
 }
 
+// unionTypeValidation checks that the members of a union are types that are stored in Dgraph.
+// Fields of a union type are stored as edges to the member nodes, and dgraph.type is what tells
+// the members apart, so none of them can be @remote.
+func unionTypeValidation(schema *ast.Schema, typ *ast.Definition) gqlerror.List {
+	if typ.Kind != ast.Union {
+		return nil
+	}
+
+	var errs []*gqlerror.Error
+	for _, member := range typ.Types {
+		memberTyp := schema.Types[member]
+		if memberTyp.Directives.ForName(remoteDirective) != nil {
+			errs = append(errs, gqlerror.ErrorPosf(typ.Position, "Union %s; member %s has "+
+				"the @remote directive, but the members of a union must be types stored in "+
+				"Dgraph.", typ.Name, member))
+		}
+	}
+	return errs
+}
+
+// apolloKeyValidation checks that @key names a single field of the type that's either of type
+// ID or has @id, because those are the fields that entities can be looked up by.
+func apolloKeyValidation(schema *ast.Schema, typ *ast.Definition) gqlerror.List {
+	dir := typ.Directives.ForName(apolloKeyDirective)
+	if dir == nil {
+		return nil
+	}
+
+	if typ.Kind != ast.Object {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(dir.Position,
+			"Type %s; @key directive is only supported on types, not on interfaces.", typ.Name)}
+	}
+	if typ.Directives.ForName(remoteDirective) != nil {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(dir.Position,
+			"Type %s; @key directive isn't allowed on types with the @remote directive, as "+
+				"their nodes aren't stored in Dgraph.", typ.Name)}
+	}
+
+	keyArg := dir.Arguments.ForName(apolloKeyArg)
+	if keyArg == nil {
+		return nil
+	}
+	fld := typ.Fields.ForName(keyArg.Value.Raw)
+	if fld == nil {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(dir.Position,
+			"Type %s; @key directive uses \"%s\", but only a single field of the type can be "+
+				"used as the key.", typ.Name, keyArg.Value.Raw)}
+	}
+	if !isID(fld) && !hasIDDirective(fld) {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(dir.Position,
+			"Type %s; Field %s: a field used in @key must either be of type ID or have the @id "+
+				"directive.", typ.Name, fld.Name)}
+	}
+	return nil
+}
+
+// apolloExtendsValidation checks that a type that extends a type from another service has a
+// key, otherwise the gateway can't link the two.
+func apolloExtendsValidation(schema *ast.Schema, typ *ast.Definition) gqlerror.List {
+	dir := typ.Directives.ForName(apolloExtendsDirective)
+	if dir == nil || typ.Directives.ForName(apolloKeyDirective) != nil {
+		return nil
+	}
+	return []*gqlerror.Error{gqlerror.ErrorPosf(dir.Position,
+		"Type %s; a type with the @extends directive must also have the @key directive.",
+		typ.Name)}
+}
+
 func idCountCheck(schema *ast.Schema, typ *ast.Definition) gqlerror.List {
 	var idFields []*ast.FieldDefinition
 	var idDirectiveFields []*ast.FieldDefinition
@@ -1841,6 +1910,74 @@ func idValidation(sch *ast.Schema,
 		typ.Name, field.Name, field.Type.String())}
 }
 
+func apolloExternalValidation(sch *ast.Schema,
+	typ *ast.Definition,
+	field *ast.FieldDefinition,
+	dir *ast.Directive,
+	secrets map[string]x.SensitiveByteSlice) gqlerror.List {
+	if typ.Directives.ForName(apolloExtendsDirective) != nil {
+		return nil
+	}
+	return []*gqlerror.Error{gqlerror.ErrorPosf(
+		dir.Position,
+		"Type %s; Field %s: @external directive can only be used on fields of a type with the "+
+			"@extends directive.", typ.Name, field.Name)}
+}
+
+func apolloRequiresValidation(sch *ast.Schema,
+	typ *ast.Definition,
+	field *ast.FieldDefinition,
+	dir *ast.Directive,
+	secrets map[string]x.SensitiveByteSlice) gqlerror.List {
+	var errs []*gqlerror.Error
+	for _, name := range apolloFieldSet(dir) {
+		fld := typ.Fields.ForName(name)
+		if fld == nil || fld.Directives.ForName(apolloExternalDirective) == nil {
+			errs = append(errs, gqlerror.ErrorPosf(
+				dir.Position,
+				"Type %s; Field %s: @requires directive uses %s, which isn't a field of %s "+
+					"with the @external directive.", typ.Name, field.Name, name, typ.Name))
+		}
+	}
+	return errs
+}
+
+func apolloProvidesValidation(sch *ast.Schema,
+	typ *ast.Definition,
+	field *ast.FieldDefinition,
+	dir *ast.Directive,
+	secrets map[string]x.SensitiveByteSlice) gqlerror.List {
+	fldTyp := sch.Types[field.Type.Name()]
+	if fldTyp == nil || fldTyp.Directives.ForName(apolloKeyDirective) == nil {
+		return []*gqlerror.Error{gqlerror.ErrorPosf(
+			dir.Position,
+			"Type %s; Field %s: @provides directive can only be used on fields of a type with "+
+				"the @key directive.", typ.Name, field.Name)}
+	}
+
+	var errs []*gqlerror.Error
+	for _, name := range apolloFieldSet(dir) {
+		fld := fldTyp.Fields.ForName(name)
+		if fld == nil || fld.Directives.ForName(apolloExternalDirective) == nil {
+			errs = append(errs, gqlerror.ErrorPosf(
+				dir.Position,
+				"Type %s; Field %s: @provides directive uses %s, which isn't a field of %s "+
+					"with the @external directive.", typ.Name, field.Name, name, fldTyp.Name))
+		}
+	}
+	return errs
+}
+
+// apolloFieldSet returns the names of the fields in the fields argument of dir.  Only a flat
+// list of fields, like "name price", is supported.
+func apolloFieldSet(dir *ast.Directive) []string {
+	arg := dir.Arguments.ForName(apolloKeyArg)
+	if arg == nil {
+		return nil
+	}
+	return strings.Fields(arg.Value.Raw)
+}
+
 func searchMessage(sch *ast.Schema, field *ast.FieldDefinition) string {
 	var possibleSearchArgs []string
 	for name, typ := range supportedSearches {
@@ -1881,6 +2018,10 @@ func isReservedKeyWord(name string) bool {
 		// Reserved Type names
 		"uid":          true,
 		"Subscription": true,
+		// Generated for Apollo Federation
+		"_Entity": true,
+		// The page of results of connection queries
+		"PageInfo": true,
 	}
 
 	caseInsensitiveKeywords := map[string]bool{
@@ -310,3 +310,26 @@ func TestOnlyCorrectSearchArgsWork(t *testing.T) {
 		})
 	}
 }
+
+func TestApolloServiceSDL(t *testing.T) {
+	str, err := ioutil.ReadFile("testdata/schemagen/input/apollo-federation.graphql")
+	require.NoError(t, err)
+
+	schHandler, errs := NewHandler(string(str), false)
+	require.NoError(t, errs)
+	sch, err := FromString(schHandler.GQLSchema())
+	require.NoError(t, err)
+
+	sdl, err := ApolloServiceSDL(sch)
+	require.NoError(t, err)
+
+	// The SDL only has the directives that Apollo gateway understands, and not the queries
+	// that Apollo Federation adds itself.
+	require.Contains(t, sdl, `type Product @key(fields: "upc") @extends {`)
+	require.Contains(t, sdl, `shippingEstimate: Int @requires(fields: "price weight")`)
+	require.NotContains(t, sdl, "@hasInverse")
+	require.NotContains(t, sdl, "@id")
+	require.NotContains(t, sdl, "_entities")
+	require.NotContains(t, sdl, "_service")
+	require.NotContains(t, sdl, "_Entity")
+}
@@ -75,6 +75,10 @@ type FieldHTTPConfig struct {
 const (
 	GetQuery             QueryType    = "get"
 	FilterQuery          QueryType    = "query"
+	AggregateQuery       QueryType    = "aggregate"
+	ConnectionQuery      QueryType    = "connection"
+	EntitiesQuery        QueryType    = "entities"
+	ServiceQuery         QueryType    = "service"
 	SchemaQuery          QueryType    = "schema"
 	PasswordQuery        QueryType    = "checkPassword"
 	HTTPQuery            QueryType    = "http"
@@ -88,6 +92,10 @@ const (
 	IDType                            = "ID"
 	InputArgName                      = "input"
 	FilterArgName                     = "filter"
+
+	aggregateResult = "AggregateResult"
+	connection      = "Connection"
+	entityUnion     = "_Entity"
 )
 
 // Schema represents a valid GraphQL schema
@@ -138,6 +146,14 @@ type Field interface {
 	IsAuthQuery() bool
 	CustomHTTPConfig() (FieldHTTPConfig, error)
 	EnumValues() []string
+	// IsAggregateField tells us whether this field is a generated <field>Aggregate field.
+	IsAggregateField() bool
+	// ConstructedFor is the type whose nodes this field returns.  For aggregate fields and
+	// queries that's T rather than the TAggregateResult type, for all other fields it's Type().
+	ConstructedFor() Type
+	// DgraphPredicateForAggregateField is the predicate of the edge that an aggregate field
+	// aggregates over, e.g. Author.posts for postsAggregate.
+	DgraphPredicateForAggregateField() string
 }
 
 // A Mutation is a field (from the schema's Mutation type) from an Operation
@@ -166,6 +182,7 @@ type Type interface {
 	Fields() []FieldDefinition
 	IDField() FieldDefinition
 	XIDField() FieldDefinition
+	KeyField() FieldDefinition
 	InterfaceImplHasAuthRules() bool
 	PasswordField() FieldDefinition
 	Name() string
@@ -174,6 +191,8 @@ type Type interface {
 	Nullable() bool
 	ListType() Type
 	Interfaces() []string
+	IsUnion() bool
+	UnionMembers(memberTypes []interface{}) []Type
 	EnsureNonNulls(map[string]interface{}, string) error
 	FieldOriginatedFrom(fieldName string) string
 	AuthRules() *TypeAuth
@@ -269,7 +288,7 @@ func (s *schema) Queries(t QueryType) []string {
 	}
 	var result []string
 	for _, q := range s.schema.Query.Fields {
-		if queryType(q.Name, s.customDirectives["Query"][q.Name]) == t {
+		if queryType(q.Name, q.Type, s.customDirectives["Query"][q.Name]) == t {
 			result = append(result, q.Name)
 		}
 	}
@@ -438,6 +457,11 @@ func dgraphMapping(sch *ast.Schema) map[string]map[string]string {
 		if strings.HasPrefix(inputTypeName, add) && strings.HasSuffix(inputTypeName, payload) {
 			continue
 		}
+		// Aggregate results are computed by Dgraph, and _Service is the result of the _service
+		// query for Apollo Federation, neither of them are stored as predicates.
+		if strings.HasSuffix(inputTypeName, aggregateResult) || inputTypeName == "_Service" {
+			continue
+		}
 
 		dgraphPredicate[originalTyp.Name] = make(map[string]string)
 
@@ -467,9 +491,10 @@ func dgraphMapping(sch *ast.Schema) map[string]map[string]string {
 		}
 
 		for _, fld := range fields {
-			if isID(fld) {
+			if isID(fld) || isAggregateField(fld) {
 				// We don't need a mapping for the field, as we the dgraph predicate for them is
-				// fixed i.e. uid.
+				// fixed i.e. uid.  Aggregate fields are rewritten using the predicate of the
+				// field they aggregate over.
 				continue
 			}
 			typName := typeName(inputTyp)
@@ -553,8 +578,10 @@ func typeMappings(s *ast.Schema) map[string][]*ast.Definition {
 func repeatedFieldMappings(s *ast.Schema, dgPreds map[string]map[string]string) map[string]bool {
 	repeatedFieldNames := make(map[string]bool)
 
+	// Fields with the same name in the types implementing an interface, or in the members of a
+	// union, can be asked for together through fragments.
 	for _, typ := range s.Types {
-		if typ.Kind != ast.Interface {
+		if typ.Kind != ast.Interface && typ.Kind != ast.Union {
 			continue
 		}
 
@@ -929,6 +956,39 @@ func (f *field) GetObjectName() string {
 	return f.field.ObjectDefinition.Name
 }
 
+func (f *field) IsAggregateField() bool {
+	return f.field.Definition != nil && isAggregateField(f.field.Definition) &&
+		!isQueryOrMutationType(f.field.ObjectDefinition)
+}
+
+func isAggregateField(fd *ast.FieldDefinition) bool {
+	return strings.HasSuffix(fd.Name, "Aggregate") &&
+		strings.HasSuffix(fd.Type.Name(), aggregateResult)
+}
+
+func (f *field) ConstructedFor() Type {
+	if !f.IsAggregateField() {
+		return f.Type()
+	}
+	return aggregatedType(f.Type(), aggregateResult)
+}
+
+func (f *field) DgraphPredicateForAggregateField() string {
+	return f.op.inSchema.dgraphPredicate[f.field.ObjectDefinition.Name][strings.TrimSuffix(
+		f.Name(), "Aggregate")]
+}
+
+// aggregatedType returns the type T for the type typ named T followed by suffix, like
+// TAggregateResult or TConnection.
+func aggregatedType(typ Type, suffix string) Type {
+	t := typ.(*astType)
+	return &astType{
+		typ:             &ast.Type{NamedType: strings.TrimSuffix(t.Name(), suffix)},
+		inSchema:        t.inSchema,
+		dgraphPredicate: t.dgraphPredicate,
+	}
+}
+
 func getCustomHTTPConfig(f *field, isQueryOrMutation bool) (FieldHTTPConfig, error) {
 	custom := f.op.inSchema.customDirectives[f.GetObjectName()][f.Name()]
 	httpArg := custom.Arguments.ForName("http")
@@ -1120,8 +1180,10 @@ func (f *field) IncludeInterfaceField(dgraphTypes []interface{}) bool {
 				//	 edge, hence does not appear in f.op.inSchema.dgraphPredicate map. So, always
 				//	 include the queried field if it is of ID type.
 				// * If the field exists in the map corresponding to the object type
+				// * The field is an aggregate field, those don't have a predicate of their own.
 				_, ok = f.op.inSchema.dgraphPredicate[origTyp.Name][f.Name()]
-				return ok || f.Type().Name() == IDType || f.Name() == Typename
+				return ok || f.Type().Name() == IDType || f.Name() == Typename ||
+					f.IsAggregateField()
 			}
 		}
 
@@ -1230,8 +1292,30 @@ func (q *query) EnumValues() []string {
 	return nil
 }
 
+func (q *query) IsAggregateField() bool {
+	return false
+}
+
+func (q *query) ConstructedFor() Type {
+	switch q.QueryType() {
+	case AggregateQuery:
+		return aggregatedType(q.Type(), aggregateResult)
+	case ConnectionQuery:
+		return aggregatedType(q.Type(), connection)
+	}
+	return q.Type()
+}
+
+func (q *query) DgraphPredicateForAggregateField() string {
+	return ""
+}
+
 func (q *query) QueryType() QueryType {
-	return queryType(q.Name(), q.op.inSchema.customDirectives["Query"][q.Name()])
+	var typ *ast.Type
+	if q.field.Definition != nil {
+		typ = q.field.Definition.Type
+	}
+	return queryType(q.Name(), typ, q.op.inSchema.customDirectives["Query"][q.Name()])
 }
 
 func (q *query) DQLQuery() string {
@@ -1243,21 +1327,31 @@ func (q *query) DQLQuery() string {
 	return ""
 }
 
-func queryType(name string, custom *ast.Directive) QueryType {
+func queryType(name string, typ *ast.Type, custom *ast.Directive) QueryType {
 	switch {
 	case custom != nil:
 		if custom.Arguments.ForName(dqlArg) != nil {
 			return DQLQuery
 		}
 		return HTTPQuery
+	case name == "_entities":
+		return EntitiesQuery
+	case name == "_service":
+		return ServiceQuery
 	case strings.HasPrefix(name, "get"):
 		return GetQuery
 	case name == "__schema" || name == "__type" || name == "__typename":
 		return SchemaQuery
+	case strings.HasPrefix(name, "query") && strings.HasSuffix(name, connection) &&
+		typ != nil && typ.Elem == nil:
+		// queryT returns a list of T, while queryTConnection returns a single TConnection.
+		return ConnectionQuery
 	case strings.HasPrefix(name, "query"):
 		return FilterQuery
 	case strings.HasPrefix(name, "check"):
 		return PasswordQuery
+	case strings.HasPrefix(name, "aggregate"):
+		return AggregateQuery
 	default:
 		return NotSupportedQuery
 	}
@@ -1402,6 +1496,18 @@ func (m *mutation) GetObjectName() string {
 	return m.field.ObjectDefinition.Name
 }
 
+func (m *mutation) IsAggregateField() bool {
+	return false
+}
+
+func (m *mutation) ConstructedFor() Type {
+	return m.Type()
+}
+
+func (m *mutation) DgraphPredicateForAggregateField() string {
+	return ""
+}
+
 func (m *mutation) MutationType() MutationType {
 	return mutationType(m.Name(), m.op.inSchema.customDirectives["Mutation"][m.Name()])
 }
@@ -1715,6 +1821,35 @@ func (t *astType) XIDField() FieldDefinition {
 	return nil
 }
 
+// KeyField returns the field named in the @key directive of the type, or nil if the type
+// doesn't have @key.
+func (t *astType) KeyField() FieldDefinition {
+	def := t.inSchema.schema.Types[t.Name()]
+	if def == nil {
+		return nil
+	}
+
+	key := def.Directives.ForName(apolloKeyDirective)
+	if key == nil {
+		return nil
+	}
+	fields := key.Arguments.ForName(apolloKeyArg)
+	if fields == nil {
+		return nil
+	}
+	fd := def.Fields.ForName(fields.Value.Raw)
+	if fd == nil {
+		return nil
+	}
+
+	return &fieldDefinition{
+		fieldDef:        fd,
+		inSchema:        t.inSchema,
+		parentType:      t,
+		dgraphPredicate: t.dgraphPredicate,
+	}
+}
+
 // InterfaceImplHasAuthRules checks if an interface's implementation has auth rules.
 func (t *astType) InterfaceImplHasAuthRules() bool {
 	schema := t.inSchema.schema
@@ -1736,6 +1871,44 @@ func (t *astType) InterfaceImplHasAuthRules() bool {
 	return false
 }
 
+// IsUnion returns true if the type is a union type.
+func (t *astType) IsUnion() bool {
+	def := t.inSchema.schema.Types[t.Name()]
+	return def != nil && def.Kind == ast.Union
+}
+
+// UnionMembers returns the member types of a union type in the order they were defined.  If
+// memberTypes isn't nil, only the members named in it are returned.
+func (t *astType) UnionMembers(memberTypes []interface{}) []Type {
+	def := t.inSchema.schema.Types[t.Name()]
+	if def == nil || def.Kind != ast.Union {
+		return nil
+	}
+
+	var include map[string]bool
+	if memberTypes != nil {
+		include = make(map[string]bool, len(memberTypes))
+		for _, typ := range memberTypes {
+			if name, ok := typ.(string); ok {
+				include[name] = true
+			}
+		}
+	}
+
+	members := make([]Type, 0, len(def.Types))
+	for _, name := range def.Types {
+		if include != nil && !include[name] {
+			continue
+		}
+		members = append(members, &astType{
+			typ:             &ast.Type{NamedType: name},
+			inSchema:        t.inSchema,
+			dgraphPredicate: t.dgraphPredicate,
+		})
+	}
+	return members
+}
+
 func (t *astType) Interfaces() []string {
 	interfaces := t.inSchema.schema.Types[t.typ.Name()].Interfaces
 	if len(interfaces) == 0 {
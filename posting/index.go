@@ -24,6 +24,9 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -130,6 +133,77 @@ func (txn *Txn) addIndexMutation(ctx context.Context, edge *pb.DirectedEdge, tok
 	return nil
 }
 
+// addCompositeIndexMutations adds mutation(s) to maintain the given composite indexes, which
+// include edge.Attr, for the node of the edge when edge.Attr has the value val.
+func (txn *Txn) addCompositeIndexMutations(ctx context.Context, indexes [][]string,
+	edge *pb.DirectedEdge, val types.Val, op pb.DirectedEdge_Op) error {
+	for _, preds := range indexes {
+		token, err := txn.compositeToken(preds, edge.Entity, edge.Attr, val)
+		if err != nil {
+			return err
+		}
+		if token == "" {
+			continue
+		}
+		// The tokens of a composite index are stored in the index of its first predicate.
+		indexEdge := &pb.DirectedEdge{
+			ValueId: edge.Entity,
+			Attr:    preds[0],
+			Op:      op,
+		}
+		if err := txn.addIndexMutation(ctx, indexEdge, token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compositeToken returns the token of the composite index over preds for the node uid, when
+// attr has the value val. The values of the other predicates are read at the start ts of the txn.
+// The token is empty if the node doesn't have a value for every predicate of the index.
+func (txn *Txn) compositeToken(preds []string, uid uint64, attr string,
+	val types.Val) (string, error) {
+	vals := make([]types.Val, 0, len(preds))
+	for _, pred := range preds {
+		v := val
+		if pred != attr {
+			key := x.DataKey(pred, uid)
+			pl, err := txn.Get(key)
+			if err != nil {
+				return "", err
+			}
+			v, err = pl.Value(txn.StartTs)
+			switch {
+			case err == ErrNoValue:
+				return "", nil
+			case err != nil:
+				return "", err
+			}
+			if !x.WorkerConfig.LudicrousMode {
+				// The token depends on this value, so the txn must conflict with the txns
+				// that change it.
+				pk, err := x.Parse(key)
+				if err != nil {
+					return "", err
+				}
+				txn.addConflictKey(GetConflictKey(pk, key, &pb.DirectedEdge{Attr: pred}))
+			}
+		}
+
+		schemaType, err := schema.State().TypeOf(pred)
+		if err != nil {
+			// The predicate has no value yet.
+			return "", nil
+		}
+		sv, err := types.Convert(v, schemaType)
+		if err != nil {
+			return "", err
+		}
+		vals = append(vals, sv)
+	}
+	return tok.CompositeToken(preds, vals)
+}
+
 // countParams is sent to updateCount function. It is used to update the count index.
 // It deletes the uid from the key corresponding to <attr, countBefore> and adds it
 // to <attr, countAfter>.
@@ -270,6 +344,7 @@ func (l *List) handleDeleteAll(ctx context.Context, edge *pb.DirectedEdge, txn *
 	isReversed := schema.State().IsReversed(ctx, edge.Attr)
 	isIndexed := schema.State().IsIndexed(ctx, edge.Attr)
 	hasCount := schema.State().HasCount(ctx, edge.Attr)
+	composites := schema.State().CompositeIndexes(edge.Attr)
 	delEdge := &pb.DirectedEdge{
 		Attr:   edge.Attr,
 		Op:     edge.Op,
@@ -279,26 +354,32 @@ func (l *List) handleDeleteAll(ctx context.Context, edge *pb.DirectedEdge, txn *
 	var plen int
 	err := l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
 		plen++
-		switch {
-		case isReversed:
+		if isReversed {
 			// Delete reverse edge for each posting.
 			delEdge.ValueId = p.Uid
 			return txn.addReverseAndCountMutation(ctx, delEdge)
-		case isIndexed:
-			// Delete index edge of each posting.
-			val := types.Val{
-				Tid:   types.TypeID(p.ValType),
-				Value: p.Value,
+		}
+		val := types.Val{
+			Tid:   types.TypeID(p.ValType),
+			Value: p.Value,
+		}
+		if len(composites) > 0 && len(p.LangTag) == 0 {
+			// Delete the composite index edges of the untagged value.
+			if err := txn.addCompositeIndexMutations(ctx, composites, edge, val,
+				pb.DirectedEdge_DEL); err != nil {
+				return err
 			}
+		}
+		if isIndexed {
+			// Delete index edge of each posting.
 			return txn.addIndexMutations(ctx, &indexMutationInfo{
 				tokenizers: schema.State().Tokenizer(ctx, edge.Attr),
 				edge:       edge,
 				val:        val,
 				op:         pb.DirectedEdge_DEL,
 			})
-		default:
-			return nil
 		}
+		return nil
 	})
 	if err != nil {
 		return err
@@ -467,6 +548,10 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 
 	doUpdateIndex := pstore != nil && schema.State().IsIndexed(ctx, edge.Attr)
 	hasCountIndex := schema.State().HasCount(ctx, edge.Attr)
+	var composites [][]string
+	if pstore != nil && edge.Lang == "" {
+		composites = schema.State().CompositeIndexes(edge.Attr)
+	}
 
 	// Add reverse mutation irrespective of hasMutated, server crash can happen after
 	// mutation is synced and before reverse edge is synced
@@ -476,10 +561,13 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 		}
 	}
 
-	val, found, cp, err := txn.addMutationHelper(ctx, l, doUpdateIndex, hasCountIndex, edge)
+	val, found, cp, err := txn.addMutationHelper(ctx, l, doUpdateIndex || len(composites) > 0,
+		hasCountIndex, edge)
 	if err != nil {
 		return err
 	}
+	// The value before the mutation, as val is reused for the new value below.
+	oldVal := val
 	ostats.Record(ctx, x.NumEdges.M(1))
 	if hasCountIndex && cp.countAfter != cp.countBefore {
 		if err := txn.updateCount(ctx, cp); err != nil {
@@ -513,6 +601,24 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 			}
 		}
 	}
+	if len(composites) > 0 {
+		if found && oldVal.Value != nil {
+			if err := txn.addCompositeIndexMutations(ctx, composites, edge, oldVal,
+				pb.DirectedEdge_DEL); err != nil {
+				return err
+			}
+		}
+		if edge.Op == pb.DirectedEdge_SET {
+			newVal := types.Val{
+				Tid:   types.TypeID(edge.ValueType),
+				Value: edge.Value,
+			}
+			if err := txn.addCompositeIndexMutations(ctx, composites, edge, newVal,
+				pb.DirectedEdge_SET); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -580,11 +686,84 @@ func deleteCountIndex(attr string) error {
 	return pstore.DropPrefix(prefix)
 }
 
-// rebuilder handles the process of rebuilding an index.
+// The phases of an index build reported by IndexingProgress.
+const (
+	phaseReading = "reading data"
+	phaseWriting = "writing index"
+)
+
+// buildProgress is the progress of a running rebuilder.
+type buildProgress struct {
+	attr    string
+	index   string
+	startTs uint64
+	since   time.Time
+
+	phase       atomic.Value
+	keysRead    uint64
+	keysWritten uint64
+}
+
+// builds holds the progress of the index builds that are running.
+var builds = struct {
+	sync.Mutex
+	m map[*buildProgress]struct{}
+}{m: make(map[*buildProgress]struct{})}
+
+func startProgress(r *rebuilder) *buildProgress {
+	p := &buildProgress{attr: r.attr, index: r.index, startTs: r.startTs, since: time.Now()}
+	p.phase.Store(phaseReading)
+	builds.Lock()
+	defer builds.Unlock()
+	builds.m[p] = struct{}{}
+	return p
+}
+
+func (p *buildProgress) done() {
+	builds.Lock()
+	defer builds.Unlock()
+	delete(builds.m, p)
+}
+
+// IndexingProgress returns the progress of the index builds that are running, ordered by the
+// time at which they started.
+func IndexingProgress() []*pb.HealthInfo_IndexingProgress {
+	builds.Lock()
+	defer builds.Unlock()
+	if len(builds.m) == 0 {
+		return nil
+	}
+
+	out := make([]*pb.HealthInfo_IndexingProgress, 0, len(builds.m))
+	for p := range builds.m {
+		out = append(out, &pb.HealthInfo_IndexingProgress{
+			Predicate:   p.attr,
+			Index:       p.index,
+			Phase:       p.phase.Load().(string),
+			StartTs:     p.startTs,
+			KeysRead:    atomic.LoadUint64(&p.keysRead),
+			KeysWritten: atomic.LoadUint64(&p.keysWritten),
+			Since:       p.since.Unix(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Since != out[j].Since {
+			return out[i].Since < out[j].Since
+		}
+		return out[i].Predicate < out[j].Predicate
+	})
+	return out
+}
+
+// rebuilder handles the process of rebuilding an index. The index is built from the data at
+// startTs, while mutations keep being applied. The index is written at startTs, so the index
+// deltas of the transactions committed after startTs are read on top of it.
 type rebuilder struct {
 	attr    string
 	prefix  []byte
 	startTs uint64
+	// index describes the index being built, to report its progress.
+	index string
 
 	// The posting list passed here is the on disk version. It is not coming
 	// from the LRU cache.
@@ -597,6 +776,9 @@ func (r *rebuilder) Run(ctx context.Context) error {
 		return nil
 	}
 
+	progress := startProgress(r)
+	defer progress.done()
+
 	// We write the index in a temporary badger first and then,
 	// merge entries before writing them to p directory.
 	// TODO(Aman): If users are not happy, we could add a flag to choose this dir.
@@ -649,6 +831,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 		if err != nil {
 			return nil, errors.Wrapf(err, "error reading posting list from disk")
 		}
+		atomic.AddUint64(&progress.keysRead, 1)
 
 		// We are using different transactions in each call to KeyToList function. This could
 		// be a problem for computing reverse count indexes if deltas for same key are added
@@ -702,6 +885,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 			r.attr, time.Since(start))
 	}()
 
+	progress.phase.Store(phaseWriting)
 	writer := pstore.NewManagedWriteBatch()
 	tmpStream := tmpDB.NewStreamAt(counter)
 	tmpStream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (2/2):", r.attr)
@@ -734,6 +918,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 			if err := writer.SetEntryAt(e.WithDiscard(), r.startTs); err != nil {
 				return errors.Wrap(err, "error in writing index to pstore")
 			}
+			atomic.AddUint64(&progress.keysWritten, 1)
 		}
 
 		return nil
@@ -752,6 +937,9 @@ type IndexRebuild struct {
 	StartTs       uint64
 	OldSchema     *pb.SchemaUpdate
 	CurrentSchema *pb.SchemaUpdate
+	// OldIndexes and CurrentIndexes are the composite indexes that include Attr.
+	OldIndexes     [][]string
+	CurrentIndexes [][]string
 }
 
 type indexOp int
@@ -802,6 +990,9 @@ func (rb *IndexRebuild) DropIndexes(ctx context.Context) error {
 	if err := dropReverseEdges(ctx, rb); err != nil {
 		return err
 	}
+	if err := dropCompositeIndexes(ctx, rb); err != nil {
+		return err
+	}
 	return dropCountIndex(ctx, rb)
 }
 
@@ -810,12 +1001,13 @@ func (rb *IndexRebuild) BuildData(ctx context.Context) error {
 	return rebuildListType(ctx, rb)
 }
 
-// NeedIndexRebuild returns true if any of the tokenizer, reverse
-// or count indexes need to be rebuilt.
+// NeedIndexRebuild returns true if any of the tokenizer, reverse, count
+// or composite indexes need to be rebuilt.
 func (rb *IndexRebuild) NeedIndexRebuild() bool {
 	return rb.needsTokIndexRebuild().op == indexRebuild ||
 		rb.needsReverseEdgesRebuild() == indexRebuild ||
-		rb.needsCountIndexRebuild() == indexRebuild
+		rb.needsCountIndexRebuild() == indexRebuild ||
+		len(rb.needsCompositeIndexRebuild().toRebuild) > 0
 }
 
 // BuildIndexes builds indexes.
@@ -826,6 +1018,9 @@ func (rb *IndexRebuild) BuildIndexes(ctx context.Context) error {
 	if err := rebuildReverseEdges(ctx, rb); err != nil {
 		return err
 	}
+	if err := rebuildCompositeIndexes(ctx, rb); err != nil {
+		return err
+	}
 	return rebuildCountIndex(ctx, rb)
 }
 
@@ -962,7 +1157,8 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 	}
 
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		index: strings.Join(rebuildInfo.tokenizersToRebuild, ",")}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
@@ -1064,7 +1260,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 
 	// Create the forward index.
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		index: "count"}
 	builder.fn = fn
 	if err := builder.Run(ctx); err != nil {
 		return err
@@ -1075,7 +1272,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 	// to call builder.Run even if that's not the case as the reverse prefix
 	// will be empty.
 	reverse = true
-	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs}
+	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs,
+		index: "count reverse"}
 	builder.fn = fn
 	return builder.Run(ctx)
 }
@@ -1125,7 +1323,8 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 
 	glog.Infof("Rebuilding reverse index for %s", rb.Attr)
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		index: "reverse"}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(pp *pb.Posting) error {
@@ -1152,6 +1351,116 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 	return builder.Run(ctx)
 }
 
+// compositeRebuildInfo lists the composite indexes including the attribute that need to be
+// deleted and the ones that need to be rebuilt.
+type compositeRebuildInfo struct {
+	toDelete  [][]string
+	toRebuild [][]string
+}
+
+func (rb *IndexRebuild) needsCompositeIndexRebuild() compositeRebuildInfo {
+	x.AssertTruef(rb.CurrentSchema != nil, "Current schema cannot be nil.")
+
+	// All the composite indexes need to be rebuilt if the value type has changed,
+	// as their tokens are made of the values.
+	if rb.OldSchema != nil && rb.CurrentSchema.ValueType != rb.OldSchema.ValueType {
+		return compositeRebuildInfo{
+			toDelete:  rb.OldIndexes,
+			toRebuild: rb.CurrentIndexes,
+		}
+	}
+
+	key := func(preds []string) string {
+		return strings.Join(preds, "\x00")
+	}
+	prevIndexes := make(map[string]struct{})
+	for _, preds := range rb.OldIndexes {
+		prevIndexes[key(preds)] = struct{}{}
+	}
+	currIndexes := make(map[string]struct{})
+	for _, preds := range rb.CurrentIndexes {
+		currIndexes[key(preds)] = struct{}{}
+	}
+
+	var info compositeRebuildInfo
+	for _, preds := range rb.OldIndexes {
+		if _, ok := currIndexes[key(preds)]; !ok {
+			info.toDelete = append(info.toDelete, preds)
+		}
+	}
+	for _, preds := range rb.CurrentIndexes {
+		if _, ok := prevIndexes[key(preds)]; !ok {
+			info.toRebuild = append(info.toRebuild, preds)
+		}
+	}
+	return info
+}
+
+// deleteCompositeIndex deletes the tokens of the composite index over preds.
+func deleteCompositeIndex(preds []string) error {
+	prefix := x.IndexKey(preds[0], tok.CompositePrefix(preds))
+	if err := pstore.DropPrefix(prefix); err != nil {
+		return err
+	}
+
+	// Also delete all the parts of any list that has been split into multiple parts.
+	// Such keys have a different prefix (the last byte is set to 1).
+	prefix = x.IndexKey(preds[0], tok.CompositePrefix(preds))
+	prefix[0] = x.ByteSplit
+	return pstore.DropPrefix(prefix)
+}
+
+func dropCompositeIndexes(ctx context.Context, rb *IndexRebuild) error {
+	rebuildInfo := rb.needsCompositeIndexRebuild()
+	// Before rebuilding, the existing index needs to be deleted.
+	for _, preds := range append(rebuildInfo.toDelete, rebuildInfo.toRebuild...) {
+		glog.Infof("Deleting composite index over %v", preds)
+		if err := deleteCompositeIndex(preds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebuildCompositeIndexes rebuilds the composite indexes that include the attribute.
+func rebuildCompositeIndexes(ctx context.Context, rb *IndexRebuild) error {
+	rebuildInfo := rb.needsCompositeIndexRebuild()
+	for _, preds := range rebuildInfo.toRebuild {
+		preds := preds
+		glog.Infof("Rebuilding composite index over %v", preds)
+
+		// The nodes are found by the values of the first predicate, in whose index the tokens
+		// are stored.
+		pk := x.ParsedKey{Attr: preds[0]}
+		builder := rebuilder{attr: preds[0], prefix: pk.DataPrefix(), startTs: rb.StartTs,
+			index: fmt.Sprintf("composite(%s)", strings.Join(preds, ", "))}
+		builder.fn = func(uid uint64, pl *List, txn *Txn) error {
+			val, err := pl.Value(txn.StartTs)
+			switch {
+			case err == ErrNoValue:
+				return nil
+			case err != nil:
+				return err
+			}
+			edge := &pb.DirectedEdge{Attr: preds[0], Entity: uid}
+			for {
+				err := txn.addCompositeIndexMutations(ctx, [][]string{preds}, edge, val,
+					pb.DirectedEdge_SET)
+				switch err {
+				case ErrRetry:
+					time.Sleep(10 * time.Millisecond)
+				default:
+					return err
+				}
+			}
+		}
+		if err := builder.Run(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // needsListTypeRebuild returns true if the schema changed from a scalar to a
 // list. It returns true if the index can be left as is.
 func (rb *IndexRebuild) needsListTypeRebuild() (bool, error) {
@@ -1179,7 +1488,8 @@ func rebuildListType(ctx context.Context, rb *IndexRebuild) error {
 	}
 
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		index: "list"}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		var mpost *pb.Posting
 		err := pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
@@ -1240,6 +1550,13 @@ func DeletePredicate(ctx context.Context, attr string) error {
 	if err := pstore.DropPrefix(prefix); err != nil {
 		return err
 	}
+	// The tokens of the composite indexes that include the predicate are made of its values.
+	for _, preds := range schema.State().CompositeIndexes(attr) {
+		if err := deleteCompositeIndex(preds); err != nil {
+			return err
+		}
+	}
+	Oracle().Reset()
 
 	return schema.State().Delete(attr)
 }
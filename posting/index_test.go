@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -309,6 +310,32 @@ func TestRebuildTokIndex(t *testing.T) {
 	require.EqualValues(t, 91, uids2[0])
 }
 
+func TestIndexingProgress(t *testing.T) {
+	addEdgeToValue(t, "progress", 93, "Rick", uint64(1), uint64(2))
+	addEdgeToValue(t, "progress", 94, "Carl", uint64(3), uint64(4))
+
+	pk := x.ParsedKey{Attr: "progress"}
+	builder := rebuilder{attr: "progress", prefix: pk.DataPrefix(), startTs: 5, index: "exact"}
+	var mu sync.Mutex
+	var seen []*pb.HealthInfo_IndexingProgress
+	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = IndexingProgress()
+		return nil
+	}
+	require.NoError(t, builder.Run(context.Background()))
+
+	require.Len(t, seen, 1)
+	require.Equal(t, "progress", seen[0].Predicate)
+	require.Equal(t, "exact", seen[0].Index)
+	require.Equal(t, phaseReading, seen[0].Phase)
+	require.EqualValues(t, 5, seen[0].StartTs)
+	require.NotZero(t, seen[0].KeysRead)
+	// The build isn't reported once it's done.
+	require.Empty(t, IndexingProgress())
+}
+
 func TestRebuildTokIndexWithDeletion(t *testing.T) {
 	addEdgeToValue(t, "name2", 91, "Michonne", uint64(1), uint64(2))
 	addEdgeToValue(t, "name2", 92, "David", uint64(3), uint64(4))
@@ -513,6 +540,34 @@ func TestNeedsReverseEdgesRebuild(t *testing.T) {
 	require.Equal(t, indexOp(indexDelete), rb.needsReverseEdgesRebuild())
 }
 
+func TestNeedsCompositeIndexRebuild(t *testing.T) {
+	rb := IndexRebuild{}
+	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING}
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING}
+	rb.OldIndexes = [][]string{{"tenant", "status"}}
+	rb.CurrentIndexes = [][]string{{"tenant", "status"}, {"tenant", "priority"}}
+	info := rb.needsCompositeIndexRebuild()
+	require.Empty(t, info.toDelete)
+	require.Equal(t, [][]string{{"tenant", "priority"}}, info.toRebuild)
+
+	rb.OldIndexes, rb.CurrentIndexes = rb.CurrentIndexes, rb.OldIndexes
+	info = rb.needsCompositeIndexRebuild()
+	require.Equal(t, [][]string{{"tenant", "priority"}}, info.toDelete)
+	require.Empty(t, info.toRebuild)
+
+	rb.OldIndexes = [][]string{{"tenant", "status"}}
+	rb.CurrentIndexes = [][]string{{"tenant", "status"}}
+	info = rb.needsCompositeIndexRebuild()
+	require.Empty(t, info.toDelete)
+	require.Empty(t, info.toRebuild)
+
+	// The tokens are made of the values, so they're all rebuilt when the value type changes.
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_INT}
+	info = rb.needsCompositeIndexRebuild()
+	require.Equal(t, [][]string{{"tenant", "status"}}, info.toDelete)
+	require.Equal(t, [][]string{{"tenant", "status"}}, info.toRebuild)
+}
+
 func TestNeedsListTypeRebuild(t *testing.T) {
 	rb := IndexRebuild{}
 	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_UID, List: false}
@@ -466,6 +466,12 @@ func GetConflictKey(pk x.ParsedKey, key []byte, t *pb.DirectedEdge) uint64 {
 		// that two users don't set the same email id.
 		conflictKey = getKey(key, 0)
 
+	case pk.IsIndex() && schema.State().IsUnique(t.Attr):
+		// Same as with the upsert directive, the index keys of a value are conflict keys
+		// irrespective of the uid, so that two transactions giving the same value to different
+		// nodes don't both commit.
+		conflictKey = getKey(key, 0)
+
 	case pk.IsData() && schema.State().IsList(t.Attr):
 		// Data keys, irrespective of whether they are UID or values, should be judged based on
 		// whether they are lists or not. For UID, t.ValueId = UID. For value, t.ValueId =
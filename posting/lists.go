@@ -285,6 +285,36 @@ func (lc *LocalCache) GetFromDelta(key []byte) (*List, error) {
 	return lc.getInternal(key, false)
 }
 
+// GetFromDisk reads the list from disk and applies the deltas of the transaction, without
+// caching it. Unlike Get, it doesn't return a cached list that was created by GetFromDelta,
+// which lacks the postings stored on disk.
+func (lc *LocalCache) GetFromDisk(key []byte) (*List, error) {
+	pl, err := getNew(key, pstore, lc.startTs)
+	if err != nil {
+		return nil, err
+	}
+	skey := string(key)
+	var delta []byte
+	if cached := lc.getNoStore(skey); cached != nil {
+		cached.RLock()
+		if mpl, ok := cached.mutationMap[lc.startTs]; ok {
+			delta, err = mpl.Marshal()
+		}
+		cached.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		lc.RLock()
+		delta = lc.deltas[skey]
+		lc.RUnlock()
+	}
+	if len(delta) > 0 {
+		pl.setMutation(lc.startTs, delta)
+	}
+	return pl, nil
+}
+
 // UpdateDeltasAndDiscardLists updates the delta cache before removing the stored posting lists.
 func (lc *LocalCache) UpdateDeltasAndDiscardLists() {
 	lc.Lock()
@@ -306,6 +336,21 @@ func (lc *LocalCache) UpdateDeltasAndDiscardLists() {
 	lc.plists = make(map[string]*List)
 }
 
+// predicates returns the predicates changed by the transaction of the cache.
+func (lc *LocalCache) predicates() []string {
+	lc.RLock()
+	defer lc.RUnlock()
+	var preds []string
+	for key := range lc.deltas {
+		pk, err := x.Parse([]byte(key))
+		if err != nil || len(pk.Attr) == 0 {
+			continue
+		}
+		preds = append(preds, pk.Attr)
+	}
+	return x.Unique(preds)
+}
+
 func (lc *LocalCache) fillPreds(ctx *api.TxnContext, gid uint32) {
 	lc.RLock()
 	defer lc.RUnlock()
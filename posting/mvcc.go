@@ -398,7 +398,9 @@ func getNew(key []byte, pstore *badger.DB, readTs uint64) (*List, error) {
 	cachedVal, ok := lCache.Get(key)
 	if ok {
 		l, ok := cachedVal.(*List)
-		if ok && l != nil {
+		// The immutable layer of a cached list can't serve reads before its minTs, which happen
+		// for point-in-time queries. Those are read from disk instead.
+		if ok && l != nil && readTs >= l.minTs {
 			// No need to clone the immutable layer or the key since mutations will not modify it.
 			lCopy := &List{
 				minTs: l.minTs,
@@ -434,6 +436,10 @@ func getNew(key []byte, pstore *badger.DB, readTs uint64) (*List, error) {
 	if err != nil {
 		return l, err
 	}
-	lCache.Set(key, l, 0)
+	// A list read in the past misses the commits done since then. Don't let it be served to
+	// the reads at newer timestamps.
+	if readTs >= o.MaxAssigned() {
+		lCache.Set(key, l, 0)
+	}
 	return l, nil
 }
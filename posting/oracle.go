@@ -82,6 +82,12 @@ func (txn *Txn) GetFromDelta(key []byte) (*List, error) {
 	return txn.cache.GetFromDelta(key)
 }
 
+// GetFromDisk retrieves the posting list for the given key from Badger, with the mutations of
+// the transaction applied, even when the cached list was only built from its deltas.
+func (txn *Txn) GetFromDisk(key []byte) (*List, error) {
+	return txn.cache.GetFromDisk(key)
+}
+
 // Update calls UpdateDeltasAndDiscardLists on the local cache.
 func (txn *Txn) Update() {
 	txn.cache.UpdateDeltasAndDiscardLists()
@@ -108,11 +114,19 @@ type oracle struct {
 	// Used for waiting logic for transactions with startTs > maxpending so that we don't read an
 	// uncommitted transaction.
 	waiters map[uint64][]chan struct{}
+
+	// commits maps the predicates changed in the group of this Alpha to the commit ts of the
+	// last transaction that changed them, and resets counts the drops and schema updates, which
+	// change predicates without a commit. Together, they tell if the result of a query read at
+	// some ts still holds at another one.
+	commits map[string]uint64
+	resets  uint64
 }
 
 func (o *oracle) init() {
 	o.waiters = make(map[uint64][]chan struct{})
 	o.pendingTxns = make(map[uint64]*Txn)
+	o.commits = make(map[string]uint64)
 }
 
 func (o *oracle) RegisterStartTs(ts uint64) *Txn {
@@ -220,6 +234,13 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 	o.Lock()
 	defer o.Unlock()
 	for _, txn := range delta.Txns {
+		if pending, ok := o.pendingTxns[txn.StartTs]; ok && txn.CommitTs > 0 {
+			for _, pred := range pending.cache.predicates() {
+				if txn.CommitTs > o.commits[pred] {
+					o.commits[pred] = txn.CommitTs
+				}
+			}
+		}
 		delete(o.pendingTxns, txn.StartTs)
 	}
 	curMax := o.MaxAssigned()
@@ -242,6 +263,37 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 		x.MaxAssignedTs.M(int64(delta.MaxAssigned))) // Can't access o.MaxAssigned without atomics.
 }
 
+// LastCommitTs returns the commit ts of the last transaction that changed any of preds, among
+// the ones committed in the group of this Alpha. It returns zero if none of them was changed.
+func (o *oracle) LastCommitTs(preds []string) uint64 {
+	o.RLock()
+	defer o.RUnlock()
+	var last uint64
+	for _, pred := range preds {
+		if ts := o.commits[pred]; ts > last {
+			last = ts
+		}
+	}
+	return last
+}
+
+// Resets returns the number of times predicates were changed without a commit, e.g. by a drop,
+// a schema update, a snapshot from the leader, a predicate move or a restore.
+func (o *oracle) Resets() uint64 {
+	o.RLock()
+	defer o.RUnlock()
+	return o.resets
+}
+
+// Reset records that predicates were changed without a commit, e.g. by a drop, a schema update,
+// a snapshot from the leader, a predicate move or a restore. Results read before it can't be
+// trusted to hold after it.
+func (o *oracle) Reset() {
+	o.Lock()
+	defer o.Unlock()
+	o.resets++
+}
+
 func (o *oracle) ResetTxns() {
 	o.Lock()
 	defer o.Unlock()
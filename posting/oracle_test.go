@@ -0,0 +1,54 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOracleLastCommitTs(t *testing.T) {
+	orc := new(oracle)
+	orc.init()
+	txn := orc.RegisterStartTs(1)
+	txn.cache.deltas[string(x.DataKey("name", 1))] = nil
+	txn.cache.deltas[string(x.IndexKey("name", "alice"))] = nil
+	txn = orc.RegisterStartTs(2)
+	txn.cache.deltas[string(x.ReverseKey("friend", 1))] = nil
+	txn = orc.RegisterStartTs(3)
+	txn.cache.deltas[string(x.DataKey("age", 1))] = nil
+
+	orc.ProcessDelta(&pb.OracleDelta{
+		Txns: []*pb.TxnStatus{
+			{StartTs: 1, CommitTs: 4},
+			{StartTs: 2, CommitTs: 5},
+			{StartTs: 3},
+		},
+		MaxAssigned: 5,
+	})
+	require.Equal(t, uint64(4), orc.LastCommitTs([]string{"name"}))
+	require.Equal(t, uint64(5), orc.LastCommitTs([]string{"name", "friend"}))
+	// Aborted transactions don't change predicates.
+	require.Equal(t, uint64(0), orc.LastCommitTs([]string{"age"}))
+
+	require.Equal(t, uint64(0), orc.Resets())
+	orc.Reset()
+	require.Equal(t, uint64(1), orc.Resets())
+}
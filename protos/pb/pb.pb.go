@@ -133,6 +133,7 @@ const (
 	Posting_PASSWORD Posting_ValType = 8
 	Posting_STRING   Posting_ValType = 9
 	Posting_OBJECT   Posting_ValType = 10
+	Posting_VFLOAT   Posting_ValType = 11
 )
 
 var Posting_ValType_name = map[int32]string{
@@ -147,6 +148,7 @@ var Posting_ValType_name = map[int32]string{
 	8:  "PASSWORD",
 	9:  "STRING",
 	10: "OBJECT",
+	11: "VFLOAT",
 }
 
 var Posting_ValType_value = map[string]int32{
@@ -161,6 +163,7 @@ var Posting_ValType_value = map[string]int32{
 	"PASSWORD": 8,
 	"STRING":   9,
 	"OBJECT":   10,
+	"VFLOAT":   11,
 }
 
 func (x Posting_ValType) String() string {
@@ -840,6 +843,8 @@ type SortMessage struct {
 	UidMatrix            []*List  `protobuf:"bytes,2,rep,name=uid_matrix,json=uidMatrix,proto3" json:"uid_matrix,omitempty"`
 	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	After                string   `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	Stable               bool     `protobuf:"varint,6,opt,name=stable,proto3" json:"stable,omitempty"`
 	ReadTs               uint64   `protobuf:"varint,13,opt,name=read_ts,json=readTs,proto3" json:"read_ts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -907,6 +912,20 @@ func (m *SortMessage) GetOffset() int32 {
 	return 0
 }
 
+func (m *SortMessage) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func (m *SortMessage) GetStable() bool {
+	if m != nil {
+		return m.Stable
+	}
+	return false
+}
+
 func (m *SortMessage) GetReadTs() uint64 {
 	if m != nil {
 		return m.ReadTs
@@ -1291,6 +1310,7 @@ type ZeroProposal struct {
 	Key                  string            `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
 	Cid                  string            `protobuf:"bytes,9,opt,name=cid,proto3" json:"cid,omitempty"`
 	License              *License          `protobuf:"bytes,10,opt,name=license,proto3" json:"license,omitempty"`
+	History              *HistoryUpdate    `protobuf:"bytes,11,opt,name=history,proto3" json:"history,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1399,22 +1419,34 @@ func (m *ZeroProposal) GetLicense() *License {
 	return nil
 }
 
+func (m *ZeroProposal) GetHistory() *HistoryUpdate {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 // MembershipState is used to pack together the current membership state of all the nodes
 // in the caller server; and the membership updates recorded by the callee server since
 // the provided lastUpdate.
 type MembershipState struct {
-	Counter              uint64             `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
-	Groups               map[uint32]*Group  `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Zeros                map[uint64]*Member `protobuf:"bytes,3,rep,name=zeros,proto3" json:"zeros,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MaxLeaseId           uint64             `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
-	MaxTxnTs             uint64             `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
-	MaxRaftId            uint64             `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
-	Removed              []*Member          `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"`
-	Cid                  string             `protobuf:"bytes,8,opt,name=cid,proto3" json:"cid,omitempty"`
-	License              *License           `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Counter    uint64             `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
+	Groups     map[uint32]*Group  `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Zeros      map[uint64]*Member `protobuf:"bytes,3,rep,name=zeros,proto3" json:"zeros,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxLeaseId uint64             `protobuf:"varint,4,opt,name=maxLeaseId,proto3" json:"maxLeaseId,omitempty"`
+	MaxTxnTs   uint64             `protobuf:"varint,5,opt,name=maxTxnTs,proto3" json:"maxTxnTs,omitempty"`
+	MaxRaftId  uint64             `protobuf:"varint,6,opt,name=maxRaftId,proto3" json:"maxRaftId,omitempty"`
+	Removed    []*Member          `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"`
+	Cid        string             `protobuf:"bytes,8,opt,name=cid,proto3" json:"cid,omitempty"`
+	License    *License           `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
+	// The samples over the history retention window, oldest first. Only Zero needs them, they
+	// aren't sent to the alphas.
+	TsSamples []*TsSample `protobuf:"bytes,10,rep,name=ts_samples,json=tsSamples,proto3" json:"ts_samples,omitempty"`
+	// The ts at the start of the history retention window, 0 if history retention is disabled.
+	HistoryTs            uint64   `protobuf:"varint,11,opt,name=history_ts,json=historyTs,proto3" json:"history_ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MembershipState) Reset()         { *m = MembershipState{} }
@@ -1513,6 +1545,20 @@ func (m *MembershipState) GetLicense() *License {
 	return nil
 }
 
+func (m *MembershipState) GetTsSamples() []*TsSample {
+	if m != nil {
+		return m.TsSamples
+	}
+	return nil
+}
+
+func (m *MembershipState) GetHistoryTs() uint64 {
+	if m != nil {
+		return m.HistoryTs
+	}
+	return 0
+}
+
 type ConnectionState struct {
 	Member               *Member          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
 	State                *MembershipState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
@@ -1577,19 +1623,20 @@ func (m *ConnectionState) GetMaxPending() uint64 {
 }
 
 type HealthInfo struct {
-	Instance             string   `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Group                string   `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
-	Version              string   `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
-	Uptime               int64    `protobuf:"varint,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	LastEcho             int64    `protobuf:"varint,7,opt,name=lastEcho,proto3" json:"lastEcho,omitempty"`
-	Ongoing              []string `protobuf:"bytes,8,rep,name=ongoing,proto3" json:"ongoing,omitempty"`
-	Indexing             []string `protobuf:"bytes,9,rep,name=indexing,proto3" json:"indexing,omitempty"`
-	EeFeatures           []string `protobuf:"bytes,10,rep,name=ee_features,json=eeFeatures,proto3" json:"ee_features,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Instance             string                         `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	Address              string                         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Status               string                         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Group                string                         `protobuf:"bytes,4,opt,name=group,proto3" json:"group,omitempty"`
+	Version              string                         `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Uptime               int64                          `protobuf:"varint,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	LastEcho             int64                          `protobuf:"varint,7,opt,name=lastEcho,proto3" json:"lastEcho,omitempty"`
+	Ongoing              []string                       `protobuf:"bytes,8,rep,name=ongoing,proto3" json:"ongoing,omitempty"`
+	Indexing             []string                       `protobuf:"bytes,9,rep,name=indexing,proto3" json:"indexing,omitempty"`
+	EeFeatures           []string                       `protobuf:"bytes,10,rep,name=ee_features,json=eeFeatures,proto3" json:"ee_features,omitempty"`
+	IndexingProgress     []*HealthInfo_IndexingProgress `protobuf:"bytes,11,rep,name=indexing_progress,json=indexingProgress,proto3" json:"indexing_progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *HealthInfo) Reset()         { *m = HealthInfo{} }
@@ -1695,6 +1742,112 @@ func (m *HealthInfo) GetEeFeatures() []string {
 	return nil
 }
 
+func (m *HealthInfo) GetIndexingProgress() []*HealthInfo_IndexingProgress {
+	if m != nil {
+		return m.IndexingProgress
+	}
+	return nil
+}
+
+// IndexingProgress is the progress of an index build of a predicate.
+type HealthInfo_IndexingProgress struct {
+	Predicate string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	// The index being built, like the tokenizers, count, reverse or a composite index.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	Phase string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	// The snapshot ts of the data the index is built from.
+	StartTs     uint64 `protobuf:"varint,4,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	KeysRead    uint64 `protobuf:"varint,5,opt,name=keys_read,json=keysRead,proto3" json:"keys_read,omitempty"`
+	KeysWritten uint64 `protobuf:"varint,6,opt,name=keys_written,json=keysWritten,proto3" json:"keys_written,omitempty"`
+	// Unix time at which the build started.
+	Since                int64    `protobuf:"varint,7,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthInfo_IndexingProgress) Reset()         { *m = HealthInfo_IndexingProgress{} }
+func (m *HealthInfo_IndexingProgress) String() string { return proto.CompactTextString(m) }
+func (*HealthInfo_IndexingProgress) ProtoMessage()    {}
+func (*HealthInfo_IndexingProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{17, 0}
+}
+func (m *HealthInfo_IndexingProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthInfo_IndexingProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthInfo_IndexingProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HealthInfo_IndexingProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthInfo_IndexingProgress.Merge(m, src)
+}
+func (m *HealthInfo_IndexingProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthInfo_IndexingProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthInfo_IndexingProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthInfo_IndexingProgress proto.InternalMessageInfo
+
+func (m *HealthInfo_IndexingProgress) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
+}
+
+func (m *HealthInfo_IndexingProgress) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *HealthInfo_IndexingProgress) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *HealthInfo_IndexingProgress) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *HealthInfo_IndexingProgress) GetKeysRead() uint64 {
+	if m != nil {
+		return m.KeysRead
+	}
+	return 0
+}
+
+func (m *HealthInfo_IndexingProgress) GetKeysWritten() uint64 {
+	if m != nil {
+		return m.KeysWritten
+	}
+	return 0
+}
+
+func (m *HealthInfo_IndexingProgress) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
 type Tablet struct {
 	GroupId              uint32   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
 	Predicate            string   `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
@@ -3246,6 +3399,7 @@ type SchemaNode struct {
 	Upsert               bool     `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	Lang                 bool     `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
 	NoConflict           bool     `protobuf:"varint,10,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Unique               bool     `protobuf:"varint,11,opt,name=unique,proto3" json:"unique,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3354,6 +3508,13 @@ func (m *SchemaNode) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaNode) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
 type SchemaResult struct {
 	Schema               []*SchemaNode `protobuf:"bytes,1,rep,name=schema,proto3" json:"schema,omitempty"` // Deprecated: Do not use.
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -3418,6 +3579,7 @@ type SchemaUpdate struct {
 	// custom name. This field stores said name.
 	ObjectTypeName       string   `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
 	NoConflict           bool     `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Unique               bool     `protobuf:"varint,14,opt,name=unique,proto3" json:"unique,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3540,12 +3702,20 @@ func (m *SchemaUpdate) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaUpdate) GetUnique() bool {
+	if m != nil {
+		return m.Unique
+	}
+	return false
+}
+
 type TypeUpdate struct {
-	TypeName             string          `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	Fields               []*SchemaUpdate `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	TypeName             string                       `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Fields               []*SchemaUpdate              `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Indexes              []*TypeUpdate_CompositeIndex `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *TypeUpdate) Reset()         { *m = TypeUpdate{} }
@@ -3595,6 +3765,61 @@ func (m *TypeUpdate) GetFields() []*SchemaUpdate {
 	return nil
 }
 
+func (m *TypeUpdate) GetIndexes() []*TypeUpdate_CompositeIndex {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+// A composite index is an index over the values of several fields of the type.
+type TypeUpdate_CompositeIndex struct {
+	Predicates           []string `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TypeUpdate_CompositeIndex) Reset()         { *m = TypeUpdate_CompositeIndex{} }
+func (m *TypeUpdate_CompositeIndex) String() string { return proto.CompactTextString(m) }
+func (*TypeUpdate_CompositeIndex) ProtoMessage()    {}
+func (*TypeUpdate_CompositeIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{40, 0}
+}
+func (m *TypeUpdate_CompositeIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypeUpdate_CompositeIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypeUpdate_CompositeIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TypeUpdate_CompositeIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypeUpdate_CompositeIndex.Merge(m, src)
+}
+func (m *TypeUpdate_CompositeIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypeUpdate_CompositeIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypeUpdate_CompositeIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypeUpdate_CompositeIndex proto.InternalMessageInfo
+
+func (m *TypeUpdate_CompositeIndex) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
 type MapHeader struct {
 	PartitionKeys        [][]byte `protobuf:"bytes,1,rep,name=partition_keys,json=partitionKeys,proto3" json:"partition_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4898,6 +5123,121 @@ func (m *UpdateGraphQLSchemaResponse) GetUid() uint64 {
 	return 0
 }
 
+// TsSample maps a point in time to the max assigned ts at that time. Zero keeps these samples
+// over the history retention window, to run point-in-time queries given a time.
+type TsSample struct {
+	UnixNano             int64    `protobuf:"varint,1,opt,name=unix_nano,json=unixNano,proto3" json:"unix_nano,omitempty"`
+	Ts                   uint64   `protobuf:"varint,2,opt,name=ts,proto3" json:"ts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TsSample) Reset()         { *m = TsSample{} }
+func (m *TsSample) String() string { return proto.CompactTextString(m) }
+func (*TsSample) ProtoMessage()    {}
+func (*TsSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{61}
+}
+func (m *TsSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TsSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TsSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TsSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TsSample.Merge(m, src)
+}
+func (m *TsSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *TsSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_TsSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TsSample proto.InternalMessageInfo
+
+func (m *TsSample) GetUnixNano() int64 {
+	if m != nil {
+		return m.UnixNano
+	}
+	return 0
+}
+
+func (m *TsSample) GetTs() uint64 {
+	if m != nil {
+		return m.Ts
+	}
+	return 0
+}
+
+// HistoryUpdate records a new ts sample and drops the samples out of the history retention
+// window. An update without a sample disables history retention.
+type HistoryUpdate struct {
+	Sample *TsSample `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
+	// The start of the history retention window, in unix time in nanoseconds.
+	Since                int64    `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryUpdate) Reset()         { *m = HistoryUpdate{} }
+func (m *HistoryUpdate) String() string { return proto.CompactTextString(m) }
+func (*HistoryUpdate) ProtoMessage()    {}
+func (*HistoryUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{62}
+}
+func (m *HistoryUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryUpdate.Merge(m, src)
+}
+func (m *HistoryUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryUpdate proto.InternalMessageInfo
+
+func (m *HistoryUpdate) GetSample() *TsSample {
+	if m != nil {
+		return m.Sample
+	}
+	return nil
+}
+
+func (m *HistoryUpdate) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("pb.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
 	proto.RegisterEnum("pb.Mutations_DropOp", Mutations_DropOp_name, Mutations_DropOp_value)
@@ -4929,6 +5269,7 @@ func init() {
 	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
 	proto.RegisterType((*ConnectionState)(nil), "pb.ConnectionState")
 	proto.RegisterType((*HealthInfo)(nil), "pb.HealthInfo")
+	proto.RegisterType((*HealthInfo_IndexingProgress)(nil), "pb.HealthInfo.IndexingProgress")
 	proto.RegisterType((*Tablet)(nil), "pb.Tablet")
 	proto.RegisterType((*DirectedEdge)(nil), "pb.DirectedEdge")
 	proto.RegisterType((*Mutations)(nil), "pb.Mutations")
@@ -4953,6 +5294,7 @@ func init() {
 	proto.RegisterType((*SchemaResult)(nil), "pb.SchemaResult")
 	proto.RegisterType((*SchemaUpdate)(nil), "pb.SchemaUpdate")
 	proto.RegisterType((*TypeUpdate)(nil), "pb.TypeUpdate")
+	proto.RegisterType((*TypeUpdate_CompositeIndex)(nil), "pb.TypeUpdate.CompositeIndex")
 	proto.RegisterType((*MapHeader)(nil), "pb.MapHeader")
 	proto.RegisterType((*MovePredicatePayload)(nil), "pb.MovePredicatePayload")
 	proto.RegisterType((*TxnStatus)(nil), "pb.TxnStatus")
@@ -4974,304 +5316,322 @@ func init() {
 	proto.RegisterType((*BackupPostingList)(nil), "pb.BackupPostingList")
 	proto.RegisterType((*UpdateGraphQLSchemaRequest)(nil), "pb.UpdateGraphQLSchemaRequest")
 	proto.RegisterType((*UpdateGraphQLSchemaResponse)(nil), "pb.UpdateGraphQLSchemaResponse")
+	proto.RegisterType((*TsSample)(nil), "pb.TsSample")
+	proto.RegisterType((*HistoryUpdate)(nil), "pb.HistoryUpdate")
 }
 
 func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
 
 var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 4662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x6f, 0x1c, 0x57,
-	0x72, 0x9a, 0xef, 0xe9, 0x9a, 0x19, 0x6a, 0xf4, 0xa4, 0x95, 0x67, 0x47, 0xb6, 0x48, 0xb7, 0xad,
-	0x35, 0x6d, 0x59, 0x94, 0x4c, 0x6f, 0x90, 0xb5, 0x17, 0x01, 0x42, 0x8a, 0x43, 0x99, 0x16, 0x45,
-	0xd2, 0x3d, 0x43, 0x79, 0xd7, 0x87, 0x0c, 0x9a, 0xdd, 0x8f, 0x64, 0x2f, 0x7b, 0xba, 0x7b, 0xbb,
-	0x7b, 0xb8, 0x43, 0x9f, 0x12, 0x04, 0xc9, 0x29, 0x39, 0x05, 0x41, 0xf6, 0x94, 0xe4, 0x1f, 0x04,
-	0xc9, 0x29, 0xc8, 0x39, 0x08, 0x82, 0x1c, 0x82, 0xfc, 0x02, 0x25, 0x70, 0x72, 0x12, 0x90, 0x43,
-	0x10, 0x20, 0xc7, 0x20, 0xa8, 0xaa, 0xd7, 0x5f, 0xc3, 0xa1, 0x64, 0x2f, 0xb0, 0x87, 0x9c, 0xfa,
-	0x55, 0xd5, 0xfb, 0xac, 0x57, 0xdf, 0xaf, 0xa1, 0x19, 0x1c, 0xad, 0x05, 0xa1, 0x1f, 0xfb, 0xa2,
-	0x1c, 0x1c, 0xf5, 0x35, 0x33, 0x70, 0x18, 0xec, 0x7f, 0x70, 0xe2, 0xc4, 0xa7, 0xd3, 0xa3, 0x35,
-	0xcb, 0x9f, 0x3c, 0xb4, 0x4f, 0x42, 0x33, 0x38, 0x7d, 0xe0, 0xf8, 0x0f, 0x8f, 0x4c, 0xfb, 0x44,
-	0x86, 0x0f, 0xcf, 0xd7, 0x1f, 0x06, 0x47, 0x0f, 0x93, 0xa1, 0xfd, 0x07, 0xb9, 0xbe, 0x27, 0xfe,
-	0x89, 0xff, 0x90, 0xd0, 0x47, 0xd3, 0x63, 0x82, 0x08, 0xa0, 0x16, 0x77, 0xd7, 0xfb, 0x50, 0xdd,
-	0x75, 0xa2, 0x58, 0x08, 0xa8, 0x4e, 0x1d, 0x3b, 0xea, 0x95, 0x56, 0x2a, 0xab, 0x75, 0x83, 0xda,
-	0xfa, 0x33, 0xd0, 0x46, 0x66, 0x74, 0xf6, 0xdc, 0x74, 0xa7, 0x52, 0x74, 0xa1, 0x72, 0x6e, 0xba,
-	0xbd, 0xd2, 0x4a, 0x69, 0xb5, 0x6d, 0x60, 0x53, 0xac, 0x41, 0xf3, 0xdc, 0x74, 0xc7, 0xf1, 0x45,
-	0x20, 0x7b, 0xe5, 0x95, 0xd2, 0xea, 0xd2, 0xfa, 0xcd, 0xb5, 0xe0, 0x68, 0xed, 0xc0, 0x8f, 0x62,
-	0xc7, 0x3b, 0x59, 0x7b, 0x6e, 0xba, 0xa3, 0x8b, 0x40, 0x1a, 0x8d, 0x73, 0x6e, 0xe8, 0xfb, 0xd0,
-	0x1a, 0x86, 0xd6, 0xf6, 0xd4, 0xb3, 0x62, 0xc7, 0xf7, 0x70, 0x45, 0xcf, 0x9c, 0x48, 0x9a, 0x51,
-	0x33, 0xa8, 0x8d, 0x38, 0x33, 0x3c, 0x89, 0x7a, 0x95, 0x95, 0x0a, 0xe2, 0xb0, 0x2d, 0x7a, 0xd0,
-	0x70, 0xa2, 0xc7, 0xfe, 0xd4, 0x8b, 0x7b, 0xd5, 0x95, 0xd2, 0x6a, 0xd3, 0x48, 0x40, 0xfd, 0x2f,
-	0x2a, 0x50, 0xfb, 0x62, 0x2a, 0xc3, 0x0b, 0x1a, 0x17, 0xc7, 0x61, 0x32, 0x17, 0xb6, 0xc5, 0x2d,
-	0xa8, 0xb9, 0xa6, 0x77, 0x12, 0xf5, 0xca, 0x34, 0x19, 0x03, 0xe2, 0x0e, 0x68, 0xe6, 0x71, 0x2c,
-	0xc3, 0xf1, 0xd4, 0xb1, 0x7b, 0x95, 0x95, 0xd2, 0x6a, 0xdd, 0x68, 0x12, 0xe2, 0xd0, 0xb1, 0xc5,
-	0xf7, 0xa1, 0x69, 0xfb, 0x63, 0x2b, 0xbf, 0x96, 0xed, 0xd3, 0x5a, 0xe2, 0x1d, 0x68, 0x4e, 0x1d,
-	0x7b, 0xec, 0x3a, 0x51, 0xdc, 0xab, 0xad, 0x94, 0x56, 0x5b, 0xeb, 0x4d, 0x3c, 0x2c, 0xf2, 0xce,
-	0x68, 0x4c, 0x1d, 0x9b, 0x98, 0xf8, 0x01, 0x34, 0xa3, 0xd0, 0x1a, 0x1f, 0x4f, 0x3d, 0xab, 0x57,
-	0xa7, 0x4e, 0xd7, 0xb1, 0x53, 0xee, 0xd4, 0x46, 0x23, 0x62, 0x00, 0x8f, 0x15, 0xca, 0x73, 0x19,
-	0x46, 0xb2, 0xd7, 0xe0, 0xa5, 0x14, 0x28, 0x1e, 0x41, 0xeb, 0xd8, 0xb4, 0x64, 0x3c, 0x0e, 0xcc,
-	0xd0, 0x9c, 0xf4, 0x9a, 0xd9, 0x44, 0xdb, 0x88, 0x3e, 0x40, 0x6c, 0x64, 0xc0, 0x71, 0x0a, 0x88,
-	0x8f, 0xa1, 0x43, 0x50, 0x34, 0x3e, 0x76, 0xdc, 0x58, 0x86, 0x3d, 0x8d, 0xc6, 0x2c, 0xd1, 0x18,
-	0xc2, 0x8c, 0x42, 0x29, 0x8d, 0x36, 0x77, 0x62, 0x8c, 0x78, 0x0b, 0x40, 0xce, 0x02, 0xd3, 0xb3,
-	0xc7, 0xa6, 0xeb, 0xf6, 0x80, 0xf6, 0xa0, 0x31, 0x66, 0xc3, 0x75, 0xc5, 0x1b, 0xb8, 0x3f, 0xd3,
-	0x1e, 0xc7, 0x51, 0xaf, 0xb3, 0x52, 0x5a, 0xad, 0x1a, 0x75, 0x04, 0x47, 0x11, 0xf2, 0xd5, 0x32,
-	0xad, 0x53, 0xd9, 0x5b, 0x5a, 0x29, 0xad, 0xd6, 0x0c, 0x06, 0x10, 0x7b, 0xec, 0x84, 0x51, 0xdc,
-	0xbb, 0xce, 0x58, 0x02, 0xf4, 0x75, 0xd0, 0x48, 0x7a, 0x88, 0x3b, 0xf7, 0xa0, 0x7e, 0x8e, 0x00,
-	0x0b, 0x59, 0x6b, 0xbd, 0x83, 0xdb, 0x4b, 0x05, 0xcc, 0x50, 0x44, 0xfd, 0x2e, 0x34, 0x77, 0x4d,
-	0xef, 0x24, 0x91, 0x4a, 0xbc, 0x36, 0x1a, 0xa0, 0x19, 0xd4, 0xd6, 0x7f, 0x59, 0x86, 0xba, 0x21,
-	0xa3, 0xa9, 0x1b, 0x8b, 0xf7, 0x00, 0xf0, 0x52, 0x26, 0x66, 0x1c, 0x3a, 0x33, 0x35, 0x6b, 0x76,
-	0x2d, 0xda, 0xd4, 0xb1, 0x9f, 0x11, 0x49, 0x3c, 0x82, 0x36, 0xcd, 0x9e, 0x74, 0x2d, 0x67, 0x1b,
-	0x48, 0xf7, 0x67, 0xb4, 0xa8, 0x8b, 0x1a, 0x71, 0x1b, 0xea, 0x24, 0x07, 0x2c, 0x8b, 0x1d, 0x43,
-	0x41, 0xe2, 0x1e, 0x2c, 0x39, 0x5e, 0x8c, 0xf7, 0x64, 0xc5, 0x63, 0x5b, 0x46, 0x89, 0xa0, 0x74,
-	0x52, 0xec, 0x96, 0x8c, 0x62, 0xf1, 0x11, 0x30, 0xb3, 0x93, 0x05, 0x6b, 0xb4, 0xe0, 0x52, 0x7a,
-	0x89, 0x11, 0xaf, 0x48, 0x7d, 0xd4, 0x8a, 0x0f, 0xa0, 0x85, 0xe7, 0x4b, 0x46, 0xd4, 0x69, 0x44,
-	0x9b, 0x4e, 0xa3, 0xd8, 0x61, 0x00, 0x76, 0x50, 0xdd, 0x91, 0x35, 0x28, 0x8c, 0x2c, 0x3c, 0xd4,
-	0xd6, 0x07, 0x50, 0xdb, 0x0f, 0x6d, 0x19, 0x2e, 0xd4, 0x07, 0x01, 0x55, 0x5b, 0x46, 0x16, 0xa9,
-	0x6a, 0xd3, 0xa0, 0x76, 0xa6, 0x23, 0x95, 0x9c, 0x8e, 0xe8, 0x7f, 0x5e, 0x82, 0xd6, 0xd0, 0x0f,
-	0xe3, 0x67, 0x32, 0x8a, 0xcc, 0x13, 0x29, 0x96, 0xa1, 0xe6, 0xe3, 0xb4, 0x8a, 0xc3, 0x1a, 0xee,
-	0x89, 0xd6, 0x31, 0x18, 0x3f, 0x77, 0x0f, 0xe5, 0xab, 0xef, 0x01, 0x65, 0x87, 0xb4, 0xab, 0xa2,
-	0x64, 0x87, 0x74, 0xeb, 0x36, 0xd4, 0xfd, 0xe3, 0xe3, 0x48, 0x32, 0x2f, 0x6b, 0x86, 0x82, 0xae,
-	0x14, 0x41, 0xfd, 0x37, 0x00, 0x70, 0x7f, 0xdf, 0x51, 0x0a, 0xf4, 0x53, 0x68, 0x19, 0xe6, 0x71,
-	0xfc, 0xd8, 0xf7, 0x62, 0x39, 0x8b, 0xc5, 0x12, 0x94, 0x1d, 0x9b, 0x58, 0x54, 0x37, 0xca, 0x8e,
-	0x8d, 0x9b, 0x3b, 0x09, 0xfd, 0x69, 0x40, 0x1c, 0xea, 0x18, 0x0c, 0x10, 0x2b, 0x6d, 0x3b, 0xa4,
-	0x1d, 0x23, 0x2b, 0x6d, 0x3b, 0x14, 0xcb, 0xd0, 0x8a, 0x3c, 0x33, 0x88, 0x4e, 0xfd, 0x18, 0x37,
-	0x57, 0xa5, 0xcd, 0x41, 0x82, 0x1a, 0x45, 0xfa, 0x7f, 0x96, 0xa1, 0xfe, 0x4c, 0x4e, 0x8e, 0x64,
-	0x78, 0x69, 0x95, 0x47, 0xd0, 0xa4, 0x89, 0xc7, 0x8e, 0xcd, 0x0b, 0x6d, 0x7e, 0xef, 0xe5, 0x8b,
-	0xe5, 0x1b, 0x84, 0xdb, 0xb1, 0x3f, 0xf4, 0x27, 0x4e, 0x2c, 0x27, 0x41, 0x7c, 0x61, 0x34, 0x14,
-	0x6a, 0xe1, 0x0e, 0x6e, 0x43, 0xdd, 0x95, 0x26, 0xde, 0x09, 0x8b, 0x9f, 0x82, 0xc4, 0x03, 0x68,
-	0x98, 0x93, 0xb1, 0x2d, 0x4d, 0x9b, 0xac, 0x54, 0x73, 0xf3, 0xd6, 0xcb, 0x17, 0xcb, 0x5d, 0x73,
-	0xb2, 0x25, 0xcd, 0xfc, 0xdc, 0x75, 0xc6, 0x88, 0x4f, 0x50, 0xe6, 0xa2, 0x78, 0x3c, 0x0d, 0x6c,
-	0x33, 0x96, 0x64, 0xb3, 0xaa, 0x9b, 0xbd, 0x97, 0x2f, 0x96, 0x6f, 0x21, 0xfa, 0x90, 0xb0, 0xb9,
-	0x61, 0x90, 0x61, 0xc5, 0x0e, 0xdc, 0xb0, 0xdc, 0x69, 0x84, 0xa6, 0xd4, 0xf1, 0x8e, 0xfd, 0xb1,
-	0xef, 0xb9, 0x17, 0x74, 0x4d, 0xcd, 0xcd, 0xb7, 0x5e, 0xbe, 0x58, 0xfe, 0xbe, 0x22, 0xee, 0x78,
-	0xc7, 0xfe, 0xbe, 0xe7, 0x5e, 0xe4, 0x66, 0xb9, 0x3e, 0x47, 0x12, 0xbf, 0x0d, 0x4b, 0xc7, 0x7e,
-	0x68, 0xc9, 0x71, 0xca, 0x98, 0x25, 0x9a, 0xa7, 0xff, 0xf2, 0xc5, 0xf2, 0x6d, 0xa2, 0x3c, 0xb9,
-	0xc4, 0x9d, 0x76, 0x1e, 0xaf, 0xff, 0x6d, 0x19, 0x6a, 0xd4, 0x16, 0x8f, 0xa0, 0x31, 0x21, 0xc6,
-	0x27, 0x56, 0xe6, 0x36, 0x4a, 0x02, 0xd1, 0xd6, 0xf8, 0x46, 0xa2, 0x81, 0x17, 0x87, 0x17, 0x46,
-	0xd2, 0x0d, 0x47, 0xc4, 0xe6, 0x91, 0x2b, 0xe3, 0x48, 0x49, 0x6e, 0x6e, 0xc4, 0x88, 0x09, 0x6a,
-	0x84, 0xea, 0x36, 0x7f, 0xfd, 0x95, 0xf9, 0xeb, 0x17, 0x7d, 0x68, 0x5a, 0xa7, 0xd2, 0x3a, 0x8b,
-	0xa6, 0x13, 0x25, 0x1c, 0x29, 0xdc, 0xdf, 0x86, 0x76, 0x7e, 0x1f, 0xe8, 0x57, 0xcf, 0xe4, 0x05,
-	0x09, 0x48, 0xd5, 0xc0, 0xa6, 0x58, 0x81, 0x1a, 0x59, 0x22, 0x12, 0x8f, 0xd6, 0x3a, 0xe0, 0x76,
-	0x78, 0x88, 0xc1, 0x84, 0x4f, 0xcb, 0x3f, 0x2a, 0xe1, 0x3c, 0xf9, 0xdd, 0xe5, 0xe7, 0xd1, 0xae,
-	0x9e, 0x87, 0x87, 0xe4, 0xe6, 0xd1, 0x7d, 0x68, 0xec, 0x3a, 0x96, 0xf4, 0x22, 0xf2, 0xbe, 0xd3,
-	0x48, 0xa6, 0x56, 0x03, 0xdb, 0x78, 0x94, 0x89, 0x39, 0xdb, 0xf3, 0x6d, 0x19, 0xd1, 0x3c, 0x55,
-	0x23, 0x85, 0x91, 0x26, 0x67, 0x81, 0x13, 0x5e, 0x8c, 0x98, 0x09, 0x15, 0x23, 0x85, 0xd1, 0xbd,
-	0x49, 0x0f, 0x17, 0xb3, 0x13, 0x4f, 0xaa, 0x40, 0xfd, 0x2f, 0x2b, 0xd0, 0xfe, 0x4a, 0x86, 0xfe,
-	0x41, 0xe8, 0x07, 0x7e, 0x64, 0xba, 0x62, 0xa3, 0xc8, 0x4e, 0xbe, 0xb6, 0x15, 0xdc, 0x6d, 0xbe,
-	0xdb, 0xda, 0x30, 0xe5, 0x2f, 0x5f, 0x47, 0x9e, 0xe1, 0x3a, 0xd4, 0xf9, 0x3a, 0x17, 0xf0, 0x4c,
-	0x51, 0xb0, 0x0f, 0x5f, 0x20, 0xed, 0xb5, 0xc8, 0x0f, 0x45, 0x11, 0x77, 0x01, 0x26, 0xe6, 0x6c,
-	0x57, 0x9a, 0x91, 0xdc, 0xb1, 0x13, 0xbd, 0xce, 0x30, 0x8a, 0x1b, 0xa3, 0x99, 0x37, 0x8a, 0x48,
-	0xbf, 0x98, 0x1b, 0x04, 0x8b, 0x37, 0x41, 0x9b, 0x98, 0x33, 0x34, 0x30, 0x3b, 0x36, 0x6b, 0x92,
-	0x91, 0x21, 0xc4, 0xdb, 0x50, 0x89, 0x67, 0x1e, 0x59, 0x6b, 0x74, 0xe6, 0x18, 0xdb, 0x8d, 0x66,
-	0x9e, 0x32, 0x45, 0x06, 0xd2, 0x92, 0x1b, 0x6c, 0x66, 0x37, 0xd8, 0x85, 0x8a, 0xe5, 0xd8, 0xe4,
-	0xcd, 0x35, 0x03, 0x9b, 0xe2, 0x1e, 0x34, 0x5c, 0xbe, 0x2d, 0xf2, 0xd8, 0xad, 0xf5, 0x16, 0x1b,
-	0x3a, 0x42, 0x19, 0x09, 0xad, 0xff, 0x5b, 0x70, 0x7d, 0x8e, 0x5d, 0x79, 0xf9, 0xe8, 0xf0, 0xec,
-	0xb7, 0xf2, 0xf2, 0x51, 0xcd, 0xcb, 0xc4, 0xbf, 0x56, 0xe0, 0xba, 0x12, 0xd2, 0x53, 0x27, 0x18,
-	0xc6, 0xa8, 0xef, 0x3d, 0x68, 0x90, 0xb5, 0x56, 0xf2, 0x51, 0x35, 0x12, 0x50, 0xfc, 0x26, 0xd4,
-	0x49, 0x71, 0x13, 0xfd, 0x59, 0xce, 0x98, 0x9f, 0x0e, 0x67, 0x7d, 0x52, 0x37, 0xa7, 0xba, 0x8b,
-	0x1f, 0x42, 0xed, 0x6b, 0x19, 0xfa, 0xec, 0x7d, 0x5a, 0xeb, 0x77, 0x17, 0x8d, 0x43, 0x11, 0x50,
-	0xc3, 0xb8, 0xf3, 0xaf, 0xf1, 0x8e, 0xde, 0x45, 0x7f, 0x33, 0xf1, 0xcf, 0xa5, 0xdd, 0x6b, 0xd0,
-	0x8e, 0xf2, 0x62, 0x94, 0x90, 0x92, 0x4b, 0x69, 0x2e, 0xbc, 0x14, 0xed, 0x15, 0x97, 0xb2, 0x05,
-	0xad, 0x1c, 0x17, 0x16, 0x5c, 0xc8, 0x72, 0x51, 0x61, 0xb5, 0xd4, 0x0e, 0xe5, 0xf5, 0x7e, 0x0b,
-	0x20, 0xe3, 0xc9, 0xaf, 0x6a, 0x3d, 0xf4, 0xdf, 0x2b, 0xc1, 0xf5, 0xc7, 0xbe, 0xe7, 0x49, 0x8a,
-	0x4a, 0xf9, 0x86, 0x33, 0x25, 0x2a, 0x5d, 0xa9, 0x44, 0xef, 0x43, 0x2d, 0xc2, 0xce, 0x6a, 0xf6,
-	0x9b, 0x0b, 0xae, 0xcc, 0xe0, 0x1e, 0x68, 0x25, 0x27, 0xe6, 0x6c, 0x1c, 0x48, 0xcf, 0x76, 0xbc,
-	0x93, 0xc4, 0x4a, 0x4e, 0xcc, 0xd9, 0x01, 0x63, 0xf4, 0x3f, 0x2d, 0x03, 0x7c, 0x26, 0x4d, 0x37,
-	0x3e, 0x45, 0x4f, 0x80, 0xf7, 0xe6, 0x78, 0x51, 0x6c, 0x7a, 0x56, 0x92, 0x13, 0xa4, 0x30, 0x0a,
-	0x1f, 0xba, 0x3d, 0x19, 0xb1, 0x11, 0xd2, 0x8c, 0x04, 0x44, 0x47, 0x88, 0xcb, 0x4d, 0x23, 0xe5,
-	0x1e, 0x15, 0x94, 0x39, 0xf3, 0x2a, 0xa1, 0x95, 0x33, 0xef, 0x41, 0x03, 0x63, 0x6c, 0xc7, 0xf7,
-	0x48, 0x34, 0x34, 0x23, 0x01, 0x71, 0x9e, 0x69, 0x10, 0x3b, 0x13, 0x76, 0x82, 0x15, 0x43, 0x41,
-	0xb8, 0x2b, 0x74, 0x7a, 0x03, 0xeb, 0xd4, 0x27, 0xe5, 0xad, 0x18, 0x29, 0x8c, 0xb3, 0xf9, 0xde,
-	0x89, 0x8f, 0xa7, 0x6b, 0x52, 0xfc, 0x94, 0x80, 0x7c, 0x16, 0x5b, 0xce, 0x90, 0xa4, 0x11, 0x29,
-	0x85, 0x91, 0x2f, 0x52, 0x8e, 0x8f, 0xa5, 0x19, 0x4f, 0x43, 0x19, 0xf5, 0x80, 0xc8, 0x20, 0xe5,
-	0xb6, 0xc2, 0xe8, 0xbf, 0x5b, 0x86, 0x3a, 0xdb, 0xa5, 0x42, 0xb0, 0x50, 0xfa, 0x56, 0xc1, 0xc2,
-	0x9b, 0xa0, 0x05, 0xa1, 0xb4, 0x1d, 0x2b, 0xb9, 0x24, 0xcd, 0xc8, 0x10, 0x14, 0xa5, 0xa3, 0xdf,
-	0x24, 0x66, 0x35, 0x0d, 0x06, 0x10, 0x1b, 0x05, 0xa6, 0x25, 0xd5, 0x01, 0x19, 0x40, 0x8e, 0xb0,
-	0xc8, 0x93, 0xa8, 0x37, 0x0d, 0x05, 0x89, 0x8f, 0x41, 0xa3, 0xa8, 0x8c, 0x1c, 0xbe, 0x46, 0x8e,
-	0xfa, 0xf6, 0xcb, 0x17, 0xcb, 0x02, 0x91, 0x73, 0x9e, 0xbe, 0x99, 0xe0, 0x30, 0x2e, 0xc1, 0xc1,
-	0x68, 0xdf, 0x81, 0x82, 0x0c, 0x8a, 0x4b, 0x10, 0x35, 0x8a, 0xf2, 0x71, 0x09, 0x63, 0xf4, 0x7f,
-	0x2e, 0x43, 0x7b, 0xcb, 0x09, 0xa5, 0x15, 0x4b, 0x7b, 0x60, 0x9f, 0xd0, 0x66, 0xa4, 0x17, 0x3b,
-	0xf1, 0x85, 0x8a, 0xa4, 0x14, 0x94, 0x06, 0xba, 0xe5, 0x62, 0xe2, 0xc7, 0x1a, 0x50, 0xa1, 0x5c,
-	0x95, 0x01, 0xb1, 0x0e, 0xc0, 0x29, 0x00, 0xe5, 0xab, 0xd5, 0xab, 0xf3, 0x55, 0x8d, 0xba, 0x61,
-	0x13, 0xf3, 0x41, 0x1e, 0xe3, 0x70, 0x38, 0x55, 0xa7, 0x64, 0x76, 0x8a, 0x56, 0x86, 0x22, 0xe7,
-	0x23, 0xe9, 0x92, 0xb8, 0x50, 0xe4, 0x7c, 0x24, 0xdd, 0x34, 0x5f, 0x69, 0xf0, 0x76, 0xb0, 0x2d,
-	0xde, 0x81, 0xb2, 0x1f, 0x10, 0x0f, 0xd5, 0x82, 0xf9, 0x83, 0xad, 0xed, 0x07, 0x46, 0xd9, 0x0f,
-	0x50, 0xf7, 0x38, 0x39, 0x23, 0x71, 0x41, 0xdd, 0x43, 0x0f, 0x41, 0xa9, 0x82, 0xa1, 0x28, 0x42,
-	0x87, 0xb6, 0xe9, 0xba, 0xfe, 0x2f, 0xa4, 0x7d, 0x10, 0x4a, 0x3b, 0x91, 0x9c, 0x02, 0x4e, 0xbf,
-	0x0d, 0xe5, 0xfd, 0x40, 0x34, 0xa0, 0x32, 0x1c, 0x8c, 0xba, 0xd7, 0xb0, 0xb1, 0x35, 0xd8, 0xed,
-	0x96, 0xf4, 0x6f, 0xca, 0xa0, 0x3d, 0x9b, 0xc6, 0x26, 0x6a, 0x7b, 0x84, 0xe7, 0x2a, 0x8a, 0x55,
-	0x26, 0x3f, 0xdf, 0x87, 0x66, 0x14, 0x9b, 0x21, 0x79, 0x62, 0xf6, 0x0b, 0x0d, 0x82, 0x47, 0x91,
-	0xf8, 0x01, 0xd4, 0xa4, 0x7d, 0x22, 0x13, 0x73, 0xdd, 0x9d, 0x3f, 0x8b, 0xc1, 0x64, 0xb1, 0x0a,
-	0xf5, 0xc8, 0x3a, 0x95, 0x13, 0xb3, 0x57, 0xcd, 0x3a, 0x0e, 0x09, 0xc3, 0xb1, 0xa3, 0xa1, 0xe8,
-	0xe2, 0x5d, 0xa8, 0xe1, 0x6d, 0x44, 0x2a, 0xd9, 0xa1, 0xf4, 0x08, 0x19, 0xaf, 0xba, 0x31, 0x11,
-	0x65, 0xc7, 0x0e, 0xfd, 0x60, 0xec, 0x07, 0xc4, 0xd7, 0xa5, 0xf5, 0x5b, 0x64, 0x75, 0x92, 0xd3,
-	0xac, 0x6d, 0x85, 0x7e, 0xb0, 0x1f, 0x18, 0x75, 0x9b, 0xbe, 0x98, 0xd7, 0x52, 0x77, 0x96, 0x01,
-	0x36, 0xd3, 0x1a, 0x62, 0xb8, 0x8e, 0xb1, 0x0a, 0xcd, 0x89, 0x8c, 0x4d, 0xdb, 0x8c, 0x4d, 0x65,
-	0xad, 0xdb, 0x6c, 0xc4, 0x18, 0x67, 0xa4, 0x54, 0xfd, 0x21, 0xd4, 0x79, 0x6a, 0xd1, 0x84, 0xea,
-	0xde, 0xfe, 0xde, 0x80, 0x19, 0xba, 0xb1, 0xbb, 0xdb, 0x2d, 0x21, 0x6a, 0x6b, 0x63, 0xb4, 0xd1,
-	0x2d, 0x63, 0x6b, 0xf4, 0xd3, 0x83, 0x41, 0xb7, 0xa2, 0xff, 0x53, 0x09, 0x9a, 0xc9, 0x3c, 0xe2,
-	0x53, 0x00, 0xd4, 0xbb, 0xf1, 0xa9, 0xe3, 0xa5, 0x41, 0xcd, 0x9d, 0xfc, 0x4a, 0x6b, 0x78, 0x63,
-	0x9f, 0x21, 0x95, 0xdd, 0x1b, 0xa9, 0x29, 0xc1, 0xfd, 0x21, 0x2c, 0x15, 0x89, 0x0b, 0xa2, 0xbb,
-	0xfb, 0x79, 0x3b, 0xbf, 0xb4, 0xfe, 0xbd, 0xc2, 0xd4, 0x38, 0x92, 0x84, 0x39, 0x67, 0xf2, 0x1f,
-	0x40, 0x33, 0x41, 0x8b, 0x16, 0x34, 0xb6, 0x06, 0xdb, 0x1b, 0x87, 0xbb, 0x28, 0x24, 0x00, 0xf5,
-	0xe1, 0xce, 0xde, 0x93, 0xdd, 0x01, 0x1f, 0x6b, 0x77, 0x67, 0x38, 0xea, 0x96, 0xf5, 0x3f, 0x29,
-	0x41, 0x33, 0x89, 0x21, 0xc4, 0xfb, 0xe8, 0xfc, 0x29, 0x54, 0x51, 0xbe, 0x81, 0xca, 0x11, 0xb9,
-	0x64, 0xca, 0x48, 0xe8, 0xa8, 0x18, 0x64, 0xea, 0x92, 0xa8, 0x82, 0x80, 0x7c, 0x2a, 0x57, 0x29,
-	0x54, 0x13, 0x30, 0x2b, 0xf5, 0x3d, 0xa9, 0x82, 0x44, 0x6a, 0x93, 0x0c, 0x3a, 0x9e, 0x45, 0xd6,
-	0xa2, 0xa6, 0x64, 0x10, 0xe1, 0x51, 0xa4, 0xff, 0x75, 0x15, 0x96, 0x0c, 0x19, 0xc5, 0x7e, 0x28,
-	0x0d, 0xf9, 0xf3, 0x29, 0xa6, 0xda, 0xaf, 0x10, 0xe6, 0xb7, 0x00, 0x42, 0xee, 0x9c, 0x89, 0xb3,
-	0xa6, 0x30, 0x1c, 0xa6, 0xbb, 0xbe, 0x45, 0x52, 0xa4, 0xbc, 0x47, 0x0a, 0x8b, 0x3b, 0xa0, 0x1d,
-	0x99, 0xd6, 0x19, 0x4f, 0xcb, 0x3e, 0xa4, 0xc9, 0x08, 0x9e, 0xd7, 0xb4, 0x2c, 0x19, 0x45, 0x63,
-	0xbc, 0x14, 0xf6, 0x24, 0x1a, 0x63, 0x9e, 0xca, 0x0b, 0x24, 0x47, 0xd2, 0x0a, 0x65, 0x4c, 0x64,
-	0x36, 0x10, 0x1a, 0x63, 0x90, 0xfc, 0x0e, 0x74, 0x22, 0x19, 0xa1, 0xd7, 0x19, 0xc7, 0xfe, 0x99,
-	0xf4, 0x94, 0xb5, 0x68, 0x2b, 0xe4, 0x08, 0x71, 0x68, 0xc7, 0x4d, 0xcf, 0xf7, 0x2e, 0x26, 0xfe,
-	0x34, 0x52, 0x06, 0x38, 0x43, 0x88, 0x35, 0xb8, 0x29, 0x3d, 0x2b, 0xbc, 0x08, 0x70, 0xaf, 0xb8,
-	0xca, 0xf8, 0xd8, 0x71, 0xa5, 0x0a, 0x14, 0x6f, 0x64, 0xa4, 0xa7, 0xf2, 0x62, 0xdb, 0x71, 0x25,
-	0xee, 0xe8, 0xdc, 0x9c, 0xba, 0xf1, 0x98, 0x12, 0x49, 0xe0, 0x1d, 0x11, 0x66, 0x03, 0xb3, 0xc9,
-	0x0f, 0xe0, 0x06, 0x93, 0x43, 0xdf, 0x95, 0x8e, 0xcd, 0x93, 0xb5, 0xa8, 0xd7, 0x75, 0x22, 0x18,
-	0x84, 0xa7, 0xa9, 0xd6, 0xe0, 0x26, 0xf7, 0xe5, 0x03, 0x25, 0xbd, 0xdb, 0xbc, 0x34, 0x91, 0x86,
-	0x8a, 0x52, 0x5c, 0x3a, 0x30, 0xe3, 0x53, 0x4a, 0x10, 0x93, 0xa5, 0x0f, 0xcc, 0xf8, 0x14, 0xbd,
-	0x21, 0x93, 0x8f, 0x1d, 0xe9, 0x72, 0xe2, 0xa7, 0x19, 0x3c, 0x62, 0x1b, 0x31, 0xe2, 0x6d, 0x68,
-	0xab, 0x0e, 0x7e, 0x38, 0x31, 0xb9, 0xbe, 0xa4, 0x19, 0x3c, 0x68, 0x9b, 0x50, 0xb8, 0x84, 0xba,
-	0x2b, 0x6f, 0x3a, 0xe9, 0x75, 0xf9, 0x9a, 0x19, 0xb3, 0x37, 0x9d, 0xe8, 0xff, 0x5b, 0x86, 0x66,
-	0x9a, 0x6c, 0xdc, 0x07, 0x6d, 0x92, 0x58, 0x0e, 0x15, 0xc4, 0x74, 0x0a, 0xe6, 0xc4, 0xc8, 0xe8,
-	0xe2, 0x2d, 0x28, 0x9f, 0x9d, 0x2b, 0x2b, 0xd6, 0x59, 0xe3, 0x7a, 0x6b, 0x70, 0xb4, 0xbe, 0xf6,
-	0xf4, 0xb9, 0x51, 0x3e, 0x3b, 0xcf, 0x82, 0xa1, 0xda, 0x6b, 0x83, 0xa1, 0xf7, 0xe0, 0xba, 0xe5,
-	0x4a, 0xd3, 0x1b, 0x67, 0xce, 0x99, 0xe5, 0x62, 0x89, 0xd0, 0x07, 0xa9, 0x87, 0x56, 0x8a, 0xde,
-	0xc8, 0x14, 0xfd, 0x1e, 0xd4, 0x6c, 0xe9, 0xc6, 0x66, 0xbe, 0x10, 0xb8, 0x1f, 0x9a, 0x96, 0x2b,
-	0xb7, 0x10, 0x6d, 0x30, 0x15, 0xed, 0x5a, 0x92, 0x10, 0xe5, 0xed, 0x5a, 0xa2, 0xc2, 0x46, 0x4a,
-	0xcd, 0x34, 0x14, 0xf2, 0x1a, 0x7a, 0x1f, 0x6e, 0xc8, 0x59, 0x40, 0xc6, 0x7c, 0x9c, 0x26, 0xaf,
-	0x2d, 0xea, 0xd1, 0x4d, 0x08, 0x8f, 0x15, 0x5e, 0x7c, 0x88, 0xea, 0x4c, 0x6a, 0x44, 0x17, 0xdf,
-	0x5a, 0x17, 0x64, 0x0f, 0x0a, 0x8a, 0x69, 0x24, 0x5d, 0x74, 0x0f, 0x2a, 0x4f, 0x9f, 0x0f, 0x15,
-	0x37, 0x4b, 0x57, 0x71, 0x33, 0xb1, 0x04, 0xe5, 0x9c, 0x25, 0xb8, 0xcb, 0x46, 0x94, 0x58, 0x93,
-	0x14, 0xa9, 0x72, 0x18, 0x3c, 0x0a, 0x3b, 0x90, 0x2a, 0xd7, 0xaf, 0x08, 0xd0, 0xff, 0xa7, 0x02,
-	0x0d, 0xe5, 0xd5, 0x91, 0x9f, 0xd3, 0xb4, 0xfe, 0x82, 0xcd, 0x62, 0xda, 0x93, 0x86, 0x07, 0xf9,
-	0x62, 0x76, 0xe5, 0xf5, 0xc5, 0x6c, 0xf1, 0x29, 0xb4, 0x03, 0xa6, 0xe5, 0x03, 0x8a, 0x37, 0xf2,
-	0x63, 0xd4, 0x97, 0xc6, 0xb5, 0x82, 0x0c, 0x40, 0x8b, 0x45, 0x95, 0xbe, 0xd8, 0x3c, 0x21, 0xd1,
-	0x69, 0x1b, 0x0d, 0x84, 0x47, 0xe6, 0xc9, 0x15, 0x61, 0xc5, 0xb7, 0x89, 0x0e, 0x96, 0x28, 0xcc,
-	0x68, 0x93, 0x01, 0xc4, 0x88, 0x22, 0xef, 0xc8, 0x3b, 0x45, 0x47, 0x7e, 0x07, 0x34, 0xcb, 0x9f,
-	0x4c, 0x1c, 0xa2, 0x2d, 0xa9, 0xfa, 0x04, 0x21, 0x46, 0x91, 0xfe, 0x87, 0x25, 0x68, 0xa8, 0xd3,
-	0x5e, 0x72, 0x13, 0x9b, 0x3b, 0x7b, 0x1b, 0xc6, 0x4f, 0xbb, 0x25, 0x74, 0x83, 0x3b, 0x7b, 0xa3,
-	0x6e, 0x59, 0x68, 0x50, 0xdb, 0xde, 0xdd, 0xdf, 0x18, 0x75, 0x2b, 0xe8, 0x3a, 0x36, 0xf7, 0xf7,
-	0x77, 0xbb, 0x55, 0xd1, 0x86, 0xe6, 0xd6, 0xc6, 0x68, 0x30, 0xda, 0x79, 0x36, 0xe8, 0xd6, 0xb0,
-	0xef, 0x93, 0xc1, 0x7e, 0xb7, 0x8e, 0x8d, 0xc3, 0x9d, 0xad, 0x6e, 0x03, 0xe9, 0x07, 0x1b, 0xc3,
-	0xe1, 0x97, 0xfb, 0xc6, 0x56, 0xb7, 0x49, 0xee, 0x67, 0x64, 0xec, 0xec, 0x3d, 0xe9, 0x6a, 0xd8,
-	0xde, 0xdf, 0xfc, 0x7c, 0xf0, 0x78, 0xd4, 0x05, 0xfd, 0x23, 0x68, 0xe5, 0x38, 0x88, 0xa3, 0x8d,
-	0xc1, 0x76, 0xf7, 0x1a, 0x2e, 0xf9, 0x7c, 0x63, 0xf7, 0x10, 0xbd, 0xd5, 0x12, 0x00, 0x35, 0xc7,
-	0xbb, 0x1b, 0x7b, 0x4f, 0xba, 0x65, 0xfd, 0x0b, 0x68, 0x1e, 0x3a, 0xf6, 0xa6, 0xeb, 0x5b, 0x67,
-	0x28, 0x4e, 0x47, 0x66, 0x24, 0x55, 0x6a, 0x44, 0x6d, 0x8c, 0x22, 0x49, 0x59, 0x22, 0x75, 0xf7,
-	0x0a, 0x42, 0x5e, 0x79, 0xd3, 0xc9, 0x98, 0x1e, 0x40, 0x2a, 0xec, 0x42, 0xbc, 0xe9, 0xe4, 0xd0,
-	0xb1, 0x23, 0xdd, 0x85, 0xc6, 0xa1, 0x63, 0x1f, 0x98, 0xd6, 0x19, 0x99, 0x19, 0x9c, 0x7a, 0x1c,
-	0x39, 0x5f, 0x4b, 0xe5, 0x6a, 0x34, 0xc2, 0x0c, 0x9d, 0xaf, 0xa5, 0x78, 0x17, 0xea, 0x04, 0x24,
-	0x69, 0x30, 0xa9, 0x5f, 0xb2, 0x1d, 0x43, 0xd1, 0xc8, 0xae, 0xbb, 0xe4, 0x65, 0xfc, 0xb0, 0xf7,
-	0x06, 0x9b, 0xaa, 0x14, 0xa1, 0xff, 0x51, 0x29, 0x3d, 0x34, 0xd5, 0xbf, 0x97, 0xa1, 0x1a, 0x98,
-	0xd6, 0x99, 0x72, 0xba, 0x2d, 0x35, 0x23, 0xee, 0xc6, 0x20, 0x82, 0x78, 0x0f, 0x9a, 0x4a, 0xb2,
-	0x92, 0x65, 0x5b, 0x39, 0x11, 0x34, 0x52, 0x62, 0xf1, 0xce, 0x2b, 0xc5, 0x3b, 0xa7, 0x24, 0x2a,
-	0x70, 0x9d, 0x98, 0xf5, 0xa8, 0x6a, 0x28, 0x48, 0xff, 0x21, 0x40, 0xf6, 0xe4, 0xb0, 0x20, 0x06,
-	0xb9, 0x05, 0x35, 0xd3, 0x75, 0xcc, 0x24, 0x29, 0x63, 0x40, 0xdf, 0x83, 0x56, 0xee, 0xa1, 0x02,
-	0x99, 0x6b, 0xba, 0x2e, 0x3a, 0xa9, 0x88, 0xc6, 0x36, 0x8d, 0x86, 0xe9, 0xba, 0x4f, 0xe5, 0x45,
-	0x84, 0xf1, 0x1f, 0xbf, 0x71, 0x94, 0xe7, 0xca, 0xe3, 0x34, 0xd4, 0x60, 0xa2, 0xfe, 0x21, 0xd4,
-	0xb7, 0x93, 0x08, 0x38, 0xd1, 0x83, 0xd2, 0x55, 0x7a, 0xa0, 0x7f, 0xa2, 0xf6, 0x4c, 0x15, 0x76,
-	0x71, 0x5f, 0xbd, 0xa5, 0x44, 0xfc, 0x72, 0x53, 0xca, 0xd2, 0x7a, 0xee, 0xa4, 0x9e, 0x51, 0xa8,
-	0xb3, 0xbe, 0x05, 0xcd, 0x57, 0xbe, 0x4e, 0x29, 0x06, 0x94, 0x33, 0x06, 0x2c, 0x78, 0xaf, 0xd2,
-	0x7f, 0x06, 0x90, 0xbd, 0xb9, 0x28, 0xb5, 0xe4, 0x59, 0x50, 0x2d, 0x3f, 0x80, 0xa6, 0x75, 0xea,
-	0xb8, 0x76, 0x28, 0xbd, 0xc2, 0xa9, 0xb3, 0x57, 0x9a, 0x94, 0x2e, 0x56, 0xa0, 0x4a, 0x4f, 0x49,
-	0x95, 0xcc, 0x9c, 0xa7, 0xef, 0x48, 0x44, 0xd1, 0x67, 0xd0, 0xe1, 0xc0, 0xfa, 0x5b, 0x04, 0x43,
-	0x45, 0x5b, 0x5a, 0xbe, 0x64, 0x4b, 0x6f, 0x43, 0x9d, 0x7c, 0x70, 0x72, 0x1a, 0x05, 0x5d, 0x61,
-	0x63, 0x7f, 0xbf, 0x0c, 0xc0, 0x4b, 0xef, 0xf9, 0xb6, 0x2c, 0xa6, 0x9d, 0xa5, 0xf9, 0xb4, 0x53,
-	0x40, 0x35, 0x7d, 0x25, 0xd4, 0x0c, 0x6a, 0x67, 0x5e, 0x48, 0xa5, 0xa2, 0xec, 0x85, 0xde, 0x04,
-	0x8d, 0x62, 0x22, 0xe7, 0x6b, 0x2a, 0x6d, 0xe3, 0x82, 0x19, 0x22, 0xff, 0x66, 0x56, 0x2b, 0xbe,
-	0x99, 0xa5, 0x0f, 0x0b, 0x75, 0x9e, 0x8d, 0x1f, 0x16, 0x16, 0xbc, 0x91, 0x70, 0xa2, 0x1f, 0xc9,
-	0x30, 0x4e, 0xd2, 0x5a, 0x86, 0xd2, 0xd4, 0x4d, 0x53, 0x7d, 0x4d, 0x4e, 0xd5, 0x3d, 0x7f, 0x6c,
-	0xf9, 0xde, 0xb1, 0xeb, 0x58, 0xb1, 0x7a, 0x23, 0x03, 0xcf, 0x7f, 0xac, 0x30, 0xfa, 0xa7, 0xd0,
-	0x4e, 0xf8, 0x4f, 0x4f, 0x11, 0x1f, 0xa4, 0xa9, 0x4f, 0x29, 0xbb, 0xdb, 0x8c, 0x4d, 0x9b, 0xe5,
-	0x5e, 0x29, 0x49, 0x7e, 0xf4, 0xff, 0xae, 0x24, 0x83, 0x55, 0x45, 0xfd, 0xd5, 0x3c, 0x2c, 0xe6,
-	0xaf, 0xe5, 0x6f, 0x95, 0xbf, 0xfe, 0x08, 0x34, 0x9b, 0x12, 0x34, 0xe7, 0x3c, 0xf1, 0x6a, 0xfd,
-	0xf9, 0x64, 0x4c, 0xa5, 0x70, 0xce, 0xb9, 0x34, 0xb2, 0xce, 0xaf, 0xb9, 0x87, 0x94, 0xdb, 0xb5,
-	0x45, 0xdc, 0xae, 0xff, 0x8a, 0xdc, 0x7e, 0x1b, 0xda, 0x9e, 0xef, 0x8d, 0xbd, 0xa9, 0xeb, 0x9a,
-	0x47, 0xae, 0x54, 0xec, 0x6e, 0x79, 0xbe, 0xb7, 0xa7, 0x50, 0x18, 0xa8, 0xe6, 0xbb, 0xb0, 0x52,
-	0xb7, 0xa8, 0xdf, 0xf5, 0x5c, 0x3f, 0x52, 0xfd, 0x55, 0xe8, 0xfa, 0x47, 0x3f, 0x93, 0x56, 0x4c,
-	0x1c, 0x1b, 0x93, 0x36, 0x73, 0x94, 0xba, 0xc4, 0x78, 0x64, 0xd1, 0x1e, 0xea, 0xf5, 0xdc, 0x35,
-	0x77, 0x2e, 0x5d, 0xf3, 0x27, 0xa0, 0xa5, 0x5c, 0xca, 0x25, 0x83, 0x1a, 0xd4, 0x76, 0xf6, 0xb6,
-	0x06, 0x3f, 0xe9, 0x96, 0xd0, 0x53, 0x1a, 0x83, 0xe7, 0x03, 0x63, 0x38, 0xe8, 0x96, 0xd1, 0x8b,
-	0x6d, 0x0d, 0x76, 0x07, 0xa3, 0x41, 0xb7, 0xf2, 0x79, 0xb5, 0xd9, 0xe8, 0x36, 0xa9, 0x2e, 0xee,
-	0x3a, 0x96, 0x13, 0xeb, 0x43, 0x80, 0x2c, 0xc3, 0x45, 0xab, 0x9c, 0x6d, 0x4e, 0x15, 0xbd, 0xe2,
-	0x64, 0x5b, 0xab, 0xa9, 0x42, 0x96, 0xaf, 0xca, 0xa3, 0x99, 0xae, 0xaf, 0x83, 0xf6, 0xcc, 0x0c,
-	0x3e, 0xe3, 0x27, 0xa0, 0x7b, 0xb0, 0x14, 0x98, 0x61, 0xec, 0x24, 0xa9, 0x01, 0x1b, 0xcb, 0xb6,
-	0xd1, 0x49, 0xb1, 0x68, 0x7b, 0xf5, 0xbf, 0x29, 0xc1, 0xad, 0x67, 0xfe, 0xb9, 0x4c, 0x43, 0xcf,
-	0x03, 0xf3, 0xc2, 0xf5, 0x4d, 0xfb, 0x35, 0x62, 0x88, 0xb9, 0x8d, 0x3f, 0xa5, 0xc7, 0x9a, 0xe4,
-	0x01, 0xcb, 0xd0, 0x18, 0xf3, 0x44, 0xbd, 0xa0, 0xcb, 0x28, 0x26, 0xa2, 0xf2, 0xa4, 0x08, 0x23,
-	0xe9, 0x7b, 0x50, 0x8f, 0x67, 0x5e, 0xf6, 0x5e, 0x56, 0x8b, 0xa9, 0x24, 0xbb, 0x30, 0xee, 0xac,
-	0x2d, 0x8e, 0x3b, 0xf5, 0xc7, 0xa0, 0x8d, 0x66, 0x54, 0xae, 0x9c, 0x46, 0x85, 0x08, 0xa7, 0xf4,
-	0x8a, 0x08, 0xa7, 0x3c, 0x17, 0xe1, 0xfc, 0x47, 0x09, 0x5a, 0xb9, 0x00, 0x5a, 0xbc, 0x0d, 0xd5,
-	0x78, 0xe6, 0x15, 0x5f, 0xa5, 0x93, 0x45, 0x0c, 0x22, 0xa1, 0x68, 0x4e, 0xcc, 0xd9, 0xd8, 0x8c,
-	0x22, 0xe7, 0xc4, 0x93, 0xb6, 0x9a, 0xb2, 0x35, 0x31, 0x67, 0x1b, 0x0a, 0x25, 0x76, 0xe1, 0x3a,
-	0x5b, 0xde, 0xe4, 0x10, 0x49, 0x9d, 0xe4, 0x9d, 0xb9, 0x80, 0x9d, 0x4b, 0xba, 0xc9, 0x91, 0x54,
-	0xf2, 0xbf, 0x74, 0x52, 0x40, 0xf6, 0x37, 0xe0, 0xe6, 0x82, 0x6e, 0xdf, 0xa9, 0x88, 0xbf, 0x0c,
-	0x9d, 0xd1, 0xcc, 0x1b, 0x39, 0x13, 0x19, 0xc5, 0xe6, 0x24, 0xa0, 0x08, 0x51, 0x79, 0xce, 0xaa,
-	0x51, 0x8e, 0x23, 0xfd, 0x07, 0xd0, 0x3e, 0x90, 0x32, 0x34, 0x64, 0x14, 0xf8, 0x1e, 0x47, 0x47,
-	0xaa, 0x94, 0xca, 0x6e, 0x5a, 0x41, 0xfa, 0xef, 0x80, 0x86, 0x99, 0xfe, 0xa6, 0x19, 0x5b, 0xa7,
-	0xdf, 0xa5, 0x12, 0xf0, 0x03, 0x68, 0x04, 0x2c, 0x53, 0x2a, 0xd1, 0x6a, 0x93, 0xbb, 0x56, 0x72,
-	0x66, 0x24, 0x44, 0xfd, 0x23, 0xb8, 0x39, 0x9c, 0x1e, 0x45, 0x56, 0xe8, 0x50, 0xce, 0x9a, 0xb8,
-	0xb2, 0x3e, 0x34, 0x83, 0x50, 0x1e, 0x3b, 0x33, 0x99, 0x48, 0x70, 0x0a, 0xeb, 0x3f, 0x86, 0x5b,
-	0xc5, 0x21, 0xea, 0x08, 0xef, 0x40, 0xe5, 0xec, 0x3c, 0x52, 0x3b, 0xbb, 0x51, 0xc8, 0x31, 0xe8,
-	0x31, 0x18, 0xa9, 0xba, 0x01, 0x95, 0xbd, 0xe9, 0x24, 0xff, 0x43, 0x4b, 0x95, 0x7f, 0x68, 0xb9,
-	0x93, 0xaf, 0x6c, 0x72, 0x1a, 0x92, 0x55, 0x30, 0xdf, 0x04, 0xed, 0xd8, 0x0f, 0x7f, 0x61, 0x86,
-	0xb6, 0xb4, 0x95, 0xcf, 0xca, 0x10, 0xfa, 0x57, 0xd0, 0x4a, 0x24, 0x61, 0xc7, 0xa6, 0xd7, 0x2f,
-	0x12, 0xc5, 0x1d, 0xbb, 0x20, 0x99, 0x5c, 0x37, 0x94, 0x9e, 0xbd, 0x93, 0x88, 0x10, 0x03, 0xc5,
-	0x95, 0xd5, 0xa3, 0x45, 0xb2, 0xb2, 0xbe, 0x0d, 0xed, 0x24, 0x8b, 0x7b, 0x26, 0x63, 0x93, 0x84,
-	0xdb, 0x75, 0xa4, 0x97, 0x13, 0xfc, 0x26, 0x23, 0x46, 0xc5, 0xd2, 0x5e, 0xb9, 0x10, 0x00, 0xe8,
-	0x6b, 0x50, 0x57, 0x9a, 0x23, 0xa0, 0x6a, 0xf9, 0x36, 0x6b, 0x77, 0xcd, 0xa0, 0x36, 0xb2, 0x63,
-	0x12, 0x9d, 0x24, 0xc1, 0xcd, 0x24, 0x3a, 0xd1, 0xff, 0xae, 0x0c, 0x9d, 0x4d, 0xca, 0xa2, 0x93,
-	0x2b, 0xc9, 0x55, 0x71, 0x4a, 0x85, 0x2a, 0x4e, 0xbe, 0x62, 0x53, 0x2e, 0x54, 0x6c, 0x0a, 0x1b,
-	0xaa, 0x14, 0x23, 0x92, 0x37, 0xa0, 0x31, 0xf5, 0x9c, 0x59, 0x62, 0x12, 0x34, 0xa3, 0x8e, 0xe0,
-	0x28, 0x12, 0x2b, 0xd0, 0x42, 0xab, 0xe1, 0x78, 0x5c, 0x9b, 0xe1, 0x02, 0x4b, 0x1e, 0x35, 0x57,
-	0x81, 0xa9, 0xbf, 0xba, 0x02, 0xd3, 0x78, 0x6d, 0x05, 0xa6, 0xf9, 0xba, 0x0a, 0x8c, 0x36, 0x5f,
-	0x81, 0x29, 0x46, 0x53, 0x30, 0x1f, 0x4d, 0xe9, 0x7f, 0x56, 0x86, 0xce, 0x60, 0x16, 0xd0, 0x5f,
-	0x0a, 0xaf, 0x0d, 0xcd, 0x72, 0x7c, 0x2d, 0x17, 0xf8, 0x9a, 0xe3, 0x50, 0x45, 0x3d, 0x4b, 0x30,
-	0x87, 0x30, 0x58, 0xe3, 0x7a, 0x88, 0xe2, 0x1c, 0x43, 0xff, 0x0f, 0x38, 0xa7, 0xef, 0xc2, 0x52,
-	0xc2, 0x18, 0xa5, 0xb5, 0xdf, 0x4a, 0x1c, 0xf9, 0x0f, 0x23, 0x37, 0x2d, 0x03, 0x30, 0xa0, 0xff,
-	0x71, 0x19, 0x34, 0x16, 0x52, 0xdc, 0xde, 0xfb, 0x2a, 0xd0, 0x2c, 0x65, 0x35, 0xd1, 0x94, 0xb8,
-	0xf6, 0x54, 0x5e, 0x50, 0x80, 0xc4, 0xf1, 0xe7, 0xa2, 0x97, 0x03, 0x55, 0x2c, 0xe0, 0xf4, 0x88,
-	0x8a, 0x05, 0x77, 0x40, 0x63, 0x1f, 0x33, 0x75, 0x92, 0xb7, 0x46, 0x76, 0x3a, 0x87, 0x0e, 0xfd,
-	0x98, 0x11, 0xcb, 0x70, 0xa2, 0xb8, 0x4c, 0xed, 0x62, 0x20, 0xda, 0x51, 0xa1, 0x91, 0x7e, 0x0a,
-	0x0d, 0xb5, 0x3a, 0x46, 0x0a, 0x87, 0x7b, 0x4f, 0xf7, 0xf6, 0xbf, 0xdc, 0xeb, 0x5e, 0x4b, 0xab,
-	0xc8, 0xa5, 0x2c, 0x96, 0x28, 0xe7, 0x63, 0x89, 0x0a, 0xe2, 0x1f, 0xef, 0x1f, 0xee, 0x8d, 0xba,
-	0x55, 0xd1, 0x01, 0x8d, 0x9a, 0x63, 0x63, 0xf0, 0xbc, 0x5b, 0xa3, 0xbc, 0xf9, 0xf1, 0x67, 0x83,
-	0x67, 0x1b, 0xdd, 0x7a, 0x5a, 0x83, 0x6e, 0xe8, 0x7f, 0x50, 0x82, 0x1b, 0x7c, 0xe4, 0x7c, 0x1e,
-	0x99, 0xff, 0xbb, 0xaf, 0xca, 0x7f, 0xf7, 0xfd, 0x9a, 0x53, 0xc7, 0x7f, 0x28, 0x41, 0x9f, 0xa3,
-	0x94, 0x27, 0xa1, 0x19, 0x9c, 0x7e, 0xb1, 0x7b, 0x29, 0x4f, 0xb9, 0xca, 0x77, 0xdf, 0x83, 0x25,
-	0xfa, 0xc5, 0xf1, 0xe7, 0xee, 0x58, 0xc5, 0xd2, 0x7c, 0x45, 0x1d, 0x85, 0xe5, 0x89, 0xc4, 0xc7,
-	0xd0, 0xe6, 0x5f, 0x21, 0xa9, 0xa4, 0x56, 0x78, 0x94, 0x28, 0xc4, 0x48, 0x2d, 0xee, 0x45, 0xcf,
-	0x23, 0xe2, 0xa3, 0x74, 0x50, 0x96, 0xd2, 0x5c, 0x7e, 0x77, 0x50, 0x43, 0x46, 0x94, 0xe8, 0x3c,
-	0x84, 0x3b, 0x0b, 0xcf, 0xa1, 0x64, 0x37, 0x57, 0x5f, 0x62, 0x91, 0x59, 0xff, 0xfb, 0x12, 0x54,
-	0xd1, 0x1f, 0x8a, 0x07, 0xa0, 0x7d, 0x26, 0xcd, 0x30, 0x3e, 0x92, 0x66, 0x2c, 0x0a, 0xbe, 0xaf,
-	0x4f, 0x2b, 0x66, 0x6f, 0x9f, 0xfa, 0xb5, 0x47, 0x25, 0xb1, 0xc6, 0x7f, 0x27, 0x25, 0x3f, 0x5d,
-	0x75, 0x12, 0xbf, 0x4a, 0x7e, 0xb7, 0x5f, 0x18, 0xaf, 0x5f, 0x5b, 0xa5, 0xfe, 0x9f, 0xfb, 0x8e,
-	0xf7, 0x98, 0x7f, 0xa6, 0x11, 0xf3, 0x7e, 0x78, 0x7e, 0x84, 0x78, 0x00, 0xf5, 0x9d, 0x08, 0x1d,
-	0xfe, 0xe5, 0xae, 0xc4, 0xb5, 0x7c, 0x2c, 0xa0, 0x5f, 0x5b, 0xff, 0xab, 0x0a, 0x54, 0xbf, 0x92,
-	0xa1, 0x2f, 0x3e, 0x84, 0x86, 0x7a, 0x29, 0x16, 0xb9, 0x17, 0xe1, 0x3e, 0xe5, 0x1e, 0x73, 0x4f,
-	0xc8, 0xb4, 0x4a, 0x97, 0xd9, 0x95, 0x15, 0x42, 0x45, 0xf6, 0x90, 0x7d, 0x69, 0x53, 0x9f, 0x40,
-	0x77, 0x18, 0x87, 0xd2, 0x9c, 0xe4, 0xba, 0x17, 0x59, 0xb5, 0xa8, 0xaa, 0x4a, 0xfc, 0xba, 0x0f,
-	0x75, 0x8e, 0xaa, 0xe6, 0x06, 0xcc, 0x17, 0x48, 0xa9, 0xf3, 0x7b, 0xd0, 0x1a, 0x9e, 0xfa, 0x53,
-	0xd7, 0x1e, 0xca, 0xf0, 0x5c, 0x8a, 0xdc, 0xbf, 0x1f, 0xfd, 0x5c, 0x5b, 0xbf, 0x26, 0x56, 0x01,
-	0xd8, 0x91, 0x1f, 0xa2, 0x8e, 0x34, 0x90, 0xb6, 0x37, 0x9d, 0xf0, 0xa4, 0x39, 0x0f, 0xcf, 0x3d,
-	0x73, 0xc1, 0xd5, 0xab, 0x7a, 0x7e, 0x0c, 0x9d, 0xc7, 0xa4, 0x2f, 0xfb, 0xe1, 0xc6, 0x91, 0x1f,
-	0xc6, 0x62, 0xfe, 0xff, 0x8f, 0xfe, 0x3c, 0x42, 0xbf, 0x26, 0x1e, 0x41, 0x73, 0x14, 0x5e, 0x70,
-	0xff, 0x1b, 0x2a, 0x26, 0xcd, 0xd6, 0x5b, 0x70, 0xca, 0xf5, 0xff, 0xaa, 0x42, 0xfd, 0x4b, 0x3f,
-	0x3c, 0x93, 0x21, 0xe6, 0xa1, 0x54, 0xd0, 0x56, 0x62, 0x94, 0x16, 0xb7, 0x17, 0x2d, 0xf4, 0x2e,
-	0x68, 0xc4, 0x94, 0x91, 0x19, 0x9d, 0xf1, 0x55, 0xd1, 0x3f, 0xb5, 0xcc, 0x17, 0xce, 0x6b, 0xe9,
-	0x5e, 0x97, 0xf8, 0xa2, 0xd2, 0x37, 0xa1, 0x42, 0x79, 0xb9, 0x4f, 0xe7, 0x7f, 0xfa, 0x7c, 0x88,
-	0xa2, 0xf9, 0xa8, 0x84, 0x86, 0x78, 0xc8, 0x27, 0xc5, 0x4e, 0xd9, 0xbf, 0x84, 0x2c, 0xf9, 0xd9,
-	0xcf, 0x7b, 0xfa, 0x35, 0xf1, 0x10, 0xea, 0x4a, 0xa5, 0x6f, 0x64, 0xca, 0xab, 0xec, 0x44, 0xbf,
-	0x9b, 0x47, 0xa9, 0x01, 0xef, 0x43, 0x9d, 0x2d, 0x1c, 0x0f, 0x28, 0x84, 0x28, 0xbc, 0x6b, 0x0e,
-	0x73, 0xf4, 0x6b, 0xe2, 0x3e, 0x34, 0x54, 0x51, 0x5a, 0x2c, 0xa8, 0x50, 0xcf, 0x75, 0xfe, 0x08,
-	0xea, 0xec, 0x98, 0x78, 0xde, 0x82, 0xf7, 0xee, 0x8b, 0x3c, 0x2a, 0x51, 0x12, 0x94, 0x76, 0x43,
-	0x5a, 0xd2, 0xc9, 0xa5, 0x51, 0x22, 0xe1, 0xc4, 0x02, 0x95, 0xfd, 0x04, 0x3a, 0x85, 0x94, 0x4b,
-	0xf4, 0xe8, 0x76, 0x16, 0x64, 0x61, 0x97, 0x14, 0xe5, 0xc7, 0xa0, 0xa9, 0x88, 0xf7, 0x48, 0x0a,
-	0x2a, 0x33, 0x2f, 0x88, 0x99, 0xfb, 0x97, 0x43, 0x5e, 0x92, 0xfe, 0x9f, 0xc0, 0xcd, 0x05, 0x36,
-	0x4c, 0xd0, 0x0f, 0x37, 0x57, 0x1b, 0xe9, 0xfe, 0xf2, 0x95, 0xf4, 0x84, 0x01, 0x9b, 0xdd, 0x7f,
-	0xfc, 0xe6, 0x6e, 0xe9, 0x5f, 0xbe, 0xb9, 0x5b, 0xfa, 0xb7, 0x6f, 0xee, 0x96, 0x7e, 0xf9, 0xef,
-	0x77, 0xaf, 0x1d, 0xd5, 0xe9, 0xbf, 0xf2, 0x8f, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x17, 0x00,
-	0x73, 0x89, 0xcd, 0x2e, 0x00, 0x00,
+	// 4926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7b, 0xcd, 0x6f, 0x1c, 0x47,
+	0x76, 0xb8, 0xba, 0xe7, 0xb3, 0xdf, 0x70, 0x46, 0xa3, 0x96, 0x2c, 0xcf, 0x8e, 0xd7, 0x22, 0xdd,
+	0xb6, 0x6c, 0xda, 0xb2, 0x28, 0x99, 0xde, 0x1f, 0xbc, 0xf6, 0xe2, 0x07, 0x84, 0x1f, 0x43, 0x89,
+	0x16, 0x45, 0xd2, 0xc5, 0x91, 0xbc, 0xbb, 0x87, 0x0c, 0x9a, 0xd3, 0x45, 0xb2, 0x97, 0x3d, 0xdd,
+	0xed, 0xee, 0x1e, 0x9a, 0xf4, 0x29, 0x09, 0x90, 0x53, 0x92, 0x4b, 0x72, 0xc8, 0x02, 0x39, 0xe4,
+	0x0f, 0xc8, 0x29, 0xc9, 0x25, 0xc8, 0x21, 0xa7, 0x20, 0x08, 0x82, 0x6c, 0x90, 0x5b, 0x6e, 0x42,
+	0xe0, 0xe4, 0x24, 0x20, 0x87, 0xfc, 0x03, 0x41, 0xf0, 0xde, 0xab, 0xfe, 0x1a, 0x0d, 0x25, 0x7b,
+	0x81, 0x3d, 0xe4, 0x34, 0xf5, 0x5e, 0xbd, 0xaa, 0xae, 0x7a, 0xef, 0xd5, 0xfb, 0xaa, 0x1a, 0x68,
+	0x86, 0x87, 0x2b, 0x61, 0x14, 0x24, 0x81, 0xa9, 0x87, 0x87, 0x7d, 0xc3, 0x0e, 0x5d, 0x06, 0xfb,
+	0x1f, 0x1c, 0xbb, 0xc9, 0xc9, 0xf4, 0x70, 0x65, 0x1c, 0x4c, 0xee, 0x39, 0xc7, 0x91, 0x1d, 0x9e,
+	0xdc, 0x75, 0x83, 0x7b, 0x87, 0xb6, 0x73, 0x2c, 0xa3, 0x7b, 0x67, 0xab, 0xf7, 0xc2, 0xc3, 0x7b,
+	0xe9, 0xd0, 0xfe, 0xdd, 0x02, 0xed, 0x71, 0x70, 0x1c, 0xdc, 0x23, 0xf4, 0xe1, 0xf4, 0x88, 0x20,
+	0x02, 0xa8, 0xc5, 0xe4, 0x56, 0x1f, 0xaa, 0x3b, 0x6e, 0x9c, 0x98, 0x26, 0x54, 0xa7, 0xae, 0x13,
+	0xf7, 0xb4, 0xa5, 0xca, 0x72, 0x5d, 0x50, 0xdb, 0x7a, 0x0c, 0xc6, 0xd0, 0x8e, 0x4f, 0x9f, 0xda,
+	0xde, 0x54, 0x9a, 0x5d, 0xa8, 0x9c, 0xd9, 0x5e, 0x4f, 0x5b, 0xd2, 0x96, 0x17, 0x04, 0x36, 0xcd,
+	0x15, 0x68, 0x9e, 0xd9, 0xde, 0x28, 0xb9, 0x08, 0x65, 0x4f, 0x5f, 0xd2, 0x96, 0x3b, 0xab, 0xd7,
+	0x57, 0xc2, 0xc3, 0x95, 0xfd, 0x20, 0x4e, 0x5c, 0xff, 0x78, 0xe5, 0xa9, 0xed, 0x0d, 0x2f, 0x42,
+	0x29, 0x1a, 0x67, 0xdc, 0xb0, 0xf6, 0xa0, 0x75, 0x10, 0x8d, 0xb7, 0xa6, 0xfe, 0x38, 0x71, 0x03,
+	0x1f, 0xbf, 0xe8, 0xdb, 0x13, 0x49, 0x33, 0x1a, 0x82, 0xda, 0x88, 0xb3, 0xa3, 0xe3, 0xb8, 0x57,
+	0x59, 0xaa, 0x20, 0x0e, 0xdb, 0x66, 0x0f, 0x1a, 0x6e, 0xbc, 0x11, 0x4c, 0xfd, 0xa4, 0x57, 0x5d,
+	0xd2, 0x96, 0x9b, 0x22, 0x05, 0xad, 0x3f, 0xaf, 0x40, 0xed, 0x8b, 0xa9, 0x8c, 0x2e, 0x68, 0x5c,
+	0x92, 0x44, 0xe9, 0x5c, 0xd8, 0x36, 0x6f, 0x40, 0xcd, 0xb3, 0xfd, 0xe3, 0xb8, 0xa7, 0xd3, 0x64,
+	0x0c, 0x98, 0x6f, 0x80, 0x61, 0x1f, 0x25, 0x32, 0x1a, 0x4d, 0x5d, 0xa7, 0x57, 0x59, 0xd2, 0x96,
+	0xeb, 0xa2, 0x49, 0x88, 0x27, 0xae, 0x63, 0xfe, 0x00, 0x9a, 0x4e, 0x30, 0x1a, 0x17, 0xbf, 0xe5,
+	0x04, 0xf4, 0x2d, 0xf3, 0x6d, 0x68, 0x4e, 0x5d, 0x67, 0xe4, 0xb9, 0x71, 0xd2, 0xab, 0x2d, 0x69,
+	0xcb, 0xad, 0xd5, 0x26, 0x6e, 0x16, 0x79, 0x27, 0x1a, 0x53, 0xd7, 0xc1, 0x86, 0xf9, 0x01, 0x34,
+	0xe3, 0x68, 0x3c, 0x3a, 0x9a, 0xfa, 0xe3, 0x5e, 0x9d, 0x88, 0xae, 0x22, 0x51, 0x61, 0xd7, 0xa2,
+	0x11, 0x33, 0x80, 0xdb, 0x8a, 0xe4, 0x99, 0x8c, 0x62, 0xd9, 0x6b, 0xf0, 0xa7, 0x14, 0x68, 0xde,
+	0x87, 0xd6, 0x91, 0x3d, 0x96, 0xc9, 0x28, 0xb4, 0x23, 0x7b, 0xd2, 0x6b, 0xe6, 0x13, 0x6d, 0x21,
+	0x7a, 0x1f, 0xb1, 0xb1, 0x80, 0xa3, 0x0c, 0x30, 0x3f, 0x86, 0x36, 0x41, 0xf1, 0xe8, 0xc8, 0xf5,
+	0x12, 0x19, 0xf5, 0x0c, 0x1a, 0xd3, 0xa1, 0x31, 0x84, 0x19, 0x46, 0x52, 0x8a, 0x05, 0x26, 0x62,
+	0x8c, 0xf9, 0x26, 0x80, 0x3c, 0x0f, 0x6d, 0xdf, 0x19, 0xd9, 0x9e, 0xd7, 0x03, 0x5a, 0x83, 0xc1,
+	0x98, 0x35, 0xcf, 0x33, 0x5f, 0xc7, 0xf5, 0xd9, 0xce, 0x28, 0x89, 0x7b, 0xed, 0x25, 0x6d, 0xb9,
+	0x2a, 0xea, 0x08, 0x0e, 0x63, 0xe4, 0xeb, 0xd8, 0x1e, 0x9f, 0xc8, 0x5e, 0x67, 0x49, 0x5b, 0xae,
+	0x09, 0x06, 0x10, 0x7b, 0xe4, 0x46, 0x71, 0xd2, 0xbb, 0xca, 0x58, 0x02, 0xac, 0x55, 0x30, 0x48,
+	0x7b, 0x88, 0x3b, 0xb7, 0xa1, 0x7e, 0x86, 0x00, 0x2b, 0x59, 0x6b, 0xb5, 0x8d, 0xcb, 0xcb, 0x14,
+	0x4c, 0xa8, 0x4e, 0xeb, 0x16, 0x34, 0x77, 0x6c, 0xff, 0x38, 0xd5, 0x4a, 0x14, 0x1b, 0x0d, 0x30,
+	0x04, 0xb5, 0xad, 0x5f, 0xea, 0x50, 0x17, 0x32, 0x9e, 0x7a, 0x89, 0xf9, 0x1e, 0x00, 0x0a, 0x65,
+	0x62, 0x27, 0x91, 0x7b, 0xae, 0x66, 0xcd, 0xc5, 0x62, 0x4c, 0x5d, 0xe7, 0x31, 0x75, 0x99, 0xf7,
+	0x61, 0x81, 0x66, 0x4f, 0x49, 0xf5, 0x7c, 0x01, 0xd9, 0xfa, 0x44, 0x8b, 0x48, 0xd4, 0x88, 0x9b,
+	0x50, 0x27, 0x3d, 0x60, 0x5d, 0x6c, 0x0b, 0x05, 0x99, 0xb7, 0xa1, 0xe3, 0xfa, 0x09, 0xca, 0x69,
+	0x9c, 0x8c, 0x1c, 0x19, 0xa7, 0x8a, 0xd2, 0xce, 0xb0, 0x9b, 0x32, 0x4e, 0xcc, 0x8f, 0x80, 0x99,
+	0x9d, 0x7e, 0xb0, 0xb6, 0x54, 0xc9, 0x04, 0x42, 0x42, 0xe0, 0x2f, 0x12, 0x8d, 0xfa, 0xe2, 0x5d,
+	0x68, 0xe1, 0xfe, 0xd2, 0x11, 0x75, 0x1a, 0xb1, 0x40, 0xbb, 0x51, 0xec, 0x10, 0x80, 0x04, 0x8a,
+	0x1c, 0x59, 0x83, 0xca, 0xc8, 0xca, 0x43, 0x6d, 0x6b, 0x00, 0xb5, 0xbd, 0xc8, 0x91, 0xd1, 0xdc,
+	0xf3, 0x60, 0x42, 0xd5, 0x91, 0xf1, 0x98, 0x8e, 0x6a, 0x53, 0x50, 0x3b, 0x3f, 0x23, 0x95, 0xc2,
+	0x19, 0xb1, 0xfe, 0x59, 0x83, 0xd6, 0x41, 0x10, 0x25, 0x8f, 0x65, 0x1c, 0xdb, 0xc7, 0xd2, 0x5c,
+	0x84, 0x5a, 0x80, 0xd3, 0x2a, 0x0e, 0x1b, 0xb8, 0x26, 0xfa, 0x8e, 0x60, 0xfc, 0x8c, 0x1c, 0xf4,
+	0xcb, 0xe5, 0x80, 0xba, 0x43, 0xa7, 0xab, 0xa2, 0x74, 0x07, 0x01, 0xe4, 0x75, 0x70, 0x74, 0x14,
+	0x4b, 0xe6, 0x65, 0x4d, 0x28, 0x08, 0xa9, 0xe9, 0x68, 0xd2, 0x81, 0x33, 0x04, 0x03, 0x48, 0x1d,
+	0x27, 0xf6, 0xa1, 0x27, 0xe9, 0x88, 0x35, 0x85, 0x82, 0x2e, 0x55, 0x58, 0xeb, 0xff, 0x01, 0xe0,
+	0x6e, 0xbe, 0xa7, 0xce, 0x58, 0x27, 0xd0, 0x12, 0xf6, 0x51, 0xb2, 0x11, 0xf8, 0x89, 0x3c, 0x4f,
+	0xcc, 0x0e, 0xe8, 0xae, 0x43, 0x0c, 0xad, 0x0b, 0xdd, 0x75, 0x70, 0x71, 0xc7, 0x51, 0x30, 0x0d,
+	0x89, 0x9f, 0x6d, 0xc1, 0x00, 0x31, 0xde, 0x71, 0xa2, 0x5e, 0x45, 0x31, 0xde, 0x71, 0x22, 0x73,
+	0x11, 0x5a, 0xb1, 0x6f, 0x87, 0xf1, 0x49, 0x90, 0xe0, 0xe2, 0xaa, 0xb4, 0x38, 0x48, 0x51, 0xc3,
+	0xd8, 0xfa, 0x2f, 0x1d, 0xea, 0x8f, 0xe5, 0xe4, 0x50, 0x46, 0x2f, 0x7c, 0xe5, 0x3e, 0x34, 0x69,
+	0xe2, 0x91, 0xeb, 0xf0, 0x87, 0xd6, 0x5f, 0x7b, 0xfe, 0x6c, 0xf1, 0x1a, 0xe1, 0xb6, 0x9d, 0x0f,
+	0x83, 0x89, 0x9b, 0xc8, 0x49, 0x98, 0x5c, 0x88, 0x86, 0x42, 0xcd, 0x5d, 0xc1, 0x4d, 0xa8, 0x7b,
+	0xd2, 0x46, 0x09, 0xb2, 0xb2, 0x2a, 0xc8, 0xbc, 0x0b, 0x0d, 0x7b, 0x32, 0x72, 0xa4, 0xed, 0x10,
+	0x8b, 0x9b, 0xeb, 0x37, 0x9e, 0x3f, 0x5b, 0xec, 0xda, 0x93, 0x4d, 0x69, 0x17, 0xe7, 0xae, 0x33,
+	0xc6, 0xfc, 0x14, 0x35, 0x34, 0x4e, 0x46, 0xd3, 0xd0, 0xb1, 0x13, 0x66, 0x7f, 0x75, 0xbd, 0xf7,
+	0xfc, 0xd9, 0xe2, 0x0d, 0x44, 0x3f, 0x21, 0x6c, 0x61, 0x18, 0xe4, 0x58, 0x73, 0x1b, 0xae, 0x8d,
+	0xbd, 0x69, 0x8c, 0x86, 0xd7, 0xf5, 0x8f, 0x82, 0x51, 0xe0, 0x7b, 0x17, 0x24, 0xa6, 0xe6, 0xfa,
+	0x9b, 0xcf, 0x9f, 0x2d, 0xfe, 0x40, 0x75, 0x6e, 0xfb, 0x47, 0xc1, 0x9e, 0xef, 0x5d, 0x14, 0x66,
+	0xb9, 0x3a, 0xd3, 0x65, 0xfe, 0x16, 0x74, 0x8e, 0x82, 0x68, 0x2c, 0x47, 0x19, 0x63, 0x3a, 0x34,
+	0x4f, 0xff, 0xf9, 0xb3, 0xc5, 0x9b, 0xd4, 0xf3, 0xe0, 0x05, 0xee, 0x2c, 0x14, 0xf1, 0xd6, 0xdf,
+	0xe8, 0x50, 0xa3, 0xb6, 0x79, 0x1f, 0x1a, 0x13, 0x62, 0x7c, 0x6a, 0x93, 0x6e, 0xa2, 0x26, 0x50,
+	0xdf, 0x0a, 0x4b, 0x24, 0x1e, 0xf8, 0x49, 0x74, 0x21, 0x52, 0x32, 0x1c, 0x41, 0xea, 0x96, 0xc4,
+	0x3d, 0x7d, 0x76, 0xc4, 0x90, 0x3b, 0xd4, 0x08, 0x45, 0x36, 0x2b, 0xfe, 0xca, 0xac, 0xf8, 0xcd,
+	0x3e, 0x34, 0xc7, 0x27, 0x72, 0x7c, 0x1a, 0x4f, 0x27, 0x4a, 0x39, 0x32, 0xb8, 0xbf, 0x05, 0x0b,
+	0xc5, 0x75, 0xa0, 0x17, 0x3e, 0x95, 0x17, 0xa4, 0x20, 0x55, 0x81, 0x4d, 0x73, 0x09, 0x6a, 0x64,
+	0xb7, 0x48, 0x3d, 0x5a, 0xab, 0x80, 0xcb, 0xe1, 0x21, 0x82, 0x3b, 0x3e, 0xd3, 0x7f, 0xac, 0xe1,
+	0x3c, 0xc5, 0xd5, 0x15, 0xe7, 0x31, 0x2e, 0x9f, 0x87, 0x87, 0x14, 0xe6, 0xb1, 0x02, 0x68, 0xec,
+	0xb8, 0x63, 0xe9, 0xc7, 0xe4, 0xab, 0xa7, 0xb1, 0xcc, 0x6c, 0x0c, 0xb6, 0x71, 0x2b, 0x13, 0xfb,
+	0x7c, 0x37, 0x70, 0x64, 0x4c, 0xf3, 0x54, 0x45, 0x06, 0x63, 0x9f, 0x3c, 0x0f, 0xdd, 0xe8, 0x62,
+	0xc8, 0x4c, 0xa8, 0x88, 0x0c, 0x46, 0x67, 0x28, 0x7d, 0xfc, 0x98, 0x93, 0xfa, 0x5d, 0x05, 0x5a,
+	0xbf, 0xaa, 0xc0, 0xc2, 0xcf, 0x65, 0x14, 0xec, 0x47, 0x41, 0x18, 0xc4, 0xb6, 0x67, 0xae, 0x95,
+	0xd9, 0xc9, 0x62, 0x5b, 0xc2, 0xd5, 0x16, 0xc9, 0x56, 0x0e, 0x32, 0xfe, 0xb2, 0x38, 0x8a, 0x0c,
+	0xb7, 0xa0, 0xce, 0xe2, 0x9c, 0xc3, 0x33, 0xd5, 0x83, 0x34, 0x2c, 0xc0, 0x5e, 0x25, 0xa7, 0x51,
+	0xfc, 0x50, 0x3d, 0xe6, 0x2d, 0x80, 0x89, 0x7d, 0xbe, 0x23, 0xed, 0x58, 0x6e, 0x3b, 0xe9, 0xb9,
+	0xce, 0x31, 0x8a, 0x1b, 0xc3, 0x73, 0x7f, 0x18, 0xf7, 0x6a, 0x19, 0x37, 0x08, 0x36, 0x7f, 0x08,
+	0xc6, 0xc4, 0x3e, 0x47, 0x03, 0xb3, 0xed, 0xf0, 0x49, 0x12, 0x39, 0xc2, 0x7c, 0x0b, 0x2a, 0xc9,
+	0xb9, 0xdf, 0x6b, 0x28, 0xd7, 0x8f, 0x91, 0xe0, 0xf0, 0xdc, 0x57, 0xa6, 0x48, 0x60, 0x5f, 0x2a,
+	0xc1, 0x66, 0x2e, 0xc1, 0x2e, 0x54, 0xc6, 0xae, 0x43, 0xbe, 0xdf, 0x10, 0xd8, 0x34, 0x6f, 0x43,
+	0xc3, 0x63, 0x69, 0x91, 0x7f, 0x6f, 0xad, 0xb6, 0xd8, 0xd0, 0x11, 0x4a, 0xa4, 0x7d, 0xe6, 0x1d,
+	0x68, 0x9c, 0xb8, 0x71, 0x12, 0x44, 0x17, 0xbd, 0x16, 0x91, 0x5d, 0x43, 0xb2, 0x87, 0x8c, 0xe2,
+	0x03, 0x2c, 0x52, 0x8a, 0xfe, 0xff, 0x87, 0xab, 0x33, 0xbc, 0x2d, 0x2a, 0x53, 0x9b, 0x97, 0x72,
+	0xa3, 0xa8, 0x4c, 0xd5, 0xa2, 0x02, 0xfd, 0x45, 0x15, 0xae, 0x2a, 0x8d, 0x3e, 0x71, 0xc3, 0x83,
+	0x04, 0x8d, 0x43, 0x0f, 0x1a, 0xe4, 0x08, 0x94, 0x32, 0x55, 0x45, 0x0a, 0x9a, 0x9f, 0x40, 0x9d,
+	0x4e, 0x79, 0x7a, 0xd8, 0x16, 0x73, 0x49, 0x65, 0xc3, 0xf9, 0xf0, 0x29, 0x31, 0x2b, 0x72, 0xf3,
+	0x47, 0x50, 0xfb, 0x46, 0x46, 0x01, 0x3b, 0xb6, 0xd6, 0xea, 0xad, 0x79, 0xe3, 0x50, 0x5f, 0xd4,
+	0x30, 0x26, 0xfe, 0x0d, 0x0a, 0xf4, 0x1d, 0x74, 0x4e, 0x93, 0xe0, 0x4c, 0x3a, 0xbd, 0xc6, 0x52,
+	0x25, 0xd5, 0x27, 0xa5, 0x73, 0x69, 0x57, 0x2a, 0xc1, 0xe6, 0x5c, 0x09, 0x1a, 0x2f, 0x95, 0x20,
+	0x24, 0xf1, 0x28, 0xb6, 0x27, 0xa1, 0x27, 0xe3, 0x1e, 0xe4, 0xa1, 0xc3, 0x30, 0x3e, 0x20, 0xa4,
+	0x30, 0x12, 0xd5, 0x8a, 0x31, 0xf0, 0x53, 0xc2, 0xc4, 0x03, 0xd4, 0xe2, 0xa5, 0x2a, 0xcc, 0x30,
+	0xee, 0x6f, 0x42, 0xab, 0xc0, 0xd1, 0x39, 0xc2, 0x5d, 0x2c, 0x5b, 0x0a, 0x23, 0x33, 0x80, 0x45,
+	0x83, 0xb3, 0x09, 0x90, 0xf3, 0xf7, 0xd7, 0x35, 0x5b, 0xd6, 0xef, 0x6a, 0x70, 0x75, 0x23, 0xf0,
+	0x7d, 0x49, 0xc1, 0x33, 0x6b, 0x4b, 0x7e, 0x7a, 0xb5, 0x4b, 0x4f, 0xef, 0xfb, 0x50, 0x8b, 0x91,
+	0x58, 0xcd, 0x7e, 0x7d, 0x8e, 0xf8, 0x05, 0x53, 0xa0, 0x79, 0x9e, 0xd8, 0xe7, 0xa3, 0x50, 0xfa,
+	0x8e, 0xeb, 0x1f, 0xa7, 0xe6, 0x79, 0x62, 0x9f, 0xef, 0x33, 0xc6, 0xfa, 0xeb, 0x2a, 0xc0, 0x43,
+	0x69, 0x7b, 0xc9, 0x09, 0xba, 0x20, 0xd4, 0x01, 0xd7, 0x8f, 0x13, 0xdb, 0x1f, 0xa7, 0xa9, 0x4b,
+	0x06, 0xa3, 0x22, 0xa3, 0xbf, 0x95, 0x31, 0x5b, 0x3f, 0x43, 0xa4, 0xa0, 0x0a, 0x5a, 0x92, 0x69,
+	0xac, 0xfc, 0xb2, 0x82, 0xf2, 0x28, 0xa2, 0x4a, 0x68, 0x06, 0x70, 0x1e, 0x4c, 0x05, 0xdc, 0xc0,
+	0x57, 0xa1, 0x4f, 0x0a, 0xe2, 0x3c, 0xd3, 0x30, 0x71, 0x27, 0xec, 0x7d, 0x2b, 0x42, 0x41, 0xb8,
+	0x2a, 0xf4, 0xb6, 0x83, 0xf1, 0x49, 0x40, 0x56, 0xa3, 0x22, 0x32, 0x18, 0x67, 0x0b, 0xfc, 0xe3,
+	0x00, 0x77, 0xd7, 0xa4, 0x30, 0x2f, 0x05, 0x79, 0x2f, 0x8e, 0x3c, 0xc7, 0x2e, 0x83, 0xba, 0x32,
+	0x18, 0xf9, 0x22, 0xe5, 0xe8, 0x48, 0xda, 0xc9, 0x34, 0x52, 0x3a, 0x65, 0x08, 0x90, 0x72, 0x4b,
+	0x61, 0xcc, 0x1d, 0xb8, 0x96, 0x12, 0x8f, 0xc2, 0x28, 0x38, 0xa6, 0x6d, 0xb7, 0xf2, 0x63, 0x9a,
+	0xf3, 0x6c, 0x65, 0x5b, 0xd1, 0xed, 0x2b, 0x32, 0xd1, 0x75, 0x67, 0x30, 0xfd, 0x5f, 0x69, 0xd0,
+	0x9d, 0x25, 0xc3, 0x33, 0x15, 0x46, 0xd2, 0x71, 0xc7, 0x76, 0x92, 0x32, 0x3b, 0x47, 0x20, 0xef,
+	0x68, 0x1a, 0xc5, 0x6b, 0x06, 0x10, 0x1b, 0x9e, 0xd8, 0xb1, 0x54, 0x8c, 0x66, 0x00, 0x33, 0xbb,
+	0x38, 0xb1, 0xa3, 0x42, 0x00, 0xd6, 0x20, 0x78, 0x48, 0x19, 0xe1, 0xa9, 0xbc, 0x88, 0x47, 0x51,
+	0x1a, 0x06, 0x55, 0x45, 0x13, 0x11, 0x02, 0x43, 0x9e, 0xb7, 0x60, 0x81, 0x3a, 0xbf, 0x8e, 0xdc,
+	0x24, 0x91, 0xbe, 0x3a, 0xd8, 0x2d, 0xc4, 0x7d, 0xc9, 0x28, 0xfc, 0x60, 0xec, 0xa2, 0x36, 0x30,
+	0xdf, 0x19, 0xb0, 0x7e, 0x47, 0x87, 0x3a, 0xbb, 0x8b, 0x52, 0x0c, 0xa7, 0x7d, 0xa7, 0x18, 0xae,
+	0xb4, 0x6f, 0x7d, 0xce, 0xbe, 0x29, 0x9c, 0xa1, 0x1d, 0x36, 0x05, 0x03, 0xb4, 0x8c, 0xd0, 0x2e,
+	0x2c, 0x03, 0x01, 0xd4, 0x17, 0x36, 0x2e, 0x64, 0x54, 0x9a, 0x42, 0x41, 0xe6, 0xc7, 0x60, 0x50,
+	0xb0, 0x4c, 0x71, 0x98, 0x41, 0xf1, 0xd3, 0xcd, 0xe7, 0xcf, 0x16, 0x4d, 0x44, 0xce, 0x04, 0x60,
+	0xcd, 0x14, 0x87, 0xe1, 0x22, 0x0e, 0x46, 0x1e, 0x02, 0xc5, 0x7e, 0x14, 0x2e, 0x22, 0x6a, 0x18,
+	0x17, 0xc3, 0x45, 0xc6, 0x58, 0xff, 0xa2, 0xc3, 0xc2, 0xa6, 0x1b, 0xc9, 0x71, 0x22, 0x9d, 0x81,
+	0x73, 0x4c, 0x8b, 0x91, 0x7e, 0xe2, 0x26, 0x17, 0x2a, 0xc0, 0x55, 0x50, 0x96, 0xad, 0xe8, 0xe5,
+	0xec, 0x9d, 0xed, 0x43, 0x85, 0x0a, 0x0e, 0x0c, 0x98, 0xab, 0x00, 0xd4, 0xe0, 0xa2, 0x43, 0xf5,
+	0xf2, 0xa2, 0x83, 0x41, 0x64, 0xd8, 0x44, 0xd1, 0xf3, 0x18, 0x97, 0xc5, 0x5b, 0xa7, 0x8a, 0xc4,
+	0x14, 0xed, 0x39, 0xa5, 0x3f, 0x87, 0xd2, 0x23, 0xb1, 0x52, 0xfa, 0x73, 0x28, 0xbd, 0x2c, 0xe9,
+	0x6c, 0xf0, 0x72, 0xb0, 0x6d, 0xbe, 0x0d, 0x7a, 0x10, 0xf6, 0x9a, 0xf9, 0x07, 0x8b, 0x1b, 0x5b,
+	0xd9, 0x0b, 0x85, 0x1e, 0x84, 0x68, 0x99, 0x38, 0xc3, 0xa6, 0xc3, 0x84, 0x96, 0x09, 0x1d, 0x37,
+	0xe5, 0x7b, 0x42, 0xf5, 0x98, 0x16, 0x2c, 0xd8, 0x9e, 0x17, 0x7c, 0x2d, 0x9d, 0xfd, 0x48, 0x3a,
+	0xe9, 0xb9, 0x2a, 0xe1, 0xac, 0x9b, 0xa0, 0xef, 0x85, 0x66, 0x03, 0x2a, 0x07, 0x83, 0x61, 0xf7,
+	0x0a, 0x36, 0x36, 0x07, 0x3b, 0x5d, 0xcd, 0xfa, 0x56, 0x07, 0xe3, 0xf1, 0x34, 0xb1, 0xd1, 0x16,
+	0xc6, 0xb8, 0xaf, 0xb2, 0x5a, 0xe5, 0xfa, 0x53, 0xd4, 0x76, 0xbd, 0xac, 0xed, 0xef, 0x42, 0x4d,
+	0x3a, 0xc7, 0x32, 0x75, 0x8c, 0xdd, 0xd9, 0xbd, 0x08, 0xee, 0x36, 0x97, 0xa1, 0x1e, 0x8f, 0x4f,
+	0xe4, 0xc4, 0xee, 0x55, 0x73, 0xc2, 0x03, 0xc2, 0xa8, 0x88, 0x40, 0xf5, 0x9b, 0xef, 0x40, 0x0d,
+	0xa5, 0x11, 0xf7, 0xea, 0x79, 0x8e, 0x8b, 0x8c, 0x57, 0x64, 0xdc, 0x89, 0xba, 0xe3, 0x44, 0x41,
+	0x38, 0x0a, 0x42, 0xe2, 0x6b, 0x67, 0xf5, 0x06, 0xd9, 0xe4, 0x74, 0x37, 0x2b, 0x9b, 0x51, 0x10,
+	0xee, 0x85, 0xa2, 0xee, 0xd0, 0x2f, 0xfa, 0x28, 0x22, 0x67, 0x1d, 0x60, 0x87, 0x68, 0x20, 0x86,
+	0x8b, 0x51, 0xcb, 0xd0, 0x9c, 0xc8, 0xc4, 0x76, 0xec, 0xc4, 0x56, 0x7e, 0x91, 0xbc, 0xdd, 0x63,
+	0x85, 0x13, 0x59, 0xaf, 0x75, 0x0f, 0xea, 0x3c, 0xb5, 0xd9, 0x84, 0xea, 0xee, 0xde, 0xee, 0x80,
+	0x19, 0xba, 0xb6, 0xb3, 0xd3, 0xd5, 0x10, 0xb5, 0xb9, 0x36, 0x5c, 0xeb, 0xea, 0xd8, 0x1a, 0xfe,
+	0x6c, 0x7f, 0xd0, 0xad, 0x58, 0xff, 0xa4, 0x41, 0x33, 0x9d, 0xc7, 0xfc, 0x0c, 0x00, 0xcf, 0xdd,
+	0xe8, 0xc4, 0xf5, 0xb3, 0x58, 0xf3, 0x8d, 0xe2, 0x97, 0x56, 0x50, 0x62, 0x0f, 0xb1, 0x97, 0x03,
+	0x09, 0x23, 0x4c, 0xe1, 0xfe, 0x01, 0x74, 0xca, 0x9d, 0x73, 0x82, 0xee, 0x3b, 0x45, 0x2f, 0xd8,
+	0x59, 0x7d, 0xad, 0x34, 0x35, 0x8e, 0x24, 0x65, 0x2e, 0x38, 0xc4, 0xbb, 0xd0, 0x4c, 0xd1, 0x66,
+	0x0b, 0x1a, 0x9b, 0x83, 0xad, 0xb5, 0x27, 0x3b, 0xa8, 0x24, 0x00, 0xf5, 0x83, 0xed, 0xdd, 0x07,
+	0x3b, 0x03, 0xde, 0xd6, 0xce, 0xf6, 0xc1, 0xb0, 0xab, 0x5b, 0x7f, 0xa2, 0x41, 0x33, 0x8d, 0xd6,
+	0xcc, 0xf7, 0x31, 0xcc, 0xa2, 0x08, 0xb2, 0xa7, 0xe5, 0x35, 0xa5, 0x42, 0x8e, 0x2b, 0xd2, 0xfe,
+	0xb2, 0x69, 0xad, 0xa6, 0xa6, 0xb5, 0x90, 0x61, 0x57, 0x4a, 0x25, 0x21, 0x2c, 0x2d, 0x04, 0xbe,
+	0x54, 0xb1, 0x3b, 0xb5, 0x49, 0x07, 0xd1, 0x12, 0x22, 0x75, 0x4d, 0xe9, 0x20, 0xc2, 0xc3, 0xd8,
+	0xfa, 0xcb, 0x2a, 0x74, 0x84, 0xc4, 0x78, 0x43, 0x0a, 0xf9, 0xd5, 0x54, 0xc6, 0xc9, 0xcb, 0x94,
+	0xf9, 0x4d, 0x80, 0x88, 0x89, 0x73, 0x75, 0x36, 0x14, 0x86, 0xb3, 0x27, 0x2f, 0x18, 0x93, 0x16,
+	0x29, 0x93, 0x9f, 0xc1, 0x68, 0xda, 0x0f, 0xed, 0xf1, 0x29, 0x4f, 0xcb, 0x1e, 0xb6, 0xc9, 0x08,
+	0x9e, 0xd7, 0x1e, 0x8f, 0x65, 0x1c, 0x8f, 0x50, 0x28, 0xec, 0x67, 0x0d, 0xc6, 0x3c, 0x92, 0x17,
+	0xd8, 0x1d, 0xcb, 0x71, 0x24, 0x13, 0xea, 0x66, 0x03, 0x61, 0x30, 0x06, 0xbb, 0xdf, 0x86, 0x76,
+	0x2c, 0x63, 0xf4, 0xc9, 0xa3, 0x24, 0x38, 0x95, 0xbe, 0xb2, 0x16, 0x0b, 0x0a, 0x39, 0x44, 0x1c,
+	0xda, 0x71, 0xdb, 0x0f, 0xfc, 0x8b, 0x49, 0x30, 0x8d, 0x95, 0x01, 0xce, 0x11, 0xe6, 0x0a, 0x5c,
+	0x97, 0xfe, 0x38, 0xba, 0x08, 0x71, 0xad, 0xf8, 0x15, 0xac, 0xde, 0x49, 0x15, 0xbf, 0x5f, 0xcb,
+	0xbb, 0x1e, 0xc9, 0x8b, 0x2d, 0xd7, 0x93, 0xb8, 0xa2, 0x33, 0x7b, 0xea, 0x25, 0x23, 0xca, 0xef,
+	0x81, 0x57, 0x44, 0x98, 0x35, 0x4c, 0xf2, 0x3f, 0x80, 0x6b, 0xdc, 0x1d, 0x05, 0x9e, 0x74, 0x1d,
+	0x9e, 0xac, 0x45, 0x54, 0x57, 0xa9, 0x43, 0x10, 0x9e, 0xa6, 0x5a, 0x81, 0xeb, 0x4c, 0xcb, 0x1b,
+	0x4a, 0xa9, 0x17, 0xf8, 0xd3, 0xd4, 0x75, 0xa0, 0x7a, 0xca, 0x9f, 0x0e, 0xed, 0xe4, 0xa4, 0xd7,
+	0x2e, 0x7c, 0x7a, 0xdf, 0x4e, 0x4e, 0x30, 0x56, 0xe0, 0xee, 0x23, 0x57, 0x7a, 0x9c, 0x8f, 0x1b,
+	0x82, 0x47, 0x6c, 0x21, 0x06, 0xdd, 0xa8, 0x22, 0x08, 0xa2, 0x89, 0xcd, 0x45, 0x42, 0x43, 0xf0,
+	0xa0, 0x2d, 0x42, 0xe1, 0x27, 0x94, 0xac, 0xfc, 0xe9, 0xa4, 0xd7, 0x65, 0x31, 0x33, 0x66, 0x77,
+	0x3a, 0xb1, 0xfe, 0x47, 0x87, 0x66, 0x96, 0x03, 0xde, 0x01, 0x63, 0x92, 0x5a, 0x0e, 0x15, 0xe2,
+	0xb5, 0x4b, 0xe6, 0x44, 0xe4, 0xfd, 0xe6, 0x9b, 0xa0, 0x9f, 0x9e, 0x29, 0x2b, 0xd6, 0x5e, 0xe1,
+	0xa2, 0x79, 0x78, 0xb8, 0xba, 0xf2, 0xe8, 0xa9, 0xd0, 0x4f, 0xcf, 0xf2, 0x50, 0xb1, 0xf6, 0xca,
+	0x50, 0xf1, 0x3d, 0xb8, 0x3a, 0xf6, 0xa4, 0xed, 0x8f, 0x72, 0xe7, 0xcc, 0x7a, 0xd1, 0x21, 0xf4,
+	0x7e, 0x8a, 0x4d, 0x0f, 0x7a, 0x23, 0x3f, 0xe8, 0xb7, 0xa1, 0xe6, 0x48, 0x2f, 0xb1, 0x8b, 0xd5,
+	0xdc, 0xbd, 0xc8, 0x1e, 0x7b, 0x72, 0x13, 0xd1, 0x82, 0x7b, 0xd1, 0xae, 0xa5, 0x79, 0x6a, 0xd1,
+	0xae, 0xa5, 0x47, 0x58, 0x64, 0xbd, 0xf9, 0x09, 0x85, 0xe2, 0x09, 0xbd, 0x03, 0xd7, 0xe4, 0x79,
+	0x48, 0xc6, 0x7c, 0x94, 0xd5, 0x14, 0x38, 0xc2, 0xef, 0xa6, 0x1d, 0x1b, 0x0a, 0x6f, 0x7e, 0x08,
+	0x0d, 0x75, 0x8c, 0x48, 0xf0, 0xad, 0x55, 0x93, 0xec, 0x41, 0xe9, 0x60, 0x8a, 0x94, 0xc4, 0xf2,
+	0xa1, 0xf2, 0xe8, 0xe9, 0x81, 0xe2, 0xa6, 0x76, 0x19, 0x37, 0x53, 0x4b, 0xa0, 0x17, 0x2c, 0xc1,
+	0x2d, 0x36, 0xa2, 0xc4, 0x9a, 0xb4, 0xd2, 0x58, 0xc0, 0xe0, 0x56, 0xd8, 0x81, 0x54, 0xa9, 0x8b,
+	0x01, 0xeb, 0xf7, 0xaa, 0xd0, 0x50, 0x5e, 0x1d, 0xf9, 0x39, 0xcd, 0xca, 0x62, 0xd8, 0x2c, 0x27,
+	0x98, 0x59, 0x78, 0x50, 0xbc, 0x91, 0xa8, 0xbc, 0xfa, 0x46, 0xc2, 0xfc, 0x0c, 0x16, 0x42, 0xee,
+	0x2b, 0x06, 0x14, 0xaf, 0x17, 0xc7, 0xa8, 0x5f, 0x1a, 0xd7, 0x0a, 0x73, 0x00, 0x2d, 0x16, 0x95,
+	0x6b, 0x13, 0xfb, 0x98, 0x54, 0x67, 0x41, 0x34, 0x10, 0x1e, 0xda, 0xc7, 0x97, 0x84, 0x15, 0xdf,
+	0x25, 0x3a, 0xe8, 0x50, 0x98, 0xb1, 0x40, 0x06, 0x10, 0x23, 0x8a, 0xa2, 0x23, 0x6f, 0xbf, 0x10,
+	0xb6, 0x8e, 0x83, 0xc9, 0xc4, 0xa5, 0xbe, 0x8e, 0x2a, 0x1b, 0x11, 0x62, 0x18, 0x5b, 0x7f, 0xac,
+	0x41, 0x43, 0xed, 0xf6, 0x05, 0x37, 0xb1, 0xbe, 0xbd, 0xbb, 0x26, 0x7e, 0xd6, 0xd5, 0xd0, 0x0d,
+	0x6e, 0xef, 0x0e, 0xbb, 0xba, 0x69, 0x40, 0x6d, 0x6b, 0x67, 0x6f, 0x6d, 0xd8, 0xad, 0xa0, 0xeb,
+	0x58, 0xdf, 0xdb, 0xdb, 0xe9, 0x56, 0xcd, 0x05, 0x68, 0x6e, 0xae, 0x0d, 0x07, 0xc3, 0xed, 0xc7,
+	0x83, 0x6e, 0x0d, 0x69, 0x1f, 0x0c, 0xf6, 0xba, 0x75, 0x6c, 0x3c, 0xd9, 0xde, 0xec, 0x36, 0xb0,
+	0x7f, 0x7f, 0xed, 0xe0, 0xe0, 0xcb, 0x3d, 0xb1, 0xd9, 0x6d, 0x92, 0xfb, 0x19, 0x8a, 0xed, 0xdd,
+	0x07, 0x5d, 0x03, 0xdb, 0x7b, 0xeb, 0x9f, 0x0f, 0x36, 0x86, 0x5d, 0xc0, 0xf6, 0x53, 0x9e, 0xbb,
+	0x65, 0x7d, 0x04, 0xad, 0x02, 0x37, 0x71, 0x26, 0x31, 0xd8, 0xea, 0x5e, 0xc1, 0xcf, 0x3f, 0x5d,
+	0xdb, 0x79, 0x82, 0x9e, 0xab, 0x03, 0x40, 0xcd, 0xd1, 0xce, 0xda, 0xee, 0x83, 0xae, 0x6e, 0x7d,
+	0x01, 0xcd, 0x27, 0xae, 0xb3, 0xee, 0x05, 0xe3, 0x53, 0x54, 0xad, 0x43, 0x8c, 0xeb, 0x39, 0x89,
+	0xa4, 0x36, 0x46, 0x94, 0x74, 0x70, 0x62, 0xa5, 0x07, 0x0a, 0x42, 0xbe, 0xf9, 0xd3, 0xc9, 0x88,
+	0x6e, 0xb4, 0x2a, 0xec, 0x4e, 0xfc, 0xe9, 0xe4, 0x09, 0x5e, 0x6a, 0x79, 0xd0, 0x78, 0xe2, 0x3a,
+	0xfb, 0xf6, 0xf8, 0x94, 0x4c, 0x0e, 0x4e, 0x3d, 0x8a, 0xdd, 0x6f, 0xa4, 0x72, 0x3b, 0x06, 0x61,
+	0x0e, 0xdc, 0x6f, 0xa4, 0xf9, 0x0e, 0xd4, 0x09, 0x48, 0x8b, 0x0f, 0x74, 0x14, 0xd3, 0xe5, 0x08,
+	0xd5, 0x47, 0x36, 0xde, 0x23, 0x8f, 0x13, 0x44, 0xbd, 0xd7, 0xd9, 0x6c, 0x65, 0x08, 0xeb, 0x0f,
+	0xb5, 0x6c, 0xd3, 0x74, 0xa1, 0xb1, 0x08, 0xd5, 0xd0, 0x1e, 0x9f, 0xf6, 0xb4, 0x3c, 0x99, 0x57,
+	0xab, 0x11, 0xd4, 0x61, 0xbe, 0x07, 0x4d, 0xa5, 0x65, 0xe9, 0x67, 0x5b, 0x05, 0x75, 0x14, 0x59,
+	0x67, 0x59, 0xfe, 0x95, 0xb2, 0xfc, 0x29, 0xdd, 0x0c, 0x3d, 0x37, 0xe1, 0x33, 0x55, 0x15, 0x0a,
+	0xb2, 0x7e, 0x04, 0x90, 0xdf, 0x21, 0xcd, 0x89, 0x47, 0xb0, 0xe2, 0xee, 0xb9, 0x76, 0x9a, 0xbe,
+	0x32, 0x60, 0xed, 0x42, 0x2b, 0x1f, 0x45, 0xcc, 0xb5, 0x3d, 0x0f, 0x1d, 0x56, 0x4c, 0x63, 0x9b,
+	0xa2, 0x61, 0x7b, 0xde, 0x23, 0x79, 0x11, 0x63, 0x2c, 0xc8, 0x97, 0x56, 0xfa, 0xcc, 0x7d, 0x07,
+	0x0d, 0x15, 0xdc, 0x69, 0x7d, 0x08, 0xf5, 0xad, 0x34, 0x1a, 0x4e, 0xcf, 0x84, 0x76, 0xd9, 0x99,
+	0xb0, 0x3e, 0x05, 0xc8, 0xaf, 0x4c, 0xcc, 0x3b, 0xea, 0x72, 0x2c, 0xe6, 0xab, 0x38, 0x2d, 0x2f,
+	0xa6, 0x30, 0x91, 0xba, 0x17, 0x23, 0x62, 0x6b, 0x13, 0x9a, 0x2f, 0xbd, 0x6e, 0x54, 0x0c, 0xd0,
+	0x73, 0x06, 0xcc, 0xb9, 0x80, 0xb4, 0x7e, 0x01, 0x90, 0x5f, 0xa2, 0xa9, 0x23, 0xca, 0xb3, 0xe0,
+	0x11, 0xfd, 0x00, 0xab, 0xb7, 0xae, 0xe7, 0x44, 0xd2, 0x2f, 0xed, 0x3a, 0x1b, 0x21, 0xb2, 0x7e,
+	0x73, 0x09, 0xaa, 0x74, 0x37, 0x58, 0xc9, 0x4d, 0x7b, 0xba, 0x3e, 0x41, 0x3d, 0xd6, 0x39, 0xb4,
+	0x39, 0xc8, 0xfe, 0x0e, 0x81, 0x51, 0xd9, 0xae, 0xea, 0x2f, 0xd8, 0xd5, 0x9b, 0x50, 0x27, 0x7f,
+	0x9c, 0xee, 0x46, 0x41, 0x97, 0xd8, 0xdb, 0x3f, 0xd3, 0x01, 0xf8, 0xd3, 0x58, 0xae, 0x7d, 0x45,
+	0xea, 0x6d, 0x42, 0x35, 0xbb, 0xf6, 0x35, 0x04, 0xb5, 0x73, 0x8f, 0xa4, 0xd2, 0x52, 0x02, 0x70,
+	0x1e, 0x8a, 0x8f, 0xdc, 0x6f, 0x64, 0xa4, 0x3e, 0x98, 0x23, 0x8a, 0x97, 0xa0, 0xb5, 0xf2, 0x25,
+	0x68, 0x76, 0x53, 0xc4, 0x97, 0x3c, 0x0c, 0xcc, 0xbb, 0xf4, 0xe2, 0x92, 0x48, 0x2c, 0xa3, 0x24,
+	0x4d, 0x71, 0x19, 0xca, 0xd2, 0x38, 0x43, 0xd1, 0xda, 0x5c, 0xd4, 0xf0, 0xf1, 0x82, 0xd7, 0x3f,
+	0xf2, 0xdc, 0x71, 0xa2, 0x2e, 0x3d, 0xc1, 0x0f, 0x36, 0x14, 0x86, 0x26, 0xf3, 0xdd, 0xaf, 0xa6,
+	0x1c, 0x39, 0x35, 0x85, 0x82, 0xac, 0xcf, 0x60, 0x21, 0x95, 0x0b, 0xdd, 0x22, 0x7d, 0x90, 0xa5,
+	0x47, 0x5a, 0x2e, 0xf3, 0x9c, 0x7d, 0xeb, 0x7a, 0x4f, 0x4b, 0x13, 0x24, 0xeb, 0x0f, 0xaa, 0xe9,
+	0x60, 0x75, 0x19, 0xf2, 0x72, 0xde, 0x96, 0x73, 0x5c, 0xfd, 0x3b, 0xe5, 0xb8, 0x3f, 0x06, 0xc3,
+	0xa1, 0x24, 0xce, 0x3d, 0x4b, 0x3d, 0x5f, 0x7f, 0x36, 0x61, 0x53, 0x69, 0x9e, 0x7b, 0x26, 0x45,
+	0x4e, 0xfc, 0x0a, 0xf9, 0x64, 0x52, 0xa8, 0xcd, 0x93, 0x42, 0xfd, 0xd7, 0x94, 0xc2, 0x5b, 0xb0,
+	0xe0, 0x07, 0xfe, 0xc8, 0x9f, 0x7a, 0x1e, 0xdd, 0xe3, 0xb1, 0x18, 0x5a, 0x7e, 0xe0, 0xef, 0x2a,
+	0x14, 0x06, 0xb3, 0x45, 0x12, 0x3e, 0xec, 0x2c, 0x92, 0xab, 0x05, 0x3a, 0x32, 0x09, 0xcb, 0xd0,
+	0x0d, 0x0e, 0x7f, 0x81, 0xf7, 0xb1, 0xc8, 0xb1, 0x11, 0x9d, 0x72, 0x8e, 0x64, 0x3b, 0x8c, 0x47,
+	0x16, 0xed, 0xe2, 0x79, 0x9f, 0x11, 0x7f, 0xfb, 0x25, 0xe2, 0xef, 0x94, 0xc4, 0xff, 0x29, 0x18,
+	0x19, 0xf7, 0x0a, 0x89, 0xa4, 0x01, 0xb5, 0xed, 0xdd, 0xcd, 0xc1, 0x4f, 0xbb, 0x1a, 0x7a, 0x59,
+	0x31, 0x78, 0x3a, 0x10, 0x07, 0x83, 0xae, 0x8e, 0x5e, 0x6f, 0x73, 0xb0, 0x33, 0x18, 0x0e, 0xba,
+	0x95, 0xcf, 0xab, 0xcd, 0x46, 0xb7, 0x49, 0x57, 0x1d, 0x9e, 0x3b, 0x76, 0x13, 0xeb, 0xef, 0x34,
+	0x80, 0x3c, 0x3d, 0x46, 0x33, 0x9e, 0xaf, 0x5a, 0xd5, 0x13, 0x93, 0x74, 0xbd, 0xcb, 0xd9, 0x09,
+	0xd6, 0x2f, 0x4b, 0xc2, 0xd5, 0x99, 0xfe, 0x04, 0x1a, 0x74, 0xde, 0xb2, 0xc4, 0xfe, 0xcd, 0x72,
+	0x1a, 0xbe, 0xb2, 0x11, 0x4c, 0xc2, 0x20, 0x76, 0x13, 0x49, 0x45, 0x36, 0x91, 0x52, 0xf7, 0xef,
+	0x43, 0xa7, 0xdc, 0x35, 0x63, 0x56, 0xb4, 0x59, 0xb3, 0x82, 0x77, 0xfa, 0x8f, 0xed, 0xf0, 0x21,
+	0xdf, 0x20, 0xde, 0x86, 0x4e, 0x68, 0x47, 0x89, 0x9b, 0xa6, 0x30, 0x3c, 0x60, 0x41, 0xb4, 0x33,
+	0x2c, 0xfa, 0x05, 0xeb, 0xaf, 0x34, 0xb8, 0xf1, 0x38, 0x38, 0x93, 0x59, 0x88, 0xbc, 0x6f, 0x5f,
+	0x78, 0x81, 0xed, 0xbc, 0xe2, 0x28, 0x60, 0x0e, 0x16, 0x4c, 0xe9, 0xae, 0x2f, 0xbd, 0xff, 0x14,
+	0x06, 0x63, 0x1e, 0xa8, 0xe7, 0x1a, 0x32, 0x4e, 0xa8, 0x53, 0x79, 0x79, 0x84, 0xb1, 0xeb, 0x35,
+	0xa8, 0x27, 0xe7, 0x7e, 0x5e, 0xed, 0xab, 0x25, 0x54, 0xa4, 0x9f, 0x1b, 0x1f, 0xd7, 0xe6, 0xc7,
+	0xc7, 0xd6, 0x06, 0x18, 0xc3, 0x73, 0x2a, 0x3a, 0x4f, 0xe3, 0x52, 0x24, 0xa6, 0xbd, 0x24, 0x12,
+	0xd3, 0x67, 0x22, 0xb1, 0xff, 0xd4, 0xa0, 0x55, 0x08, 0xf4, 0xcd, 0xb7, 0xa0, 0x9a, 0x9c, 0xfb,
+	0xe5, 0x27, 0x10, 0xe9, 0x47, 0x04, 0x75, 0xe1, 0xf1, 0xc0, 0x8a, 0xb4, 0x1d, 0xc7, 0xee, 0xb1,
+	0x2f, 0x1d, 0x35, 0x25, 0x56, 0xa9, 0xd7, 0x14, 0xca, 0xdc, 0x81, 0xab, 0xec, 0x15, 0xd2, 0x4d,
+	0xa4, 0x62, 0x7f, 0x7b, 0x26, 0xb1, 0xe0, 0xc2, 0x7c, 0xba, 0x25, 0x55, 0xa4, 0xe8, 0x1c, 0x97,
+	0x90, 0xfd, 0x35, 0xb8, 0x3e, 0x87, 0xec, 0x7b, 0x5d, 0xeb, 0x2c, 0x42, 0x1b, 0xaf, 0x41, 0xdc,
+	0x89, 0x8c, 0x13, 0x7b, 0x12, 0x52, 0x24, 0xab, 0xbc, 0x7a, 0x55, 0xe8, 0x49, 0x6c, 0xbd, 0x0b,
+	0x0b, 0xfb, 0x52, 0x46, 0x42, 0xc6, 0x61, 0xe0, 0x73, 0xe4, 0xa6, 0x0a, 0xe2, 0x5a, 0x76, 0x8b,
+	0x9f, 0x4c, 0x63, 0xeb, 0xb7, 0xc1, 0xc0, 0x8a, 0xc4, 0xba, 0x9d, 0x8c, 0x4f, 0xbe, 0x4f, 0xc5,
+	0xe2, 0x5d, 0x68, 0x84, 0xac, 0x53, 0x2a, 0x21, 0x5c, 0xa0, 0x50, 0x42, 0xe9, 0x99, 0x48, 0x3b,
+	0xad, 0x8f, 0xe0, 0xfa, 0xc1, 0xf4, 0x30, 0x1e, 0x47, 0x2e, 0xe5, 0xd6, 0xa9, 0x9b, 0xed, 0x43,
+	0x33, 0x8c, 0xe4, 0x91, 0x7b, 0x2e, 0x53, 0x0d, 0xce, 0x60, 0xeb, 0x27, 0x70, 0xa3, 0x3c, 0x44,
+	0x6d, 0xe1, 0x6d, 0xa8, 0x9c, 0x9e, 0xc5, 0x6a, 0x65, 0xd7, 0x4a, 0xb9, 0x10, 0xbd, 0x25, 0xc0,
+	0x5e, 0x4b, 0x40, 0x65, 0x77, 0x3a, 0x29, 0xbe, 0x9e, 0xaa, 0xf2, 0xeb, 0xa9, 0x37, 0x8a, 0x15,
+	0x58, 0x4e, 0x97, 0xf2, 0x4a, 0xeb, 0x0f, 0xc1, 0x38, 0x0a, 0xa2, 0xaf, 0xed, 0xc8, 0x91, 0x8e,
+	0xf2, 0xa7, 0x39, 0xc2, 0xfa, 0x39, 0xb4, 0x52, 0x4d, 0xd8, 0x76, 0xe8, 0xf2, 0x94, 0x54, 0x71,
+	0xdb, 0x29, 0x69, 0x26, 0xd7, 0x37, 0xa5, 0xef, 0x6c, 0xa7, 0x2a, 0xc4, 0x40, 0xf9, 0xcb, 0xaa,
+	0xe0, 0x9d, 0x7e, 0xd9, 0xda, 0x82, 0x85, 0x34, 0xdb, 0xc4, 0x42, 0x14, 0x29, 0xb7, 0xe7, 0x4a,
+	0xbf, 0xa0, 0xf8, 0x4d, 0x46, 0x0c, 0xcb, 0x25, 0x48, 0xbd, 0x14, 0x9c, 0x58, 0x2b, 0x50, 0x57,
+	0x27, 0xc7, 0x84, 0xea, 0x38, 0x70, 0xf8, 0x74, 0xd7, 0x04, 0xb5, 0x91, 0x1d, 0x93, 0xf8, 0x38,
+	0x0d, 0xbc, 0x26, 0xf1, 0xb1, 0xf5, 0xb7, 0x3a, 0xb4, 0xd7, 0x29, 0xdb, 0x4f, 0x45, 0x52, 0xa8,
+	0x36, 0x69, 0xa5, 0x6a, 0x53, 0xb1, 0xb2, 0xa4, 0x97, 0x2a, 0x4b, 0xa5, 0x05, 0x55, 0xca, 0xd1,
+	0xd2, 0xeb, 0xd0, 0x98, 0xfa, 0xee, 0x79, 0x6a, 0x12, 0x0c, 0xb2, 0xed, 0xe7, 0xc3, 0xd8, 0x5c,
+	0x82, 0x16, 0x5a, 0x0d, 0xd7, 0xe7, 0x1a, 0x12, 0x17, 0x82, 0x8a, 0xa8, 0x99, 0x4a, 0x51, 0xfd,
+	0xe5, 0x95, 0xa2, 0xc6, 0x2b, 0x2b, 0x45, 0xcd, 0x57, 0x55, 0x8a, 0x8c, 0xd9, 0x4a, 0x51, 0xd9,
+	0x24, 0xc3, 0x0b, 0x26, 0xf9, 0x4f, 0x75, 0x68, 0x0f, 0xce, 0x43, 0x7a, 0xe4, 0xf2, 0xca, 0xb0,
+	0xb1, 0xc0, 0x57, 0xbd, 0xc4, 0xd7, 0x02, 0x87, 0x2a, 0xea, 0x72, 0x89, 0x39, 0x84, 0x81, 0x24,
+	0xd7, 0x6d, 0x14, 0xe7, 0x18, 0xfa, 0x3f, 0xc0, 0x39, 0x6b, 0x07, 0x3a, 0x29, 0x63, 0xd4, 0xa9,
+	0xfd, 0x4e, 0xea, 0xc8, 0xcf, 0xd9, 0xbc, 0xac, 0x5c, 0xc1, 0x80, 0xf5, 0x47, 0x3a, 0x18, 0xac,
+	0xa4, 0xb8, 0xbc, 0xf7, 0x55, 0x10, 0xac, 0xe5, 0xb5, 0xdb, 0xac, 0x73, 0xe5, 0x91, 0xbc, 0xa0,
+	0x20, 0x8d, 0x48, 0xe6, 0xde, 0x70, 0xa8, 0xa2, 0x06, 0xa7, 0x6e, 0xd8, 0xc4, 0xb3, 0xc6, 0x3e,
+	0x66, 0xea, 0xa6, 0xb7, 0xcf, 0xec, 0x74, 0xf0, 0x6d, 0x22, 0x86, 0xdc, 0x32, 0x9a, 0x28, 0x2e,
+	0x53, 0xbb, 0x1c, 0x24, 0xb7, 0x55, 0x78, 0x66, 0x9d, 0x40, 0x43, 0x7d, 0x1d, 0xa3, 0x92, 0x27,
+	0xbb, 0x8f, 0x76, 0xf7, 0xbe, 0xdc, 0xed, 0x5e, 0xc9, 0xaa, 0xdd, 0x5a, 0x1e, 0xb7, 0xe8, 0xc5,
+	0xb8, 0xa5, 0x82, 0xf8, 0x8d, 0xbd, 0x27, 0xbb, 0xc3, 0x6e, 0xd5, 0x6c, 0x83, 0x41, 0xcd, 0x91,
+	0x18, 0x3c, 0xed, 0xd6, 0x28, 0xbf, 0xdf, 0x78, 0x38, 0x78, 0xbc, 0xd6, 0xad, 0x67, 0xb5, 0xf2,
+	0x86, 0xf5, 0xfb, 0x1a, 0x5c, 0xe3, 0x2d, 0x17, 0x73, 0xdc, 0xe2, 0x53, 0xd2, 0x2a, 0x3f, 0x25,
+	0xfd, 0x0d, 0xa7, 0xb5, 0xff, 0xa0, 0x41, 0x9f, 0xe3, 0x9c, 0x07, 0xf8, 0x38, 0xf6, 0x8b, 0x9d,
+	0x17, 0x72, 0xa8, 0xcb, 0x7c, 0xf7, 0x6d, 0xe8, 0xd0, 0x7b, 0xda, 0xaf, 0xbc, 0x91, 0x8a, 0xe7,
+	0x59, 0x44, 0x6d, 0x85, 0xe5, 0x89, 0xcc, 0x8f, 0x61, 0x81, 0xdf, 0xdd, 0x52, 0xe9, 0xaf, 0x74,
+	0x79, 0x52, 0x0a, 0xc7, 0x5a, 0x4c, 0x45, 0xd7, 0x38, 0xf8, 0x06, 0x50, 0x0d, 0xca, 0xd3, 0xad,
+	0x17, 0xef, 0x47, 0xd4, 0x90, 0x21, 0x25, 0x61, 0xf7, 0xe0, 0x8d, 0xb9, 0xfb, 0x50, 0xba, 0x5b,
+	0xa8, 0x83, 0xb1, 0xca, 0x58, 0x9f, 0x40, 0x33, 0xbd, 0xe2, 0x47, 0xd6, 0xd1, 0xf9, 0xf5, 0x6d,
+	0x3f, 0x20, 0x9a, 0x8a, 0x68, 0x22, 0x62, 0xd7, 0xf6, 0x03, 0xe5, 0x8f, 0xf9, 0xc0, 0xa3, 0x3f,
+	0x7e, 0x04, 0xed, 0xd2, 0x03, 0x0f, 0xac, 0x76, 0xf0, 0xfb, 0x81, 0x9e, 0x96, 0x67, 0xa7, 0xe9,
+	0xdc, 0x42, 0xf5, 0xe5, 0x97, 0x9d, 0x7a, 0xe1, 0xb2, 0x73, 0xf5, 0xef, 0x35, 0xa8, 0xa2, 0x57,
+	0x36, 0xef, 0x82, 0xf1, 0x50, 0xda, 0x51, 0x72, 0x28, 0xed, 0xc4, 0x2c, 0x79, 0xe0, 0x7e, 0xa7,
+	0x7c, 0x27, 0x6c, 0x5d, 0xb9, 0xaf, 0x99, 0x2b, 0xfc, 0xc4, 0x2e, 0x7d, 0x67, 0xd8, 0x4e, 0xbd,
+	0x3b, 0x79, 0xff, 0x7e, 0x69, 0xbc, 0x75, 0x65, 0x99, 0xe8, 0x3f, 0x0f, 0x5c, 0x7f, 0x83, 0x5f,
+	0x84, 0x99, 0xb3, 0xd1, 0xc0, 0xec, 0x08, 0xf3, 0x2e, 0xd4, 0xb7, 0xe3, 0x7d, 0x39, 0x8f, 0x94,
+	0x64, 0x57, 0x8c, 0x48, 0xac, 0x2b, 0xab, 0xff, 0x56, 0x81, 0x2a, 0x3e, 0x5a, 0xc0, 0xca, 0xa8,
+	0x7a, 0x75, 0x60, 0x16, 0x5e, 0x17, 0xf4, 0x29, 0x0b, 0x9b, 0x79, 0x8e, 0x40, 0x5f, 0xe9, 0x32,
+	0x0f, 0xf3, 0xb2, 0xb1, 0x99, 0x3f, 0x8a, 0x78, 0x61, 0x51, 0x9f, 0x42, 0xf7, 0x20, 0x89, 0xa4,
+	0x3d, 0x29, 0x90, 0x97, 0x59, 0x35, 0xaf, 0x06, 0x4d, 0xfc, 0xba, 0x03, 0x75, 0x8e, 0xed, 0x66,
+	0x06, 0xcc, 0x96, 0x93, 0x89, 0xf8, 0x3d, 0x68, 0x1d, 0x9c, 0x04, 0x53, 0xcf, 0x39, 0x90, 0xd1,
+	0x99, 0x34, 0x0b, 0x0f, 0x98, 0xfa, 0x85, 0xb6, 0x75, 0xc5, 0x5c, 0x06, 0xe0, 0x70, 0x02, 0xeb,
+	0x63, 0x66, 0x03, 0xfb, 0x76, 0xa7, 0x13, 0x9e, 0xb4, 0x10, 0x67, 0x30, 0x65, 0x21, 0xc4, 0x7b,
+	0x19, 0xe5, 0xc7, 0xd0, 0xde, 0xa0, 0x53, 0xbb, 0x17, 0xad, 0x1d, 0x06, 0x51, 0x62, 0xce, 0x3e,
+	0x62, 0xea, 0xcf, 0x22, 0xac, 0x2b, 0x78, 0x51, 0x3e, 0x8c, 0x2e, 0x98, 0xfe, 0x9a, 0x8a, 0x8c,
+	0xf3, 0xef, 0xcd, 0xd9, 0xa5, 0xf9, 0x2e, 0xd4, 0xd7, 0xe2, 0xbd, 0xa3, 0x61, 0x6c, 0x96, 0xd4,
+	0xb5, 0x5f, 0x82, 0xac, 0x2b, 0xab, 0xff, 0x5d, 0x85, 0xfa, 0x97, 0x41, 0x74, 0x2a, 0xf1, 0x9a,
+	0xa4, 0x4e, 0xd7, 0x04, 0x4a, 0xdd, 0xb2, 0x2b, 0x83, 0x79, 0x0b, 0x7a, 0x07, 0x0c, 0x62, 0x1e,
+	0x3e, 0x52, 0x66, 0x91, 0xd2, 0x73, 0x73, 0xe6, 0x1f, 0x57, 0x02, 0x48, 0xfe, 0x1d, 0x16, 0x68,
+	0x76, 0xd3, 0x56, 0x2a, 0xda, 0xf7, 0x89, 0x4f, 0x8f, 0x9e, 0x1e, 0xa0, 0x0a, 0xdf, 0xd7, 0xd0,
+	0x6d, 0x1c, 0x30, 0x47, 0x90, 0x28, 0x7f, 0x66, 0xdb, 0xef, 0xa4, 0x88, 0x6c, 0xe6, 0x7b, 0x50,
+	0x57, 0x06, 0xe8, 0x5a, 0x6e, 0x6a, 0x94, 0x55, 0xeb, 0x77, 0x8b, 0x28, 0x35, 0xe0, 0x7d, 0xa8,
+	0xb3, 0x3d, 0xe6, 0x01, 0xa5, 0x80, 0x8a, 0x57, 0xcd, 0x41, 0x99, 0x75, 0x05, 0x1f, 0x7d, 0xa9,
+	0x52, 0xbf, 0x39, 0xa7, 0xee, 0x3f, 0x43, 0xfc, 0x11, 0xd4, 0xd9, 0x8d, 0xf2, 0xbc, 0xa5, 0x58,
+	0xa3, 0x6f, 0x16, 0x51, 0xe9, 0x61, 0xc2, 0x53, 0x21, 0xe4, 0x58, 0xba, 0x85, 0xa4, 0xcf, 0x4c,
+	0x39, 0x31, 0xe7, 0x68, 0x7f, 0x0a, 0xed, 0x52, 0x82, 0x68, 0xf6, 0x48, 0x3a, 0x73, 0x72, 0xc6,
+	0x17, 0x0e, 0xd4, 0x4f, 0xc0, 0x50, 0xf1, 0xf9, 0xa1, 0x34, 0xa9, 0x78, 0x3f, 0x27, 0xc2, 0xef,
+	0xbf, 0x18, 0xa0, 0xd3, 0x29, 0xf9, 0x29, 0x5c, 0x9f, 0x63, 0x71, 0x4d, 0x7a, 0x30, 0x76, 0xb9,
+	0x4b, 0xe9, 0x2f, 0x5e, 0xda, 0x9f, 0x32, 0x60, 0xbd, 0xfb, 0x8f, 0xdf, 0xde, 0xd2, 0xfe, 0xf5,
+	0xdb, 0x5b, 0xda, 0xbf, 0x7f, 0x7b, 0x4b, 0xfb, 0xe5, 0x7f, 0xdc, 0xba, 0x72, 0x58, 0xa7, 0xbf,
+	0x5c, 0x7c, 0xfc, 0xbf, 0x03, 0x00, 0x68, 0xdc, 0xbd, 0x2a, 0xe8, 0x31, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5538,6 +5898,8 @@ type ZeroClient interface {
 	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
 	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
 	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
+	// Returns the ts to read at for a point-in-time query, given either a ts or a time.
+	AsOfTs(ctx context.Context, in *TsSample, opts ...grpc.CallOption) (*TsSample, error)
 }
 
 type zeroClient struct {
@@ -5675,6 +6037,15 @@ func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...gr
 	return out, nil
 }
 
+func (c *zeroClient) AsOfTs(ctx context.Context, in *TsSample, opts ...grpc.CallOption) (*TsSample, error) {
+	out := new(TsSample)
+	err := c.cc.Invoke(ctx, "/pb.Zero/AsOfTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ZeroServer is the server API for Zero service.
 type ZeroServer interface {
 	// These 3 endpoints are for handling membership.
@@ -5687,6 +6058,8 @@ type ZeroServer interface {
 	Timestamps(context.Context, *Num) (*AssignedIds, error)
 	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
 	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
+	// Returns the ts to read at for a point-in-time query, given either a ts or a time.
+	AsOfTs(context.Context, *TsSample) (*TsSample, error)
 }
 
 // UnimplementedZeroServer can be embedded to have forward compatible implementations.
@@ -5720,6 +6093,9 @@ func (*UnimplementedZeroServer) CommitOrAbort(ctx context.Context, req *api.TxnC
 func (*UnimplementedZeroServer) TryAbort(ctx context.Context, req *TxnTimestamps) (*OracleDelta, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TryAbort not implemented")
 }
+func (*UnimplementedZeroServer) AsOfTs(ctx context.Context, req *TsSample) (*TsSample, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AsOfTs not implemented")
+}
 
 func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
 	s.RegisterService(&_Zero_serviceDesc, srv)
@@ -5893,6 +6269,24 @@ func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zero_AsOfTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TsSample)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).AsOfTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/AsOfTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).AsOfTs(ctx, req.(*TsSample))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Zero_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.Zero",
 	HandlerType: (*ZeroServer)(nil),
@@ -5925,6 +6319,10 @@ var _Zero_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TryAbort",
 			Handler:    _Zero_TryAbort_Handler,
 		},
+		{
+			MethodName: "AsOfTs",
+			Handler:    _Zero_AsOfTs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -7055,6 +7453,23 @@ func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x68
 	}
+	if m.Stable {
+		i--
+		if m.Stable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Offset != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
 		i--
@@ -7442,9 +7857,21 @@ func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.License != nil {
+	if m.History != nil {
 		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.License != nil {
+		{
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -7561,6 +7988,25 @@ func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HistoryTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.HistoryTs))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TsSamples) > 0 {
+		for iNdEx := len(m.TsSamples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TsSamples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.License != nil {
 		{
 			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
@@ -7745,6 +8191,20 @@ func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IndexingProgress) > 0 {
+		for iNdEx := len(m.IndexingProgress) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexingProgress[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.EeFeatures) > 0 {
 		for iNdEx := len(m.EeFeatures) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EeFeatures[iNdEx])
@@ -7820,6 +8280,74 @@ func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HealthInfo_IndexingProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthInfo_IndexingProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthInfo_IndexingProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Since != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.KeysWritten != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.KeysWritten))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeysRead != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.KeysRead))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Tablet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8757,20 +9285,20 @@ func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Splits) > 0 {
-		dAtA26 := make([]byte, len(m.Splits)*10)
-		var j25 int
+		dAtA27 := make([]byte, len(m.Splits)*10)
+		var j26 int
 		for _, num := range m.Splits {
 			for num >= 1<<7 {
-				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j25++
+				j26++
 			}
-			dAtA26[j25] = uint8(num)
-			j25++
+			dAtA27[j26] = uint8(num)
+			j26++
 		}
-		i -= j25
-		copy(dAtA[i:], dAtA26[:j25])
-		i = encodeVarintPb(dAtA, i, uint64(j25))
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintPb(dAtA, i, uint64(j26))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -9175,6 +9703,16 @@ func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Unique {
+		i--
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.NoConflict {
 		i--
 		if m.NoConflict {
@@ -9336,6 +9874,16 @@ func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Unique {
+		i--
+		if m.Unique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.NoConflict {
 		i--
 		if m.NoConflict {
@@ -9466,6 +10014,20 @@ func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Fields) > 0 {
 		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9490,6 +10052,42 @@ func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TypeUpdate_CompositeIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TypeUpdate_CompositeIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypeUpdate_CompositeIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MapHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9703,20 +10301,20 @@ func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Ts) > 0 {
-		dAtA30 := make([]byte, len(m.Ts)*10)
-		var j29 int
+		dAtA31 := make([]byte, len(m.Ts)*10)
+		var j30 int
 		for _, num := range m.Ts {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j30++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPb(dAtA, i, uint64(j29))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintPb(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10373,20 +10971,20 @@ func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Splits) > 0 {
-		dAtA35 := make([]byte, len(m.Splits)*10)
-		var j34 int
+		dAtA36 := make([]byte, len(m.Splits)*10)
+		var j35 int
 		for _, num := range m.Splits {
 			for num >= 1<<7 {
-				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j34++
+				j35++
 			}
-			dAtA35[j34] = uint8(num)
-			j34++
+			dAtA36[j35] = uint8(num)
+			j35++
 		}
-		i -= j34
-		copy(dAtA[i:], dAtA35[:j34])
-		i = encodeVarintPb(dAtA, i, uint64(j34))
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintPb(dAtA, i, uint64(j35))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -10410,20 +11008,20 @@ func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Uids) > 0 {
-		dAtA37 := make([]byte, len(m.Uids)*10)
-		var j36 int
+		dAtA38 := make([]byte, len(m.Uids)*10)
+		var j37 int
 		for _, num := range m.Uids {
 			for num >= 1<<7 {
-				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j36++
+				j37++
 			}
-			dAtA37[j36] = uint8(num)
-			j36++
+			dAtA38[j37] = uint8(num)
+			j37++
 		}
-		i -= j36
-		copy(dAtA[i:], dAtA37[:j36])
-		i = encodeVarintPb(dAtA, i, uint64(j36))
+		i -= j37
+		copy(dAtA[i:], dAtA38[:j37])
+		i = encodeVarintPb(dAtA, i, uint64(j37))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10529,6 +11127,87 @@ func (m *UpdateGraphQLSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *TsSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TsSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TsSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ts != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UnixNano != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UnixNano))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Since != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sample != nil {
+		{
+			size, err := m.Sample.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPb(v)
 	base := offset
@@ -10792,6 +11471,13 @@ func (m *SortMessage) Size() (n int) {
 	if m.Offset != 0 {
 		n += 1 + sovPb(uint64(m.Offset))
 	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Stable {
+		n += 2
+	}
 	if m.ReadTs != 0 {
 		n += 1 + sovPb(uint64(m.ReadTs))
 	}
@@ -10997,6 +11683,10 @@ func (m *ZeroProposal) Size() (n int) {
 		l = m.License.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if m.History != nil {
+		l = m.History.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11061,6 +11751,15 @@ func (m *MembershipState) Size() (n int) {
 		l = m.License.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if len(m.TsSamples) > 0 {
+		for _, e := range m.TsSamples {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.HistoryTs != 0 {
+		n += 1 + sovPb(uint64(m.HistoryTs))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11140,39 +11839,47 @@ func (m *HealthInfo) Size() (n int) {
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
+	if len(m.IndexingProgress) > 0 {
+		for _, e := range m.IndexingProgress {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Tablet) Size() (n int) {
+func (m *HealthInfo_IndexingProgress) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
 	l = len(m.Predicate)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Force {
-		n += 2
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Space != 0 {
-		n += 1 + sovPb(uint64(m.Space))
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Remove {
-		n += 2
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
 	}
-	if m.ReadOnly {
-		n += 2
+	if m.KeysRead != 0 {
+		n += 1 + sovPb(uint64(m.KeysRead))
 	}
-	if m.MoveTs != 0 {
-		n += 1 + sovPb(uint64(m.MoveTs))
+	if m.KeysWritten != 0 {
+		n += 1 + sovPb(uint64(m.KeysWritten))
+	}
+	if m.Since != 0 {
+		n += 1 + sovPb(uint64(m.Since))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11180,15 +11887,49 @@ func (m *Tablet) Size() (n int) {
 	return n
 }
 
-func (m *DirectedEdge) Size() (n int) {
+func (m *Tablet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Entity != 0 {
-		n += 9
-	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.Space != 0 {
+		n += 1 + sovPb(uint64(m.Space))
+	}
+	if m.Remove {
+		n += 2
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.MoveTs != 0 {
+		n += 1 + sovPb(uint64(m.MoveTs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DirectedEdge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entity != 0 {
+		n += 9
+	}
 	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
@@ -11813,6 +12554,9 @@ func (m *SchemaNode) Size() (n int) {
 	if m.NoConflict {
 		n += 2
 	}
+	if m.Unique {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11884,6 +12628,9 @@ func (m *SchemaUpdate) Size() (n int) {
 	if m.NoConflict {
 		n += 2
 	}
+	if m.Unique {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11906,6 +12653,30 @@ func (m *TypeUpdate) Size() (n int) {
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TypeUpdate_CompositeIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12404,6 +13175,43 @@ func (m *UpdateGraphQLSchemaResponse) Size() (n int) {
 	return n
 }
 
+func (m *TsSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnixNano != 0 {
+		n += 1 + sovPb(uint64(m.UnixNano))
+	}
+	if m.Ts != 0 {
+		n += 1 + sovPb(uint64(m.Ts))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sample != nil {
+		l = m.Sample.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovPb(uint64(m.Since))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovPb(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -13903,6 +14711,58 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stable = bool(v != 0)
 		case 13:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
@@ -15256,6 +16116,42 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.History == nil {
+				m.History = &HistoryUpdate{}
+			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15718,6 +16614,59 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TsSamples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TsSamples = append(m.TsSamples, &TsSample{})
+			if err := m.TsSamples[len(m.TsSamples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTs", wireType)
+			}
+			m.HistoryTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -16211,6 +17160,40 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.EeFeatures = append(m.EeFeatures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexingProgress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexingProgress = append(m.IndexingProgress, &HealthInfo_IndexingProgress{})
+			if err := m.IndexingProgress[len(m.IndexingProgress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -16236,7 +17219,7 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tablet) Unmarshal(dAtA []byte) error {
+func (m *HealthInfo_IndexingProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16259,17 +17242,243 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexingProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexingProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+			}
+			m.KeysRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysRead |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+			}
+			m.KeysWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysWritten |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tablet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20325,6 +21534,26 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NoConflict = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20741,6 +21970,26 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NoConflict = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20861,12 +22110,132 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, &TypeUpdate_CompositeIndex{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TypeUpdate_CompositeIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompositeIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompositeIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if skippy < 0 {
 				return ErrInvalidLengthPb
 			}
@@ -23809,6 +25178,207 @@ func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TsSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TsSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TsSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixNano", wireType)
+			}
+			m.UnixNano = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixNano |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
+			}
+			m.Ts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sample", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sample == nil {
+				m.Sample = &TsSample{}
+			}
+			if err := m.Sample.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,7 +18,10 @@ package query
 
 import (
 	"bytes"
+	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
@@ -31,6 +34,54 @@ type aggregator struct {
 	name   string
 	result types.Val
 	count  int // used when we need avergae.
+	// vals keeps all the values for the aggregations that can't be computed incrementally, like
+	// median.
+	vals []types.Val
+	// percentile is the percentile computed by the percentile and median aggregations.
+	percentile float64
+}
+
+// newAggregator returns the aggregator for the aggregation function fn.
+func newAggregator(fn *Function) (aggregator, error) {
+	ag := aggregator{name: fn.Name}
+	switch fn.Name {
+	case "median":
+		ag.percentile = 50
+	case "percentile":
+		if len(fn.Args) == 0 {
+			return ag, errors.Errorf("Function percentile expects a number between 0 and 100 " +
+				"as its second argument")
+		}
+		p, err := strconv.ParseFloat(fn.Args[0].Value, 64)
+		if err != nil || p < 0 || p > 100 {
+			return ag, errors.Errorf("Function percentile expects a number between 0 and 100 "+
+				"as its second argument. Got: %s", fn.Args[0].Value)
+		}
+		ag.percentile = p
+	}
+	return ag, nil
+}
+
+// aggregatorFieldName returns the name of the field that holds the result of the aggregation
+// function fn over arg, when it doesn't have an alias.
+func aggregatorFieldName(fn *Function, arg string) string {
+	switch {
+	case fn.Name == "countdistinct":
+		return fmt.Sprintf("count(distinct %s)", arg)
+	case fn.Name == "percentile" && len(fn.Args) > 0:
+		return fmt.Sprintf("percentile(%s, %s)", arg, fn.Args[0].Value)
+	}
+	return fmt.Sprintf("%s(%s)", fn.Name, arg)
+}
+
+// needsAllValues returns true if the aggregation function f can only be computed once all the
+// values have been seen.
+func needsAllValues(f string) bool {
+	switch f {
+	case "median", "percentile", "stddev", "variance", "countdistinct":
+		return true
+	}
+	return false
 }
 
 func isUnary(f string) bool {
@@ -475,6 +526,13 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 }
 
 func (ag *aggregator) Apply(val types.Val) {
+	if needsAllValues(ag.name) {
+		if val.Value != nil {
+			ag.vals = append(ag.vals, val)
+		}
+		return
+	}
+
 	if ag.result.Value == nil {
 		ag.result = val
 		ag.count++
@@ -517,6 +575,9 @@ func (ag *aggregator) Apply(val types.Val) {
 
 func (ag *aggregator) ValueMarshalled() (*pb.TaskValue, error) {
 	data := types.ValueForType(types.BinaryID)
+	if err := ag.applyAllValues(); err != nil {
+		return nil, err
+	}
 	ag.divideByCount()
 	res := &pb.TaskValue{ValType: ag.result.Tid.Enum(), Val: x.Nilbyte}
 	if ag.result.Value == nil {
@@ -547,7 +608,125 @@ func (ag *aggregator) divideByCount() {
 	ag.result.Value = v / float64(ag.count)
 }
 
+// applyAllValues computes the result of the aggregations that need all the values at once.
+func (ag *aggregator) applyAllValues() error {
+	if !needsAllValues(ag.name) || ag.result.Value != nil {
+		return nil
+	}
+	if ag.name == "countdistinct" {
+		return ag.countDistinct()
+	}
+	if len(ag.vals) == 0 {
+		return nil
+	}
+
+	nums, isTime, err := ag.statValues()
+	if err != nil {
+		return err
+	}
+	switch ag.name {
+	case "median", "percentile":
+		if isTime {
+			ag.result = ag.timePercentile()
+			return nil
+		}
+		sort.Float64s(nums)
+		lo, hi, frac := percentileRank(len(nums), ag.percentile)
+		ag.result = types.Val{Tid: types.FloatID, Value: nums[lo] + frac*(nums[hi]-nums[lo])}
+	case "variance", "stddev":
+		var mean float64
+		for _, n := range nums {
+			mean += n
+		}
+		mean /= float64(len(nums))
+		var variance float64
+		for _, n := range nums {
+			variance += (n - mean) * (n - mean)
+		}
+		variance /= float64(len(nums))
+		if ag.name == "stddev" {
+			variance = math.Sqrt(variance)
+		}
+		ag.result = types.Val{Tid: types.FloatID, Value: variance}
+	}
+	return nil
+}
+
+// statValues returns the values given to the aggregator as floats, along with whether they are
+// datetimes. Datetimes are converted to seconds since the Unix epoch. The values must either be
+// all numbers or all datetimes.
+func (ag *aggregator) statValues() ([]float64, bool, error) {
+	nums := make([]float64, 0, len(ag.vals))
+	var numbers, times int
+	for _, v := range ag.vals {
+		switch v.Tid {
+		case types.IntID:
+			nums = append(nums, float64(v.Value.(int64)))
+			numbers++
+		case types.FloatID:
+			nums = append(nums, v.Value.(float64))
+			numbers++
+		case types.DateTimeID:
+			nums = append(nums, float64(v.Value.(time.Time).UnixNano())/1e9)
+			times++
+		default:
+			return nil, false, errors.Errorf("Wrong type %v encountered for func %s", v.Tid,
+				ag.name)
+		}
+	}
+	if numbers > 0 && times > 0 {
+		return nil, false, errors.Errorf("Cannot mix datetime and numeric values in func %s",
+			ag.name)
+	}
+	return nums, times > 0, nil
+}
+
+// timePercentile returns the percentile of the datetimes given to the aggregator. It's computed
+// on the times themselves so that it keeps their precision.
+func (ag *aggregator) timePercentile() types.Val {
+	times := make([]time.Time, 0, len(ag.vals))
+	for _, v := range ag.vals {
+		times = append(times, v.Value.(time.Time))
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	lo, hi, frac := percentileRank(len(times), ag.percentile)
+	diff := time.Duration(frac * float64(times[hi].Sub(times[lo])))
+	return types.Val{Tid: types.DateTimeID, Value: times[lo].Add(diff)}
+}
+
+// percentileRank returns the indexes of the two sorted values, out of n, that the percentile p
+// falls between, and how far it's from the first one. The percentile is linearly interpolated
+// between them.
+func percentileRank(n int, p float64) (int, int, float64) {
+	rank := p / 100 * float64(n-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return lo, hi, rank - float64(lo)
+}
+
+// countDistinct counts the different values given to the aggregator.
+func (ag *aggregator) countDistinct() error {
+	seen := make(map[string]struct{}, len(ag.vals))
+	for _, v := range ag.vals {
+		if v.Tid == types.UidID {
+			seen[fmt.Sprintf("%d:%d", v.Tid, v.Value.(uint64))] = struct{}{}
+			continue
+		}
+		data := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(v, &data); err != nil {
+			return err
+		}
+		// Values of different types are different, even if they look the same.
+		seen[fmt.Sprintf("%d:%s", v.Tid, data.Value)] = struct{}{}
+	}
+	ag.result = types.Val{Tid: types.IntID, Value: int64(len(seen))}
+	return nil
+}
+
 func (ag *aggregator) Value() (types.Val, error) {
+	if err := ag.applyAllValues(); err != nil {
+		return ag.result, err
+	}
 	if ag.result.Value == nil {
 		return ag.result, ErrEmptyVal
 	}
@@ -0,0 +1,212 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPageRankIterations = 20
+	defaultPageRankDamping    = 0.85
+)
+
+func isGraphAlgoFn(f string) bool {
+	return f == "pagerank" || f == "components"
+}
+
+// isGraphAlgo returns true if sg runs a graph algorithm, like pagerank(follows), over the
+// nodes of its parent.
+func (sg *SubGraph) isGraphAlgo() bool {
+	return sg.SrcFunc != nil && isGraphAlgoFn(sg.SrcFunc.Name)
+}
+
+// processGraphAlgo runs the graph algorithm of sg over the graph formed by its SrcUIDs and
+// the sg.Attr edges between them. The result, a value for every node, is kept in
+// sg.Params.UidToVal and becomes the value of the variable defined by sg.
+func processGraphAlgo(ctx context.Context, sg *SubGraph, rch chan error) {
+	rch <- sg.processGraphAlgo(ctx)
+}
+
+func (sg *SubGraph) processGraphAlgo(ctx context.Context) error {
+	sg.Params.UidToVal = make(map[uint64]types.Val)
+	if sg.SrcUIDs == nil || len(sg.SrcUIDs.Uids) == 0 {
+		return nil
+	}
+
+	out, err := sg.graphAlgoEdges(ctx)
+	if err != nil {
+		return err
+	}
+
+	nodes := sg.SrcUIDs.Uids
+	switch sg.SrcFunc.Name {
+	case "pagerank":
+		iterations, damping, err := sg.pageRankArgs()
+		if err != nil {
+			return err
+		}
+		ranks, err := pageRank(ctx, out, iterations, damping)
+		if err != nil {
+			return err
+		}
+		for i, uid := range nodes {
+			sg.Params.UidToVal[uid] = types.Val{Tid: types.FloatID, Value: ranks[i]}
+		}
+	case "components":
+		for i, c := range components(out) {
+			sg.Params.UidToVal[nodes[i]] = types.Val{Tid: types.IntID, Value: c}
+		}
+	}
+	return nil
+}
+
+// pageRankArgs returns the number of iterations and the damping factor given to pagerank.
+func (sg *SubGraph) pageRankArgs() (int, float64, error) {
+	iterations, damping := defaultPageRankIterations, defaultPageRankDamping
+	args := sg.SrcFunc.Args
+	if len(args) > 0 {
+		n, err := strconv.ParseInt(args[0].Value, 0, 32)
+		if err != nil || n <= 0 {
+			return 0, 0, errors.Errorf("pagerank expects the number of iterations to be a "+
+				"positive integer. Got: %s", args[0].Value)
+		}
+		iterations = int(n)
+	}
+	if len(args) > 1 {
+		d, err := strconv.ParseFloat(args[1].Value, 64)
+		if err != nil || d < 0 || d >= 1 {
+			return 0, 0, errors.Errorf("pagerank expects the damping factor to be in [0, 1). "+
+				"Got: %s", args[1].Value)
+		}
+		damping = d
+	}
+	return iterations, damping, nil
+}
+
+// graphAlgoEdges fetches the sg.Attr edges of the nodes in sg.SrcUIDs. It returns, for the
+// node at each index of sg.SrcUIDs, the indexes of the nodes that it has an edge to. Edges to
+// nodes outside of sg.SrcUIDs are left out.
+func (sg *SubGraph) graphAlgoEdges(ctx context.Context) ([][]int, error) {
+	matrix, err := sg.fetchEdges(ctx, sg.Attr, sg.SrcUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]int, len(sg.SrcUIDs.Uids))
+	for i, ul := range matrix {
+		if i >= len(out) {
+			break
+		}
+		for _, uid := range ul.Uids {
+			if j := algo.IndexOf(sg.SrcUIDs, uid); j >= 0 {
+				out[i] = append(out[i], j)
+			}
+		}
+	}
+	return out, nil
+}
+
+// pageRank computes the PageRank of every node of the graph given by the adjacency lists in
+// out. The rank of a node without any outgoing edges is shared by all the nodes, so that the
+// ranks always sum up to 1.
+func pageRank(ctx context.Context, out [][]int, iterations int,
+	damping float64) ([]float64, error) {
+	n := float64(len(out))
+	rank := make([]float64, len(out))
+	for i := range rank {
+		rank[i] = 1 / n
+	}
+	next := make([]float64, len(out))
+	for it := 0; it < iterations; it++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var dangling float64
+		for i := range next {
+			next[i] = 0
+		}
+		for u, vs := range out {
+			if len(vs) == 0 {
+				dangling += rank[u]
+				continue
+			}
+			share := rank[u] / float64(len(vs))
+			for _, v := range vs {
+				next[v] += share
+			}
+		}
+
+		base := (1-damping)/n + damping*dangling/n
+		for i := range next {
+			next[i] = base + damping*next[i]
+		}
+		rank, next = next, rank
+	}
+	return rank, nil
+}
+
+// components finds the weakly connected components of the graph given by the adjacency lists
+// in out, ignoring the direction of the edges. It returns the component of every node.
+// Components are numbered from 1, in the order of the first node in them.
+func components(out [][]int) []int64 {
+	parent := make([]int, len(out))
+	for i := range parent {
+		parent[i] = i
+	}
+	find := func(i int) int {
+		root := i
+		for parent[root] != root {
+			root = parent[root]
+		}
+		for parent[i] != root {
+			parent[i], i = root, parent[i]
+		}
+		return root
+	}
+	for u, vs := range out {
+		for _, v := range vs {
+			ru, rv := find(u), find(v)
+			// The smaller index is always the root, so a component's root is its first node.
+			switch {
+			case ru < rv:
+				parent[rv] = ru
+			case rv < ru:
+				parent[ru] = rv
+			}
+		}
+	}
+
+	comp := make([]int64, len(out))
+	var last int64
+	for i := range out {
+		r := find(i)
+		if r == i {
+			last++
+			comp[i] = last
+			continue
+		}
+		comp[i] = comp[r]
+	}
+	return comp
+}
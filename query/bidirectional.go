@@ -0,0 +1,242 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/pkg/errors"
+)
+
+// bfsNode is a node reached by one side of the bidirectional search.
+type bfsNode struct {
+	// hops is the number of edges between the node and the end the search started from.
+	hops int
+	// next is the node before this one, on the way back to the end the search started from.
+	next uint64
+	// attr is the predicate of the edge between the node and next, in the direction of the path.
+	attr string
+}
+
+// bfsSide is the state of the search from one end of the path.
+type bfsSide struct {
+	// forward is true for the search from sg.Params.From, which follows the edges, and false
+	// for the search from sg.Params.To, which follows them backwards.
+	forward  bool
+	visited  map[uint64]bfsNode
+	frontier *pb.List
+	// levels is the number of times the side has been expanded.
+	levels int
+}
+
+func newBfsSide(start uint64, forward bool) *bfsSide {
+	return &bfsSide{
+		forward:  forward,
+		visited:  map[uint64]bfsNode{start: {}},
+		frontier: &pb.List{Uids: []uint64{start}},
+	}
+}
+
+// reverseAttr returns the predicate that traverses the edges of attr backwards.
+func reverseAttr(attr string) string {
+	if strings.HasPrefix(attr, "~") {
+		return strings.TrimPrefix(attr, "~")
+	}
+	return "~" + attr
+}
+
+// canRunBidirectional returns true if the shortest path can be found by a breadth first search
+// from both of its ends. That's the case when the edges aren't weighted by facets and every
+// predicate can be traversed backwards, because it has a reverse index or because the block
+// traverses it in both directions.
+func (sg *SubGraph) canRunBidirectional(ctx context.Context) bool {
+	if len(sg.Children) == 0 {
+		return false
+	}
+	attrs := make(map[string]bool)
+	for _, child := range sg.Children {
+		attrs[child.Attr] = true
+	}
+	for _, child := range sg.Children {
+		if child.Params.Facet != nil || child.facetsFilter != nil || len(child.Filters) > 0 ||
+			child.Params.Expand != "" {
+			return false
+		}
+		if strings.HasPrefix(child.Attr, "~") || attrs[reverseAttr(child.Attr)] {
+			continue
+		}
+		if !schema.State().IsReversed(ctx, child.Attr) {
+			return false
+		}
+	}
+	return true
+}
+
+// bidirectionalShortestPath finds the path with the least number of edges between
+// sg.Params.From and sg.Params.To with a breadth first search from both ends, that stops as
+// soon as the two searches meet. The smaller of the two frontiers is expanded each time, so
+// nodes with a lot of edges near one end don't make the search explode.
+func bidirectionalShortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
+	maxHops := math.MaxInt32
+	if sg.Params.ExploreDepth != nil {
+		maxHops = int(*sg.Params.ExploreDepth)
+	}
+	if maxHops == 0 {
+		return nil, nil
+	}
+
+	from := newBfsSide(sg.Params.From, true)
+	to := newBfsSide(sg.Params.To, false)
+	var numEdges uint64
+	var meet uint64
+	if sg.Params.From == sg.Params.To {
+		meet = sg.Params.From
+	}
+	for meet == 0 && from.levels+to.levels < maxHops {
+		if len(from.frontier.Uids) == 0 || len(to.frontier.Uids) == 0 {
+			// One of the ends can't reach any more nodes, so there is no path.
+			break
+		}
+		side, other := from, to
+		if len(to.frontier.Uids) < len(from.frontier.Uids) {
+			side, other = to, from
+		}
+
+		var err error
+		meet, err = sg.expandBfsSide(ctx, side, other, &numEdges)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if meet == 0 {
+		sg.DestUIDs = &pb.List{}
+		return nil, nil
+	}
+
+	// Walk back from the meeting node to both ends to build the path.
+	var result []uint64
+	dist := make(map[uint64]nodeInfo)
+	for cur := meet; cur != sg.Params.From; cur = from.visited[cur].next {
+		result = append(result, cur)
+		node := from.visited[cur]
+		dist[cur] = nodeInfo{parent: node.next, mapItem: mapItem{attr: node.attr}}
+	}
+	result = append(result, sg.Params.From)
+	l := len(result)
+	for i := 0; i < l/2; i++ {
+		result[i], result[l-i-1] = result[l-i-1], result[i]
+	}
+	for cur := meet; cur != sg.Params.To; {
+		node := to.visited[cur]
+		dist[node.next] = nodeInfo{parent: cur, mapItem: mapItem{attr: node.attr}}
+		result = append(result, node.next)
+		cur = node.next
+	}
+
+	// Put the path in DestUIDs of the root.
+	sg.DestUIDs.Uids = result
+	shortestSg := createPathSubgraph(ctx, dist, float64(len(result)-1), result)
+	return []*SubGraph{shortestSg}, nil
+}
+
+// expandBfsSide expands the frontier of side by one level. It returns the node where the two
+// searches meet on the shortest path found during the expansion, or 0 if they didn't meet.
+func (sg *SubGraph) expandBfsSide(ctx context.Context, side, other *bfsSide,
+	numEdges *uint64) (uint64, error) {
+	var meet uint64
+	bestHops := math.MaxInt32
+	var next []uint64
+	for _, child := range sg.Children {
+		attr := child.Attr
+		if !side.forward {
+			attr = reverseAttr(attr)
+		}
+		matrix, err := sg.fetchEdges(ctx, attr, side.frontier)
+		if err != nil {
+			return 0, err
+		}
+
+		for i, ul := range matrix {
+			if i >= len(side.frontier.Uids) {
+				break
+			}
+			fromUid := side.frontier.Uids[i]
+			hops := side.visited[fromUid].hops + 1
+			*numEdges += uint64(len(ul.Uids))
+			for _, uid := range ul.Uids {
+				if _, ok := sg.Params.Avoid[uid]; ok {
+					continue
+				}
+				if _, ok := side.visited[uid]; ok {
+					continue
+				}
+				side.visited[uid] = bfsNode{hops: hops, next: fromUid, attr: child.Attr}
+				next = append(next, uid)
+				if o, ok := other.visited[uid]; ok && hops+o.hops < bestHops {
+					meet, bestHops = uid, hops+o.hops
+				}
+			}
+		}
+	}
+
+	if *numEdges > x.Config.QueryEdgeLimit {
+		// If we've seen too many edges, stop the query.
+		return 0, errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
+			x.Config.QueryEdgeLimit, *numEdges)
+	}
+	if meet == 0 && uint64(len(next)) > x.Config.ShortestFrontierLimit {
+		return 0, errors.Errorf("Exceeded shortest path frontier limit = %v. Found %v nodes "+
+			"in the frontier.", x.Config.ShortestFrontierLimit, len(next))
+	}
+
+	side.levels++
+	// The task queries need the uids in sorted order.
+	sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+	side.frontier = &pb.List{Uids: next}
+	return meet, nil
+}
+
+// fetchEdges returns the uid matrix of the attr edges of the nodes in uids. A predicate that
+// doesn't exist doesn't have any edges.
+func (sg *SubGraph) fetchEdges(ctx context.Context, attr string,
+	uids *pb.List) ([]*pb.List, error) {
+	edges := &SubGraph{
+		Attr:    attr,
+		SrcUIDs: uids,
+		ReadTs:  sg.ReadTs,
+		Cache:   sg.Cache,
+	}
+	taskQuery, err := createTaskQuery(edges)
+	if err != nil {
+		return nil, err
+	}
+	result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+	switch {
+	case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return result.UidMatrix, nil
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+// Explain collects the plans chosen for the blocks of a query, and the profiles of the blocks
+// once they've been processed. They're collected if the context of the query holds an
+// *Explain under ExplainKey.
+type Explain struct {
+	mu       sync.Mutex
+	plans    []*QueryPlan
+	profiles []*ProfileNode
+}
+
+func explainFromContext(ctx context.Context) *Explain {
+	e, _ := ctx.Value(ExplainKey).(*Explain)
+	return e
+}
+
+func (e *Explain) addPlan(plan *QueryPlan) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.plans = append(e.plans, plan)
+}
+
+func (e *Explain) addProfile(profile *ProfileNode) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.profiles = append(e.profiles, profile)
+}
+
+// Plans returns the plans collected so far.
+func (e *Explain) Plans() []*QueryPlan {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append(e.plans[:0:0], e.plans...)
+}
+
+// Profiles returns the profiles collected so far, one for every query block.
+func (e *Explain) Profiles() []*ProfileNode {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append(e.profiles[:0:0], e.profiles...)
+}
+
+// ProfileNode is the profile of a node of the SubGraph tree of a query. Its filters and
+// children are profiled the same way.
+type ProfileNode struct {
+	Alias    string `json:"alias,omitempty"`
+	Attr     string `json:"attr,omitempty"`
+	Func     string `json:"func,omitempty"`
+	FilterOp string `json:"filter_op,omitempty"`
+	// Group is the group contacted for the task of the node, Remote is true if it's served by
+	// another Alpha, and Path and Intersect tell how the task found its results. They're only
+	// set for nodes that ran a task, as described by worker.TaskStats.
+	Group     uint32 `json:"group,omitempty"`
+	Remote    bool   `json:"remote,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Intersect bool   `json:"intersect,omitempty"`
+	// UidsIn is the number of uids the node started from, and UidsOut is the number of uids
+	// it's left with after its filters and pagination.
+	UidsIn  int `json:"uids_in"`
+	UidsOut int `json:"uids_out"`
+	// TimeNs is the time spent processing the node along with its filters and children, and
+	// TaskNs the part of it spent on the task of the node.
+	TimeNs        int64 `json:"time_ns"`
+	TaskNs        int64 `json:"task_ns,omitempty"`
+	BytesSent     int   `json:"bytes_sent,omitempty"`
+	BytesReceived int   `json:"bytes_received,omitempty"`
+
+	Filters  []*ProfileNode `json:"filters,omitempty"`
+	Children []*ProfileNode `json:"children,omitempty"`
+}
+
+// nodeProfile is what's recorded about a SubGraph while it's processed in explain mode.
+type nodeProfile struct {
+	latency time.Duration
+	task    *worker.TaskStats
+}
+
+// processTask runs the task query q of sg. In explain mode, it also records how the task was
+// processed.
+func (sg *SubGraph) processTask(ctx context.Context, q *pb.Query) (*pb.Result, error) {
+	if sg.profile == nil {
+		return worker.ProcessTaskOverNetwork(ctx, q)
+	}
+	result, stats, err := worker.ProcessTaskWithStats(ctx, q)
+	sg.profile.task = stats
+	return result, err
+}
+
+// profileTree returns the profile of sg, and of the filters and children under it. Nodes that
+// weren't processed, like the ones for value variables, are left out.
+func (sg *SubGraph) profileTree() *ProfileNode {
+	if sg.profile == nil {
+		return nil
+	}
+	node := &ProfileNode{
+		Alias:    sg.Params.Alias,
+		Attr:     sg.Attr,
+		FilterOp: sg.FilterOp,
+		UidsIn:   len(sg.SrcUIDs.GetUids()),
+		UidsOut:  len(sg.DestUIDs.GetUids()),
+		TimeNs:   sg.profile.latency.Nanoseconds(),
+	}
+	if sg.SrcFunc != nil && (sg.Attr != "" || sg.SrcFunc.Name == "uid") {
+		node.Func = funcString(sg)
+	}
+	if stats := sg.profile.task; stats != nil {
+		node.Group = stats.Group
+		node.Remote = stats.Remote
+		node.Path = stats.Path
+		node.Intersect = stats.Intersect
+		node.TaskNs = stats.Latency.Nanoseconds()
+		node.BytesSent = stats.BytesSent
+		node.BytesReceived = stats.BytesReceived
+	}
+	for _, filter := range sg.Filters {
+		if f := filter.profileTree(); f != nil {
+			node.Filters = append(node.Filters, f)
+		}
+	}
+	for _, child := range sg.Children {
+		if c := child.profileTree(); c != nil {
+			node.Children = append(node.Children, c)
+		}
+	}
+	return node
+}
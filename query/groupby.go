@@ -17,11 +17,12 @@
 package query
 
 import (
-	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/gql"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/pkg/errors"
@@ -38,15 +39,43 @@ type groupResult struct {
 	uids       []uint64
 }
 
+// groupbyFieldName returns the name of the field for the grouping attribute or the aggregation
+// of child, a child of a groupby node.
+func groupbyFieldName(child *SubGraph) string {
+	switch {
+	case child.Params.Alias != "":
+		return child.Params.Alias
+	case child.Params.IgnoreResult:
+		return child.Attr
+	case child.Params.DoCount:
+		return "count"
+	case child.SrcFunc != nil:
+		return aggregatorFieldName(child.SrcFunc, child.Attr)
+	}
+	return child.Attr
+}
+
+// value returns the value of the aggregation or the grouping attribute called name in grp.
+func (grp *groupResult) value(name string) (types.Val, bool) {
+	for _, p := range grp.aggregates {
+		if p.attr == name {
+			return p.key, true
+		}
+	}
+	for _, p := range grp.keys {
+		if p.attr == name {
+			return p.key, true
+		}
+	}
+	return types.Val{}, false
+}
+
 func (grp *groupResult) aggregateChild(child *SubGraph) error {
-	fieldName := child.Params.Alias
+	fieldName := groupbyFieldName(child)
 	if child.Params.DoCount {
 		if child.Attr != "uid" {
 			return errors.Errorf("Only uid predicate is allowed in count within groupby")
 		}
-		if fieldName == "" {
-			fieldName = "count"
-		}
 		grp.aggregates = append(grp.aggregates, groupPair{
 			attr: fieldName,
 			key: types.Val{
@@ -57,9 +86,6 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 		return nil
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
-		if fieldName == "" {
-			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
-		}
 		finalVal, err := aggregateGroup(grp, child)
 		if err != nil {
 			return err
@@ -134,12 +160,111 @@ func (d *dedup) addValue(attr string, value types.Val, uid uint64) {
 		}
 	}
 	curEntity := cur.elements[strKey].entities
+	if n := len(curEntity.Uids); n > 0 && curEntity.Uids[n-1] == uid {
+		// The node reaches the same value more than once through a multi-hop attribute.
+		return
+	}
 	curEntity.Uids = append(curEntity.Uids, uid)
 }
 
+// addChild adds the values of the groupby attribute child for the nodes in ul to the groups.
+// The values for all the nodes are added if ul is nil.
+func (d *dedup) addChild(child *SubGraph, ul *pb.List) {
+	attr := groupbyFieldName(child)
+	for i, srcUid := range child.SrcUIDs.GetUids() {
+		// Ignore uids which are not part of ul.
+		if ul != nil && algo.IndexOf(ul, srcUid) < 0 {
+			continue
+		}
+		for _, val := range groupbyValues(child, i) {
+			d.addValue(attr, val, srcUid)
+		}
+	}
+}
+
+// groupbyAttrSubGraph returns the SubGraph that fetches the values of the groupby attribute
+// attr. An attribute more than one hop away is fetched by a chain of SubGraphs, one for every
+// uid predicate in its path, which ends with the SubGraph for the attribute itself.
+func groupbyAttrSubGraph(attr gql.GroupByAttr, readTs uint64) *SubGraph {
+	sg := &SubGraph{
+		Attr:   attr.Attr,
+		ReadTs: readTs,
+		Params: params{
+			IgnoreResult: true,
+			Langs:        attr.Langs,
+		},
+	}
+	for i := len(attr.Path) - 1; i >= 0; i-- {
+		sg = &SubGraph{
+			Attr:     attr.Path[i],
+			ReadTs:   readTs,
+			Params:   params{IgnoreResult: true},
+			Children: []*SubGraph{sg},
+		}
+	}
+	sg.Params.Alias = attr.Alias
+	if sg.Params.Alias == "" && len(attr.Path) > 0 {
+		sg.Params.Alias = strings.Join(attr.Path, "/") + "/" + attr.Attr
+	}
+	return sg
+}
+
+// groupbyChain returns the chain of SubGraphs that fetches the values of the groupby attribute
+// child, from child itself to the SubGraph for the attribute.
+func groupbyChain(child *SubGraph) []*SubGraph {
+	chain := []*SubGraph{child}
+	for len(child.Children) > 0 {
+		child = child.Children[0]
+		chain = append(chain, child)
+	}
+	return chain
+}
+
+// groupbyValues returns the values of the groupby attribute child for the node at index i of
+// child.SrcUIDs. For an attribute more than one hop away, the values are collected from all the
+// nodes reached through its path.
+func groupbyValues(child *SubGraph, i int) []types.Val {
+	if len(child.Children) > 0 {
+		if i >= len(child.uidMatrix) {
+			return nil
+		}
+		next := child.Children[0]
+		var vals []types.Val
+		for _, uid := range child.uidMatrix[i].GetUids() {
+			if j := algo.IndexOf(next.SrcUIDs, uid); j >= 0 {
+				vals = append(vals, groupbyValues(next, j)...)
+			}
+		}
+		return vals
+	}
+
+	if len(child.DestUIDs.GetUids()) > 0 {
+		// It's a UID node.
+		if i >= len(child.uidMatrix) {
+			return nil
+		}
+		var vals []types.Val
+		for _, uid := range child.uidMatrix[i].GetUids() {
+			vals = append(vals, types.Val{Tid: types.UidID, Value: uid})
+		}
+		return vals
+	}
+
+	// It's a value node.
+	if i >= len(child.valueMatrix) || len(child.valueMatrix[i].Values) == 0 {
+		return nil
+	}
+	val, err := convertTo(child.valueMatrix[i].Values[0])
+	if err != nil {
+		return nil
+	}
+	return []types.Val{val}
+}
+
 func aggregateGroup(grp *groupResult, child *SubGraph) (types.Val, error) {
-	ag := aggregator{
-		name: child.SrcFunc.Name,
+	ag, err := newAggregator(child.SrcFunc)
+	if err != nil {
+		return types.Val{}, err
 	}
 	for _, uid := range grp.uids {
 		idx := sort.Search(len(child.SrcUIDs.Uids), func(i int) bool {
@@ -208,39 +333,7 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 		if !child.Params.IgnoreResult {
 			continue
 		}
-
-		attr := child.Params.Alias
-		if attr == "" {
-			attr = child.Attr
-		}
-		if len(child.DestUIDs.GetUids()) > 0 {
-			// It's a UID node.
-			for i := 0; i < len(child.uidMatrix); i++ {
-				srcUid := child.SrcUIDs.Uids[i]
-				// Ignore uids which are not part of srcUid.
-				if algo.IndexOf(ul, srcUid) < 0 {
-					continue
-				}
-
-				ul := child.uidMatrix[i]
-				for _, uid := range ul.GetUids() {
-					dedupMap.addValue(attr, types.Val{Tid: types.UidID, Value: uid}, srcUid)
-				}
-			}
-		} else {
-			// It's a value node.
-			for i, v := range child.valueMatrix {
-				srcUid := child.SrcUIDs.Uids[i]
-				if len(v.Values) == 0 || algo.IndexOf(ul, srcUid) < 0 {
-					continue
-				}
-				val, err := convertTo(v.Values[0])
-				if err != nil {
-					continue
-				}
-				dedupMap.addValue(attr, val, srcUid)
-			}
-		}
+		dedupMap.addChild(child, ul)
 	}
 
 	// Create all the groups here.
@@ -259,12 +352,7 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 			}
 		}
 	}
-	// Sort to order the groups for determinism.
-	sort.Slice(res.group, func(i, j int) bool {
-		return groupLess(res.group[i], res.group[j])
-	})
-
-	return res, nil
+	return res, sg.selectGroups(res)
 }
 
 // This function is to use the fillVars. It is similar to formResult, the only difference being
@@ -283,41 +371,17 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 		return nil
 	}
 
-	var pathNode *SubGraph
+	var pathNode []*SubGraph
 	var dedupMap dedup
 
 	for _, child := range sg.Children {
 		if !child.Params.IgnoreResult {
 			continue
 		}
-
-		attr := child.Params.Alias
-		if attr == "" {
-			attr = child.Attr
-		}
-		if len(child.DestUIDs.GetUids()) > 0 {
+		dedupMap.addChild(child, nil)
+		if chain := groupbyChain(child); len(chain[len(chain)-1].DestUIDs.GetUids()) > 0 {
 			// It's a UID node.
-			for i := 0; i < len(child.uidMatrix); i++ {
-				srcUid := child.SrcUIDs.Uids[i]
-				ul := child.uidMatrix[i]
-				for _, uid := range ul.Uids {
-					dedupMap.addValue(attr, types.Val{Tid: types.UidID, Value: uid}, srcUid)
-				}
-			}
-			pathNode = child
-		} else {
-			// It's a value node.
-			for i, v := range child.valueMatrix {
-				srcUid := child.SrcUIDs.Uids[i]
-				if len(v.Values) == 0 {
-					continue
-				}
-				val, err := convertTo(v.Values[0])
-				if err != nil {
-					continue
-				}
-				dedupMap.addValue(attr, val, srcUid)
-			}
+			pathNode = chain
 		}
 	}
 
@@ -337,10 +401,17 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 				return err
 			}
 		}
-		if child.Params.Var == "" {
+	}
+	if err := sg.selectGroups(res); err != nil {
+		return err
+	}
+
+	for _, child := range sg.Children {
+		if child.Params.IgnoreResult || child.Params.Var == "" {
 			continue
 		}
 		chVar := child.Params.Var
+		fieldName := groupbyFieldName(child)
 
 		tempMap := make(map[uint64]types.Val)
 		for _, grp := range res.group {
@@ -355,19 +426,199 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 			if !ok {
 				return errors.Errorf("Vars can be assigned only when grouped by UID attribute")
 			}
-			// grp.aggregates could be empty if schema conversion failed during aggregation
-			if len(grp.aggregates) > 0 {
-				tempMap[uid] = grp.aggregates[len(grp.aggregates)-1].key
+			// The aggregate could be missing if schema conversion failed during aggregation
+			if val, ok := grp.value(fieldName); ok {
+				tempMap[uid] = val
 			}
 		}
 		doneVars[chVar] = varValue{
 			Vals: tempMap,
-			path: append(path, pathNode),
+			path: append(path, pathNode...),
 		}
 	}
 	return nil
 }
 
+// fillGroupby fills the ordering, pagination and having filter of the groups formed by the
+// groupby directive of gq.
+func (args *params) fillGroupby(gq *gql.GraphQuery) error {
+	gargs := gq.GroupbyArgs
+	if !gq.IsGroupby {
+		if gargs.Having != nil {
+			return errors.Errorf("@having can only be used along with @groupby")
+		}
+		return nil
+	}
+	if v, ok := gargs.Args["offset"]; ok {
+		offset, err := strconv.ParseInt(v, 0, 32)
+		if err != nil || offset < 0 {
+			return errors.Errorf("offset in groupby should be a non-negative integer. Got: %s", v)
+		}
+		args.GroupbyOffset = int(offset)
+	}
+	if v, ok := gargs.Args["first"]; ok {
+		first, err := strconv.ParseInt(v, 0, 32)
+		if err != nil || first < 0 {
+			return errors.Errorf("first in groupby should be a non-negative integer. Got: %s", v)
+		}
+		args.GroupbyCount = int(first)
+	}
+	args.GroupbyOrder = gargs.Order
+	args.GroupbyHaving = gargs.Having
+	return nil
+}
+
+// selectGroups filters the groups with the having filter of sg, sorts them by its ordering and
+// applies its pagination.
+func (sg *SubGraph) selectGroups(res *groupResults) error {
+	// Sort to order the groups for determinism.
+	sort.Slice(res.group, func(i, j int) bool {
+		return groupLess(res.group[i], res.group[j])
+	})
+
+	names := make(map[string]struct{})
+	for _, child := range sg.Children {
+		names[groupbyFieldName(child)] = struct{}{}
+	}
+
+	if having := sg.Params.GroupbyHaving; having != nil {
+		if err := checkHavingNames(having, names); err != nil {
+			return err
+		}
+		groups := res.group[:0]
+		for _, grp := range res.group {
+			ok, err := grp.matchesHaving(having)
+			if err != nil {
+				return err
+			}
+			if ok {
+				groups = append(groups, grp)
+			}
+		}
+		res.group = groups
+	}
+
+	if order := sg.Params.GroupbyOrder; len(order) > 0 {
+		for _, o := range order {
+			if _, ok := names[o.Attr]; !ok {
+				return errors.Errorf("Cannot sort groups by %s. Only the aggregations and the "+
+					"attributes of the groupby can be used, with their alias if they have one.",
+					o.Attr)
+			}
+		}
+		sort.SliceStable(res.group, func(i, j int) bool {
+			return groupOrderLess(res.group[i], res.group[j], order)
+		})
+	}
+
+	offset, count := sg.Params.GroupbyOffset, sg.Params.GroupbyCount
+	if offset > len(res.group) {
+		offset = len(res.group)
+	}
+	res.group = res.group[offset:]
+	if count > 0 && count < len(res.group) {
+		res.group = res.group[:count]
+	}
+	return nil
+}
+
+// groupOrderLess returns true if the group a comes before b when sorting by order. Groups that
+// don't have a value to sort by come last.
+func groupOrderLess(a, b *groupResult, order []*pb.Order) bool {
+	for _, o := range order {
+		va, okA := a.value(o.Attr)
+		vb, okB := b.value(o.Attr)
+		switch {
+		case !okA && !okB:
+			continue
+		case !okA || !okB:
+			return okA
+		}
+		if l, err := types.Less(va, vb); err == nil && l {
+			return !o.Desc
+		}
+		if l, err := types.Less(vb, va); err == nil && l {
+			return o.Desc
+		}
+	}
+	return false
+}
+
+// havingOps maps the functions allowed in the having filter to the compare functions.
+var havingOps = map[string]string{
+	"eq": "==",
+	"le": "<=",
+	"lt": "<",
+	"ge": ">=",
+	"gt": ">",
+}
+
+// checkHavingNames checks that the having filter ft only uses the aggregations and the
+// attributes of the groupby, given in names.
+func checkHavingNames(ft *gql.FilterTree, names map[string]struct{}) error {
+	for _, child := range ft.Child {
+		if err := checkHavingNames(child, names); err != nil {
+			return err
+		}
+	}
+	if ft.Func == nil {
+		return nil
+	}
+	if _, ok := havingOps[ft.Func.Name]; !ok {
+		return errors.Errorf("Function %s is not supported in @having. Only eq, le, lt, ge "+
+			"and gt are supported.", ft.Func.Name)
+	}
+	if len(ft.Func.Args) != 1 {
+		return errors.Errorf("Function %s in @having expects one value to compare with.",
+			ft.Func.Name)
+	}
+	if _, ok := names[ft.Func.Attr]; !ok {
+		return errors.Errorf("Cannot use %s in @having. Only the aggregations and the "+
+			"attributes of the groupby can be used, with their alias if they have one.",
+			ft.Func.Attr)
+	}
+	return nil
+}
+
+// matchesHaving returns true if the values of grp satisfy the having filter ft. A group without
+// the value that a function compares never satisfies it.
+func (grp *groupResult) matchesHaving(ft *gql.FilterTree) (bool, error) {
+	switch ft.Op {
+	case "and":
+		for _, child := range ft.Child {
+			if ok, err := grp.matchesHaving(child); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case "or":
+		for _, child := range ft.Child {
+			if ok, err := grp.matchesHaving(child); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case "not":
+		ok, err := grp.matchesHaving(ft.Child[0])
+		return !ok && err == nil, err
+	}
+
+	val, ok := grp.value(ft.Func.Attr)
+	if !ok {
+		return false, nil
+	}
+	arg := types.Val{Tid: types.StringID, Value: []byte(ft.Func.Args[0].Value)}
+	target, err := types.Convert(arg, val.Tid)
+	if err != nil && val.Tid == types.IntID {
+		// Integer aggregations can be compared with floats, like gt(count, 1.5).
+		target, err = types.Convert(arg, types.FloatID)
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "while comparing %s in @having", ft.Func.Attr)
+	}
+	return compareValues(havingOps[ft.Func.Name], val, target)
+}
+
 func (sg *SubGraph) processGroupBy(doneVars map[string]varValue, path []*SubGraph) error {
 	for _, ul := range sg.uidMatrix {
 		// We need to process groupby for each list as grouping needs to happen for each path of the
@@ -590,6 +590,8 @@ func valToBytes(v types.Val) ([]byte, error) {
 		return []byte(fmt.Sprintf("\"%#x\"", v.Value)), nil
 	case types.PasswordID:
 		return []byte(fmt.Sprintf("%q", v.Value.(string))), nil
+	case types.VFloatID:
+		return json.Marshal(v.Value.([]float32))
 	default:
 		return nil, errors.New("Unsupported types.Val.Tid")
 	}
@@ -1043,6 +1045,8 @@ type Extensions struct {
 	Latency *api.Latency    `json:"server_latency,omitempty"`
 	Txn     *api.TxnContext `json:"txn,omitempty"`
 	Metrics *api.Metrics    `json:"metrics,omitempty"`
+	Plan    []*QueryPlan    `json:"plan,omitempty"`
+	Profile []*ProfileNode  `json:"profile,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(l *Latency) ([]byte, error) {
@@ -1121,7 +1125,7 @@ func (sg *SubGraph) aggWithVarFieldName() string {
 	if len(sg.Params.NeedsVar) > 0 {
 		fieldName = fmt.Sprintf("val(%v)", sg.Params.NeedsVar[0].Name)
 		if sg.SrcFunc != nil {
-			fieldName = fmt.Sprintf("%s(%v)", sg.SrcFunc.Name, fieldName)
+			fieldName = aggregatorFieldName(sg.SrcFunc, fieldName)
 		}
 	}
 	return fieldName
@@ -1443,5 +1447,47 @@ func (sg *SubGraph) preTraverse(enc *encoder, uid uint64, dst fastJsonNode) erro
 		}
 	}
 
+	if sg.Params.Cursor {
+		cursor := types.Val{Tid: types.StringID, Value: sg.cursorFor(uid)}
+		if err := enc.AddValue(dst, enc.idForAttr("_cursor_"), cursor); err != nil {
+			return err
+		}
+	}
+
+	if sg.recurseMeta != nil {
+		if err := addRecursePaths(enc, dst, "_paths_", sg.recurseMeta.paths[uid]); err != nil {
+			return err
+		}
+		if err := addRecursePaths(enc, dst, "_cycles_", sg.recurseMeta.cycles[uid]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addRecursePaths adds the paths found by a @recurse block to dst under fieldName. Every path
+// is an object with the list of its uids and the list of the predicates between them.
+func addRecursePaths(enc *encoder, dst fastJsonNode, fieldName string,
+	paths []recursePath) error {
+	fieldID := enc.idForAttr(fieldName)
+	uidsID, attrsID := enc.idForAttr("uids"), enc.idForAttr("predicates")
+	for _, p := range paths {
+		pn := enc.newNode(fieldID)
+		for _, uid := range p.uids {
+			un, err := enc.makeUidNode(uidsID, uid)
+			if err != nil {
+				return err
+			}
+			enc.AddListChild(pn, un)
+		}
+		for _, attr := range p.attrs {
+			val := types.Val{Tid: types.StringID, Value: attr}
+			if err := enc.AddListValue(pn, attrsID, val, true); err != nil {
+				return err
+			}
+		}
+		enc.AddListChild(dst, pn)
+	}
 	return nil
 }
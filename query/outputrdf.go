@@ -186,6 +186,8 @@ func getObjectVal(v types.Val) ([]byte, error) {
 		return quotedNumber(outputval), nil
 	case types.GeoID:
 		return nil, errors.New("Geo id is not supported in rdf output")
+	case types.VFloatID:
+		return []byte(strconv.Quote(types.FormatVFloat(v.Value.([]float32)))), nil
 	default:
 		return outputval, nil
 	}
@@ -0,0 +1,438 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	otrace "go.opencensus.io/trace"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/gql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+)
+
+// unknownEstimate is the estimate of a function whose number of matches can't be estimated.
+// Such a function is assumed to scan the whole predicate.
+const unknownEstimate int64 = -1
+
+// PlanStep is a function evaluated by a query block, along with the number of nodes the
+// planner estimated it would match. Estimate is -1 if the planner couldn't estimate it.
+type PlanStep struct {
+	Func     string `json:"func"`
+	Estimate int64  `json:"estimate"`
+}
+
+// QueryPlan is the plan that the planner chose for a query block.
+type QueryPlan struct {
+	Block string `json:"block"`
+	// Root is the function evaluated at the root of the block.
+	Root PlanStep `json:"root"`
+	// Swapped is true if the root function was given as a filter, and the function given at
+	// the root is evaluated as a filter instead.
+	Swapped bool `json:"swapped,omitempty"`
+	// Filters are the conjunctive filters of the block, in the order they're evaluated.
+	Filters []PlanStep `json:"filters,omitempty"`
+}
+
+// indexEstimateFuncs are the functions that can be estimated by adding up the number of uids
+// in the posting lists of the index tokens they look up.
+var indexEstimateFuncs = map[string]bool{
+	"eq": true, "le": true, "lt": true, "ge": true, "gt": true, "between": true,
+	"anyofterms": true, "allofterms": true, "anyoftext": true, "alloftext": true,
+	"composite_eq": true,
+}
+
+// isPlannable returns true if fn can be moved between the root of a block and its filters
+// without changing what it matches.
+func isPlannable(fn *Function) bool {
+	if fn == nil || fn.IsValueVar || fn.IsLenVar {
+		return false
+	}
+	switch fn.Name {
+	case "uid", "uid_in", "similar_to", "checkpwd":
+		return false
+	}
+	// The @count index doesn't keep the nodes without any edge, so a count function that
+	// matches a count of zero finds them as a filter but not at the root.
+	return !fn.IsCount || !matchesZeroCount(fn)
+}
+
+// matchesZeroCount returns true if the count function fn matches a count of zero, or if its
+// arguments can't be told.
+func matchesZeroCount(fn *Function) bool {
+	bounds := make([]int64, 0, len(fn.Args))
+	for _, arg := range fn.Args {
+		n, err := strconv.ParseInt(arg.Value, 0, 64)
+		if err != nil {
+			return true
+		}
+		bounds = append(bounds, n)
+	}
+	switch {
+	case len(bounds) == 0:
+		return true
+	case fn.Name == "eq":
+		return bounds[0] == 0
+	case fn.Name == "lt":
+		return bounds[0] > 0
+	case fn.Name == "le":
+		return bounds[0] >= 0
+	case fn.Name == "gt":
+		return bounds[0] < 0
+	case fn.Name == "ge":
+		return bounds[0] <= 0
+	case fn.Name == "between" && len(bounds) == 2:
+		return bounds[0] <= 0 && bounds[1] >= 0
+	}
+	return true
+}
+
+// plan picks the order in which the root function and the conjunctive filters of the query
+// block sg are evaluated. The number of nodes matched by every function is estimated with the
+// index of its predicate, or its @count index. The function given at the root is swapped with
+// a filter that's expected to match fewer nodes, and the filters are evaluated from the most
+// selective to the least one, each of them only on the nodes left by the ones before it.
+func (sg *SubGraph) plan(ctx context.Context) {
+	if len(sg.Filters) == 0 || sg.facetsFilter != nil || !isPlannable(sg.SrcFunc) {
+		return
+	}
+
+	// The filter at the root is either a single function or an operator over other filters.
+	// Only the filters of an "and" can be reordered.
+	parent, conjuncts := sg, sg.Filters
+	if f := sg.Filters[0]; f.FilterOp == "and" {
+		parent, conjuncts = f, f.Filters
+	} else if f.FilterOp != "" {
+		return
+	}
+	if rest := sg.useCompositeIndex(conjuncts); len(rest) != len(conjuncts) {
+		conjuncts = rest
+		if parent == sg || len(conjuncts) <= 1 {
+			parent, sg.Filters = sg, conjuncts
+		} else {
+			parent.Filters = conjuncts
+		}
+	}
+
+	rootEstimate := unknownEstimate
+	estimates := make([]int64, len(conjuncts))
+	var wg sync.WaitGroup
+	wg.Add(len(conjuncts) + 1)
+	go func() {
+		defer wg.Done()
+		rootEstimate = sg.estimate(ctx)
+	}()
+	for i, f := range conjuncts {
+		go func(i int, f *SubGraph) {
+			defer wg.Done()
+			estimates[i] = f.estimate(ctx)
+		}(i, f)
+	}
+	wg.Wait()
+
+	best := -1
+	for i, f := range conjuncts {
+		if estimates[i] == unknownEstimate || !f.canSwapWithRoot() {
+			continue
+		}
+		if best < 0 || estimates[i] < estimates[best] {
+			best = i
+		}
+	}
+	var swapped bool
+	if best >= 0 && (rootEstimate == unknownEstimate || estimates[best] < rootEstimate) {
+		sg.swapFunc(conjuncts[best])
+		rootEstimate, estimates[best] = estimates[best], rootEstimate
+		swapped = true
+	}
+
+	if len(conjuncts) > 1 {
+		order := make([]int, len(conjuncts))
+		for i := range order {
+			order[i] = i
+		}
+		cost := func(i int) int64 {
+			if estimates[i] == unknownEstimate {
+				return math.MaxInt64
+			}
+			return estimates[i]
+		}
+		sort.SliceStable(order, func(i, j int) bool { return cost(order[i]) < cost(order[j]) })
+
+		filters := make([]*SubGraph, len(conjuncts))
+		ests := make([]int64, len(conjuncts))
+		for i, idx := range order {
+			filters[i], ests[i] = conjuncts[idx], estimates[idx]
+		}
+		parent.Filters, conjuncts, estimates = filters, filters, ests
+		parent.filtersInOrder = true
+	}
+
+	plan := &QueryPlan{
+		Block:   sg.Params.Alias,
+		Root:    PlanStep{Func: funcString(sg), Estimate: rootEstimate},
+		Swapped: swapped,
+	}
+	for i, f := range conjuncts {
+		plan.Filters = append(plan.Filters, PlanStep{Func: filterString(f), Estimate: estimates[i]})
+	}
+	if span := otrace.FromContext(ctx); span != nil {
+		span.Annotatef(nil, "Query plan: %+v", plan)
+	}
+	if e := explainFromContext(ctx); e != nil {
+		e.addPlan(plan)
+	}
+}
+
+// useCompositeIndex finds the nodes matched by several eq functions of the block sg, among its
+// root function and its conjunctive filters, with a single lookup of a composite index over
+// their predicates. The largest composite index whose predicates are all compared is used, and
+// it replaces the root function if the root function is one of them, or else the first of the
+// filters. The conjunctive filters left are returned.
+func (sg *SubGraph) useCompositeIndex(conjuncts []*SubGraph) []*SubGraph {
+	eqs := make(map[string]*SubGraph)
+	if isCompositeEq(sg) {
+		eqs[sg.Attr] = sg
+	}
+	for _, f := range conjuncts {
+		if _, ok := eqs[f.Attr]; !ok && isCompositeEq(f) {
+			eqs[f.Attr] = f
+		}
+	}
+	if len(eqs) < 2 {
+		return conjuncts
+	}
+
+	var best []string
+	for _, preds := range schema.State().AllCompositeIndexes() {
+		covered := len(preds) > len(best) && !schema.State().IsBuildingIndex(preds)
+		for _, pred := range preds {
+			if _, ok := eqs[pred]; !ok || schema.State().IsBeingIndexed(pred) {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			best = preds
+		}
+	}
+	if best == nil {
+		return conjuncts
+	}
+
+	fn := &Function{Name: "composite_eq"}
+	looked := make(map[*SubGraph]bool)
+	for i, pred := range best {
+		if i > 0 {
+			fn.Args = append(fn.Args, gql.Arg{Value: pred})
+		}
+		fn.Args = append(fn.Args, gql.Arg{Value: eqs[pred].SrcFunc.Args[0].Value})
+		looked[eqs[pred]] = true
+	}
+	var target *SubGraph
+	if looked[sg] {
+		target = sg
+	}
+	var rest []*SubGraph
+	for _, f := range conjuncts {
+		switch {
+		case !looked[f]:
+			rest = append(rest, f)
+		case target == nil:
+			target = f
+			rest = append(rest, f)
+		}
+	}
+	target.Attr, target.SrcFunc, target.Params.Langs = best[0], fn, nil
+	return rest
+}
+
+// isCompositeEq returns true if sg compares the untagged value of a predicate to a single
+// value, which can be looked up in a composite index.
+func isCompositeEq(sg *SubGraph) bool {
+	fn := sg.SrcFunc
+	return sg.FilterOp == "" && fn != nil && fn.Name == "eq" && len(fn.Args) == 1 &&
+		!fn.IsCount && !fn.IsValueVar && !fn.IsLenVar && !fn.Args[0].IsValueVar &&
+		len(sg.Params.Langs) == 0 && len(sg.Params.NeedsVar) == 0 && sg.facetsFilter == nil &&
+		!strings.HasPrefix(sg.Attr, "~")
+}
+
+// canSwapWithRoot returns true if the filter sg can be evaluated at the root of its block
+// instead of the root function.
+func (sg *SubGraph) canSwapWithRoot() bool {
+	return sg.FilterOp == "" && sg.facetsFilter == nil && len(sg.Params.NeedsVar) == 0 &&
+		isPlannable(sg.SrcFunc)
+}
+
+// swapFunc swaps the root function of sg with the function of the filter f.
+func (sg *SubGraph) swapFunc(f *SubGraph) {
+	sg.Attr, f.Attr = f.Attr, sg.Attr
+	sg.SrcFunc, f.SrcFunc = f.SrcFunc, sg.SrcFunc
+	sg.Params.Langs, f.Params.Langs = f.Params.Langs, sg.Params.Langs
+}
+
+// estimate returns the number of nodes that the function of sg is expected to match if it's
+// evaluated at the root, or unknownEstimate if it can't be estimated. Functions that go
+// through an index are estimated by the number of uids stored for the index tokens they look
+// up, and has() and count functions are estimated with the @count index of their predicate.
+func (sg *SubGraph) estimate(ctx context.Context) int64 {
+	fn := sg.SrcFunc
+	switch {
+	case fn == nil || sg.FilterOp != "" || fn.IsValueVar || fn.IsLenVar ||
+		len(sg.Params.NeedsVar) > 0:
+		return unknownEstimate
+	case fn.Name == "uid":
+		return int64(len(sg.SrcUIDs.GetUids()))
+	case fn.Name == "has" || fn.IsCount:
+		if !schema.State().HasCount(ctx, strings.TrimPrefix(sg.Attr, "~")) {
+			return unknownEstimate
+		}
+		if fn.Name == "has" {
+			fn = &Function{Name: "gt", Args: []gql.Arg{{Value: "0"}}, IsCount: true}
+		}
+		result, err := sg.estimateTask(ctx, fn, false)
+		if err != nil {
+			return unknownEstimate
+		}
+		return int64(len(algo.MergeSorted(result.UidMatrix).Uids))
+	case indexEstimateFuncs[fn.Name]:
+		result, err := sg.estimateTask(ctx, fn, true)
+		// A function that doesn't look up any token, like anyofterms(name, ""), matches
+		// differently at the root and as a filter, so it's never moved to the root.
+		if err != nil || len(result.Counts) == 0 || len(result.Counts) != len(result.UidMatrix) {
+			return unknownEstimate
+		}
+		var n int64
+		for i, c := range result.Counts {
+			switch {
+			case !strings.HasPrefix(fn.Name, "allof"):
+				n += int64(c)
+			case i == 0 || int64(c) < n:
+				// All the tokens have to match, so the rarest one bounds the matches.
+				n = int64(c)
+			}
+		}
+		return n
+	}
+	return unknownEstimate
+}
+
+// estimateTask evaluates fn on the predicate of sg at the root. If doCount is true, only the
+// number of uids for every index token looked up by fn is returned.
+func (sg *SubGraph) estimateTask(ctx context.Context, fn *Function,
+	doCount bool) (*pb.Result, error) {
+	est := &SubGraph{
+		Attr:    sg.Attr,
+		SrcFunc: fn,
+		ReadTs:  sg.ReadTs,
+		Cache:   sg.Cache,
+		Params:  params{Langs: sg.Params.Langs, DoCount: doCount},
+	}
+	taskQuery, err := createTaskQuery(est)
+	if err != nil {
+		return nil, err
+	}
+	return worker.ProcessTaskOverNetwork(ctx, taskQuery)
+}
+
+// applyFiltersInOrder evaluates the filters of sg one after the other, in the order chosen by
+// the planner, and keeps the nodes that pass all of them. Every filter only gets the nodes
+// left by the filters before it, and no filter is evaluated once no node is left.
+func (sg *SubGraph) applyFiltersInOrder(ctx context.Context) error {
+	for _, filter := range sg.Filters {
+		if len(sg.DestUIDs.GetUids()) == 0 {
+			filter.DestUIDs = &pb.List{}
+			continue
+		}
+		if filter.SrcFunc != nil && filter.SrcFunc.Name == "uid" &&
+			len(filter.Params.NeedsVar) == 0 {
+			filter.DestUIDs = filter.SrcUIDs
+		} else {
+			filter.SrcUIDs = sg.DestUIDs
+			filter.Params.ParentVars = sg.Params.ParentVars
+			errChan := make(chan error, 1)
+			ProcessGraph(ctx, filter, sg, errChan)
+			if err := <-errChan; err != nil {
+				return err
+			}
+		}
+		sg.DestUIDs = algo.IntersectSorted([]*pb.List{sg.DestUIDs, filter.DestUIDs})
+	}
+	return nil
+}
+
+// funcString returns the function of sg the way it's written in a query.
+func funcString(sg *SubGraph) string {
+	fn := sg.SrcFunc
+	if fn.Name == "uid" {
+		var args []string
+		for _, v := range sg.Params.NeedsVar {
+			args = append(args, v.Name)
+		}
+		if len(args) == 0 {
+			for _, uid := range sg.SrcUIDs.GetUids() {
+				args = append(args, UidToHex(uid))
+			}
+		}
+		return "uid(" + strings.Join(args, ", ") + ")"
+	}
+
+	attr := sg.Attr
+	if fn.Name == "composite_eq" {
+		// The arguments alternate between the other predicates and their values.
+		args := []string{attr}
+		for i, arg := range fn.Args {
+			if i%2 == 1 {
+				args = append(args, arg.Value)
+			} else {
+				args = append(args, strconv.Quote(arg.Value))
+			}
+		}
+		return fn.Name + "(" + strings.Join(args, ", ") + ")"
+	}
+	if len(sg.Params.Langs) > 0 {
+		attr += "@" + strings.Join(sg.Params.Langs, ":")
+	}
+	if fn.IsCount {
+		attr = "count(" + attr + ")"
+	}
+	args := []string{attr}
+	for _, arg := range fn.Args {
+		args = append(args, strconv.Quote(arg.Value))
+	}
+	return fn.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// filterString returns the filter sg the way it's written in a query.
+func filterString(sg *SubGraph) string {
+	if sg.FilterOp == "" {
+		return funcString(sg)
+	}
+	var args []string
+	for _, f := range sg.Filters {
+		args = append(args, filterString(f))
+	}
+	return sg.FilterOp + "(" + strings.Join(args, ", ") + ")"
+}
@@ -114,6 +114,10 @@ type params struct {
 	Offset int
 	// AfterUID is the value of the "after" parameter.
 	AfterUID uint64
+	// AfterCursor is the value of the "after" parameter when it's the cursor of a sorted query.
+	AfterCursor string
+	// Cursor is true if the cursor of every node is asked for with _cursor_.
+	Cursor bool
 	// DoCount is true if the count of the predicate is requested instead of its value.
 	DoCount bool
 	// GetUid is true if the uid should be returned. Used for debug requests.
@@ -175,6 +179,18 @@ type params struct {
 	MaxWeight float64
 	// MinWeight is the min weight allowed in a path returned by the shortest path algorithm.
 	MinWeight float64
+	// AllPaths is true if the shortest path query should return all the paths with the least
+	// weight, instead of just one of them.
+	AllPaths bool
+	// MaxHops is the max number of edges allowed in a path returned by the shortest path
+	// algorithm. Zero means that there is no limit.
+	MaxHops int
+	// Avoid is the set of nodes that a path returned by the shortest path algorithm can't go
+	// through.
+	Avoid map[uint64]struct{}
+	// Via is the set of nodes that a path returned by the shortest path algorithm must go
+	// through at least one of. An empty set means that there is no such constraint.
+	Via map[uint64]struct{}
 
 	// ExploreDepth is used by recurse and shortest path queries to specify the maximum graph
 	// depth to explore.
@@ -191,6 +207,12 @@ type params struct {
 	IsGroupBy bool // True if @groupby is specified.
 	// GroupbyAttrs holds the list of attributes to group by.
 	GroupbyAttrs []gql.GroupByAttr
+	// GroupbyOrder, GroupbyOffset and GroupbyCount sort and paginate the groups formed by
+	// @groupby, after GroupbyHaving has filtered them.
+	GroupbyOrder  []*pb.Order
+	GroupbyOffset int
+	GroupbyCount  int
+	GroupbyHaving *gql.FilterTree
 
 	// ParentIds is a stack that is maintained and passed down to children.
 	ParentIds []uint64
@@ -287,7 +309,18 @@ type SubGraph struct {
 	DestUIDs *pb.List
 	List     bool // whether predicate is of list type
 
+	// filtersInOrder is set by the planner when Filters have to be evaluated one after the
+	// other, in their order.
+	filtersInOrder bool
+	// profile is recorded while the SubGraph is processed in explain mode.
+	profile *nodeProfile
+
 	pathMeta *pathMetadata
+	// recurseMeta has the paths and cycles found by a @recurse block with paths or detectcycles.
+	recurseMeta *recurseMetadata
+	// cursors has the cursor of every node, if they are asked for with _cursor_ and the nodes
+	// are sorted.
+	cursors map[uint64]string
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -551,6 +584,7 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 			GroupbyAttrs: gchild.GroupbyAttrs,
 			IsGroupBy:    gchild.IsGroupby,
 			IsInternal:   gchild.IsInternal,
+			Cursor:       gchild.Cursor,
 		}
 
 		// Inherit from the parent.
@@ -595,7 +629,8 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 		}
 
 		if gchild.Func != nil &&
-			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier()) {
+			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier() ||
+				isGraphAlgoFn(gchild.Func.Name)) {
 			if len(gchild.Children) != 0 {
 				return errors.Errorf("Node with %q cant have child attr", gchild.Func.Name)
 			}
@@ -638,6 +673,28 @@ func treeCopy(gq *gql.GraphQuery, sg *SubGraph) error {
 	return nil
 }
 
+// fillCursor sets the parameters to return the nodes after the one that the cursor, returned
+// as its _cursor_, is for.
+func (args *params) fillCursor(cursor string) error {
+	uid, vals, err := worker.DecodeCursor(cursor)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(args.FacetsOrder) > 0:
+		return errors.Errorf("Cursors can't be used when sorting by facets")
+	case len(vals) != len(args.Order):
+		return errors.Errorf("Cursor doesn't match the order of the query, it's for nodes "+
+			"sorted by %d predicates but the query is sorted by %d", len(vals), len(args.Order))
+	case len(vals) == 0:
+		// Nodes that aren't sorted are ordered by uid.
+		args.AfterUID = uid
+	default:
+		args.AfterCursor = cursor
+	}
+	return nil
+}
+
 func (args *params) fill(gq *gql.GraphQuery) error {
 	if v, ok := gq.Args["offset"]; ok {
 		offset, err := strconv.ParseInt(v, 0, 32)
@@ -647,11 +704,11 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		args.Offset = int(offset)
 	}
 	if v, ok := gq.Args["after"]; ok {
-		after, err := strconv.ParseUint(v, 0, 64)
-		if err != nil {
+		if after, err := strconv.ParseUint(v, 0, 64); err == nil {
+			args.AfterUID = after
+		} else if err := args.fillCursor(v); err != nil {
 			return err
 		}
-		args.AfterUID = after
 	}
 
 	if args.Alias == "shortest" {
@@ -691,6 +748,39 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 			args.MinWeight = -math.MaxFloat64
 		}
 
+		if v, ok := gq.Args["allpaths"]; ok {
+			allPaths, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			args.AllPaths = allPaths
+		}
+
+		if v, ok := gq.Args["maxhops"]; ok {
+			maxHops, err := strconv.ParseUint(v, 0, 32)
+			if err != nil {
+				return err
+			}
+			if maxHops == 0 {
+				return errors.Errorf("maxhops should be greater than 0 for shortest path")
+			}
+			args.MaxHops = int(maxHops)
+		}
+
+		// The uids given as variables are added when the variables are filled.
+		if gq.ShortestPathArgs.Avoid != nil {
+			args.Avoid = make(map[uint64]struct{})
+			for _, uid := range gq.ShortestPathArgs.Avoid.UID {
+				args.Avoid[uid] = struct{}{}
+			}
+		}
+		if gq.ShortestPathArgs.Via != nil {
+			args.Via = make(map[uint64]struct{})
+			for _, uid := range gq.ShortestPathArgs.Via.UID {
+				args.Via[uid] = struct{}{}
+			}
+		}
+
 		if gq.ShortestPathArgs.From == nil || gq.ShortestPathArgs.To == nil {
 			return errors.Errorf("from/to can't be nil for shortest path")
 		}
@@ -709,7 +799,7 @@ func (args *params) fill(gq *gql.GraphQuery) error {
 		}
 		args.Count = int(first)
 	}
-	return nil
+	return args.fillGroupby(gq)
 }
 
 // ToSubGraph converts the GraphQuery into the pb.SubGraph instance type.
@@ -731,9 +821,13 @@ type ContextKey int
 const (
 	// DebugKey is the key used to toggle debug mode.
 	DebugKey ContextKey = iota
+	// ExplainKey is the key of the *Explain that collects the plans chosen for a query.
+	ExplainKey
 )
 
-func isDebug(ctx context.Context) bool {
+// IsDebug returns true if the query in ctx is run in debug mode, which returns the uids of the
+// nodes it reads.
+func IsDebug(ctx context.Context) bool {
 	var debug bool
 
 	// gRPC client passes information about debug as metadata.
@@ -770,7 +864,7 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 	args := params{
 		Alias:            gq.Alias,
 		Cascade:          gq.Cascade,
-		GetUid:           isDebug(ctx),
+		GetUid:           IsDebug(ctx),
 		IgnoreReflex:     gq.IgnoreReflex,
 		IsEmpty:          gq.IsEmpty,
 		Langs:            gq.Langs,
@@ -785,6 +879,7 @@ func newGraph(ctx context.Context, gq *gql.GraphQuery) (*SubGraph, error) {
 		GroupbyAttrs:     gq.GroupbyAttrs,
 		IsGroupBy:        gq.IsGroupby,
 		AllowedPreds:     gq.AllowedPreds,
+		Cursor:           gq.Cursor,
 	}
 
 	for argk := range gq.Args {
@@ -984,8 +1079,9 @@ func evalLevelAgg(
 			return mp, nil
 		}
 
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, val := range vals {
 			ag.Apply(val)
@@ -1026,8 +1122,9 @@ func evalLevelAgg(
 	mp = make(map[uint64]types.Val)
 	// Go over the sibling node and aggregate.
 	for i, list := range relSG.uidMatrix {
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, uid := range list.Uids {
 			if val, ok := vals[uid]; ok {
@@ -1225,6 +1322,14 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 			glog.V(3).Info("Warning: Math expression is using unassigned values or constants")
 		}
 		// Put it in this node.
+	case sg.isGraphAlgo():
+		// The values were computed by the graph algorithm when the parent was processed.
+		if sg.Params.Var != "" {
+			it := doneVars[sg.Params.Var]
+			it.Vals = sg.Params.UidToVal
+			it.path = path
+			doneVars[sg.Params.Var] = it
+		}
 	case len(sg.Params.NeedsVar) > 0:
 		// This is a var() block.
 		srcVar := sg.Params.NeedsVar[0]
@@ -1612,7 +1717,7 @@ func (sg *SubGraph) recursiveFillVars(doneVars map[string]varValue) error {
 }
 
 // fillShortestPathVars reads value of the uid variable from mp map and fills it into From and To
-// parameters.
+// parameters, and the Avoid and Via sets.
 func (sg *SubGraph) fillShortestPathVars(mp map[string]varValue) error {
 	// The uidVar.Uids can be nil or have an empty uid list if the variable didn't
 	// return any uids. This would mean sg.Params.From or sg.Params.To is 0 and the
@@ -1647,7 +1752,27 @@ func (sg *SubGraph) fillShortestPathVars(mp map[string]varValue) error {
 			sg.Params.To = uidVar.Uids.Uids[0]
 		}
 	}
-	return nil
+
+	fillSet := func(fn *gql.Function, set map[uint64]struct{}, name string) error {
+		if fn == nil {
+			return nil
+		}
+		for _, v := range fn.NeedsVar {
+			uidVar, ok := mp[v.Name]
+			if !ok {
+				return errors.Errorf("value of %s var(%s) should have already been populated",
+					name, v.Name)
+			}
+			for _, uid := range uidVar.Uids.GetUids() {
+				set[uid] = struct{}{}
+			}
+		}
+		return nil
+	}
+	if err := fillSet(sg.Params.ShortestPathArgs.Avoid, sg.Params.Avoid, "avoid"); err != nil {
+		return err
+	}
+	return fillSet(sg.Params.ShortestPathArgs.Via, sg.Params.Via, "via")
 }
 
 // fillVars reads the value corresponding to a variable from the map mp and stores it inside
@@ -1973,6 +2098,22 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 // ProcessGraph processes the SubGraph instance accumulating result for the query
 // from different instances. Note: taskQuery is nil for root node.
 func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
+	if explainFromContext(ctx) == nil {
+		processGraph(ctx, sg, parent, rch)
+		return
+	}
+
+	// In explain mode, time the processing of the node along with its filters and children.
+	sg.profile = &nodeProfile{}
+	start := time.Now()
+	ch := make(chan error, 1)
+	processGraph(ctx, sg, parent, ch)
+	err := <-ch
+	sg.profile.latency = time.Since(start)
+	rch <- err
+}
+
+func processGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	var suffix string
 	if len(sg.Params.Alias) > 0 {
 		suffix += "." + sg.Params.Alias
@@ -1992,6 +2133,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		rch <- nil
 		return
 	}
+	if parent == nil {
+		sg.plan(ctx)
+	}
+
 	var err error
 	switch {
 	case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid":
@@ -2074,7 +2219,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				rch <- err
 				return
 			}
-			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			result, err := sg.processTask(ctx, taskQuery)
 			switch {
 			case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
 				sg.UnknownAttr = true
@@ -2115,7 +2260,13 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	}
 
 	// Run filters if any.
-	if len(sg.Filters) > 0 {
+	if sg.filtersInOrder {
+		if err = sg.applyFiltersInOrder(ctx); err != nil {
+			rch <- err
+			return
+		}
+	}
+	if len(sg.Filters) > 0 && !sg.filtersInOrder {
 		// Run all filters in parallel.
 		filterChan := make(chan error, len(sg.Filters))
 		for _, filter := range sg.Filters {
@@ -2220,15 +2371,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		// Add the attrs required by groupby nodes
 		for _, it := range sg.Params.GroupbyAttrs {
 			// TODO - Throw error if Attr is of list type.
-			sg.Children = append(sg.Children, &SubGraph{
-				Attr:   it.Attr,
-				ReadTs: sg.ReadTs,
-				Params: params{
-					Alias:        it.Alias,
-					IgnoreResult: true,
-					Langs:        it.Langs,
-				},
-			})
+			sg.Children = append(sg.Children, groupbyAttrSubGraph(it, sg.ReadTs))
 		}
 	}
 
@@ -2251,6 +2394,10 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 
 		child.SrcUIDs = sg.DestUIDs // Make the connection.
+		if child.isGraphAlgo() {
+			go processGraphAlgo(ctx, child, childChan)
+			continue
+		}
 		if child.IsInternal() {
 			// We dont have to execute these nodes.
 			continue
@@ -2261,7 +2408,7 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	var childErr error
 	// Now get all the results back.
 	for _, child := range sg.Children {
-		if child.IsInternal() {
+		if child.IsInternal() && !child.isGraphAlgo() {
 			continue
 		}
 		if err = <-childChan; err != nil {
@@ -2317,6 +2464,9 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 
 	// See if we need to apply order based on facet.
 	if len(sg.Params.FacetsOrder) != 0 {
+		if sg.Params.Cursor {
+			return errors.Errorf("Cursors can't be used when sorting by facets")
+		}
 		return sg.sortAndPaginateUsingFacet(ctx)
 	}
 
@@ -2324,6 +2474,9 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 		// TODO(pawan) - Return error if user uses var order with predicates.
 		if len(sg.Params.Order) > 0 && it.Name == sg.Params.Order[0].Attr &&
 			(it.Typ == gql.ValueVar) {
+			if sg.Params.Cursor || sg.Params.AfterCursor != "" {
+				return errors.Errorf("Cursors can't be used when sorting by a variable")
+			}
 			// If the Order name is same as var name and it's a value variable, we sort using that variable.
 			return sg.sortAndPaginateUsingVar(ctx)
 		}
@@ -2341,7 +2494,10 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 		UidMatrix: sg.uidMatrix,
 		Offset:    int32(sg.Params.Offset),
 		Count:     int32(sg.Params.Count),
-		ReadTs:    sg.ReadTs,
+		After:     sg.Params.AfterCursor,
+		// The next page asked for with a cursor must continue in the same order.
+		Stable: sg.Params.Cursor,
+		ReadTs: sg.ReadTs,
 	}
 	result, err := worker.SortOverNetwork(ctx, sortMsg)
 	if err != nil {
@@ -2368,9 +2524,54 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 	// Update the destUids as we might have removed some UIDs for which we didn't find any values
 	// while sorting.
 	sg.updateDestUids()
+	if sg.Params.Cursor {
+		return sg.fillCursors(ctx)
+	}
 	return nil
 }
 
+// fillCursors fetches the values that the nodes are sorted by, which make up their cursors.
+func (sg *SubGraph) fillCursors(ctx context.Context) error {
+	vals := make([][]*pb.TaskValue, len(sg.DestUIDs.Uids))
+	for i := range vals {
+		vals[i] = make([]*pb.TaskValue, len(sg.Params.Order))
+	}
+	for i, order := range sg.Params.Order {
+		q := &pb.Query{
+			Attr:    strings.TrimPrefix(order.Attr, "~"),
+			Reverse: strings.HasPrefix(order.Attr, "~"),
+			Langs:   order.Langs,
+			UidList: sg.DestUIDs,
+			ReadTs:  sg.ReadTs,
+		}
+		result, err := worker.ProcessTaskOverNetwork(ctx, q)
+		if err != nil {
+			return err
+		}
+		x.AssertTrue(len(result.ValueMatrix) == len(sg.DestUIDs.Uids))
+		for j, vl := range result.ValueMatrix {
+			if len(vl.Values) > 0 {
+				vals[j][i] = vl.Values[0]
+			}
+		}
+	}
+
+	sg.cursors = make(map[uint64]string, len(sg.DestUIDs.Uids))
+	for i, uid := range sg.DestUIDs.Uids {
+		sg.cursors[uid] = worker.EncodeCursor(uid, vals[i])
+	}
+	return nil
+}
+
+// cursorFor returns the cursor of the node uid, to resume after it with after.
+func (sg *SubGraph) cursorFor(uid uint64) string {
+	if len(sg.Params.Order) == 0 {
+		// Nodes that aren't sorted are ordered by uid.
+		return worker.EncodeCursor(uid, nil)
+	}
+	return sg.cursors[uid]
+}
+
 func (sg *SubGraph) updateDestUids() {
 	// Update sg.destUID. Iterate over the UID matrix (which is not sorted by
 	// UID). For each element in UID matrix, we do a binary search in the
@@ -2521,7 +2722,7 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 func isValidArg(a string) bool {
 	switch a {
 	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth",
-		"minweight", "maxweight":
+		"minweight", "maxweight", "allpaths", "maxhops":
 		return true
 	}
 	return false
@@ -2531,7 +2732,7 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
@@ -2547,7 +2748,8 @@ func isInequalityFn(f string) bool {
 
 func isAggregatorFn(f string) bool {
 	switch f {
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance",
+		"countdistinct":
 		return true
 	}
 	return false
@@ -2808,6 +3010,14 @@ func (req *Request) Process(ctx context.Context) (er ExecutionResult, err error)
 	}
 	er.Metrics = metrics
 
+	if e := explainFromContext(ctx); e != nil {
+		for _, sg := range er.Subgraphs {
+			if profile := sg.profileTree(); profile != nil {
+				e.addProfile(profile)
+			}
+		}
+	}
+
 	schemaProcessingStart := time.Now()
 	if req.GqlQuery.Schema != nil {
 		if er.SchemaNode, err = worker.GetSchemaOverNetwork(ctx, req.GqlQuery.Schema); err != nil {
@@ -2833,6 +3043,50 @@ func StripBlankNode(mp map[string]uint64) map[string]uint64 {
 	return temp
 }
 
+// Predicates returns the predicates read by the processed query, sorted. It includes the
+// predicates of functions, filters, orders and groupings, and dgraph.type if a predicate is
+// expanded.
+func (er *ExecutionResult) Predicates() []string {
+	var preds []string
+	add := func(attr string) {
+		attr = strings.TrimPrefix(attr, "~")
+		if attr != "" && attr != "uid" && !strings.Contains(attr, "(") {
+			preds = append(preds, attr)
+		}
+	}
+	var walk func(sg *SubGraph)
+	walk = func(sg *SubGraph) {
+		if !sg.IsInternal() {
+			add(sg.Attr)
+		}
+		if sg.Params.Expand != "" {
+			add("dgraph.type")
+		}
+		for _, order := range sg.Params.Order {
+			add(order.Attr)
+		}
+		for _, order := range sg.Params.GroupbyOrder {
+			add(order.Attr)
+		}
+		for _, attr := range sg.Params.GroupbyAttrs {
+			add(attr.Attr)
+			for _, pred := range attr.Path {
+				add(pred)
+			}
+		}
+		for _, filter := range sg.Filters {
+			walk(filter)
+		}
+		for _, child := range sg.Children {
+			walk(child)
+		}
+	}
+	for _, sg := range er.Subgraphs {
+		walk(sg)
+	}
+	return x.Unique(preds)
+}
+
 // calculateMetrics populates the given map with the number of UIDs that were seen
 // for each predicate.
 func calculateMetrics(sg *SubGraph, metrics map[string]uint64) {
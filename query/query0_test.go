@@ -1562,6 +1562,42 @@ func TestGroupByAgg(t *testing.T) {
 		js)
 }
 
+func TestGroupByAggStatistics(t *testing.T) {
+	query := `
+		{
+			me(func: uid( 1)) {
+				friend @groupby(age) {
+					count(distinct name)
+					median(dob)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[
+			{"age":17,"count(distinct name)":1,"median(dob)":"1909-01-10T00:00:00Z"},
+			{"age":19,"count(distinct name)":1,"median(dob)":"1901-01-15T00:00:00Z"},
+			{"age":15,"count(distinct name)":2,"median(dob)":"1909-09-03T00:00:00Z"}]}]}]}}`,
+		js)
+}
+
+func TestGroupByAggPercentile(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(survival_rate) {
+					p: percentile(age, 25)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"survival_rate":1.6,"p":15.000000}]}]}]}}`,
+		js)
+}
+
 func TestGroupByMulti(t *testing.T) {
 	query := `
 		{
@@ -1642,6 +1678,93 @@ func TestGroupByAgeMultiParents(t *testing.T) {
 	require.JSONEq(t, `{"data":{"me":[{"name":"Michonne","friend":[{"@groupby":[{"age":17,"count":1},{"age":19,"count":1},{"age":15,"count":2}]}]},{"name":"Rick Grimes","friend":[{"@groupby":[{"age":38,"count":1}]}]},{"name":"Andrea","friend":[{"@groupby":[{"age":15,"count":1}]}]}]}}`, js)
 }
 
+func TestGroupByOrderAndFirst(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age, orderdesc: c, first: 1) {
+					c: count(uid)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"friend":[{"@groupby":[{"age":15,"c":2}]}]}]}}`, js)
+}
+
+func TestGroupByOrderByKeyWithOffset(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age, orderasc: age, offset: 1) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data":{"me":[{"friend":[{"@groupby":[{"age":17,"count":1},{"age":19,"count":1}]}]}]}}`,
+		js)
+}
+
+func TestGroupByHaving(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age) @having(lt(c, 2) and not eq(age, 19)) {
+					c: count(uid)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"friend":[{"@groupby":[{"age":17,"c":1}]}]}]}}`, js)
+}
+
+func TestGroupByHavingError(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age) @having(gt(count(uid), 1)) {
+					count(uid)
+				}
+			}
+		}
+	`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Cannot use uid in @having")
+
+	query = `
+		{
+			me(func: uid(1)) {
+				friend @having(gt(c, 1)) {
+					c: count(uid)
+				}
+			}
+		}
+	`
+	_, err = processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "@having can only be used along with @groupby")
+}
+
+func TestGroupByMultiHop(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(friend/name) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"friend":[{"@groupby":[
+		{"friend/name":"Glenn Rhee","count":1},{"friend/name":"Michonne","count":1}]}]}]}}`, js)
+}
+
 func TestGroupByFriendsMultipleParents(t *testing.T) {
 
 	// We dont have any data for uid 99999, 99998.
@@ -706,6 +706,66 @@ func TestHasFuncAtRootWithAfterOnUIDsOtherThanRoot(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"uid":"0x1","friend":[{"uid": "0x17"},{"uid": "0x18"}]},{"uid": "0x1f","friend": [{"uid": "0x18"}]}]}}`, js)
 }
 
+type cursorNode struct {
+	Uid    string `json:"uid"`
+	Cursor string `json:"_cursor_"`
+}
+
+func cursorPage(t *testing.T, args, after string) []cursorNode {
+	if after != "" {
+		args += ", after: " + after
+	}
+	js := processQueryNoErr(t, `{ me(func: has(age)`+args+`) { uid _cursor_ } }`)
+	var res struct {
+		Data struct {
+			Me []cursorNode `json:"me"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	return res.Data.Me
+}
+
+func TestSortWithCursor(t *testing.T) {
+	// Paging through the nodes with cursors must return all of them, in the same order as
+	// a single query does. Many nodes have the same age, so pages end in the middle of them.
+	for _, order := range []string{"", ", orderasc: age", ", orderdesc: age",
+		", orderasc: age, orderdesc: name", ", orderdesc: age, orderasc: alias"} {
+		all := cursorPage(t, order, "")
+		require.True(t, len(all) > 4, order)
+
+		var paged []cursorNode
+		after := ""
+		for {
+			page := cursorPage(t, order+", first: 2", after)
+			if len(page) == 0 {
+				break
+			}
+			require.True(t, len(page) <= 2, order)
+			paged = append(paged, page...)
+			after = page[len(page)-1].Cursor
+		}
+		require.Equal(t, all, paged, order)
+
+		// The offset is applied after the cursor.
+		page := cursorPage(t, order+", first: 2, offset: 1", all[0].Cursor)
+		require.Equal(t, all[2:4], page, order)
+	}
+}
+
+func TestSortWithCursorOfAnotherOrder(t *testing.T) {
+	all := cursorPage(t, ", orderasc: age", "")
+	require.NotEmpty(t, all)
+	_, err := processQuery(context.Background(), t,
+		`{ me(func: has(age), orderasc: age, orderdesc: name, after: `+all[0].Cursor+`) { uid } }`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Cursor doesn't match the order of the query")
+
+	_, err = processQuery(context.Background(), t,
+		`{ me(func: has(age), orderasc: age, after: AXXX) { uid } }`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid cursor")
+}
+
 func TestHasFuncAtRootFilter(t *testing.T) {
 
 	query := `
@@ -1497,6 +1557,44 @@ func TestAggregateRoot6(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[]}}`, js)
 }
 
+func TestAggregateRootStatistics(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				median(val(a))
+				percentile(val(a), 90)
+				variance(val(a))
+				stddev(val(a))
+				count(distinct val(a))
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"median(val(a))":19.000000},
+		{"percentile(val(a), 90)":34.200000},{"variance(val(a))":100.666667},
+		{"stddev(val(a))":10.033278},{"count(distinct val(a))":3}]}}`, js)
+}
+
+func TestAggregateRootMedianDatetime(t *testing.T) {
+	query := `
+		{
+			var(func: uid(23, 24)) {
+				d as dob
+			}
+
+			me() {
+				median(val(d))
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"median(val(d))":"1909-09-03T00:00:00Z"}]}}`, js)
+}
+
 func TestAggregateRootError(t *testing.T) {
 
 	query := `
@@ -1820,6 +1918,58 @@ func TestNearPointMultiPolygon(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Rick Grimes"}]}}`, js)
 }
 
+func TestSimilarTo(t *testing.T) {
+	setSchema(`embedding : float32vector @index(vector) .`)
+	defer dropPredicate("embedding")
+	require.NoError(t, addTriplesToCluster(`
+		<4001> <embedding> "[1.0, 0.0, 0.0]" .
+		<4002> <embedding> "[0.9, 0.1, 0.0]" .
+		<4003> <embedding> "[0.0, 1.0, 0.0]" .
+		<4004> <embedding> "[0.0, 0.0, 1.0]" .
+	`))
+
+	query := `{
+		me(func: similar_to(embedding, 2, [1.0, 0.05, 0.0])) {
+			uid
+		}
+		quoted(func: similar_to(embedding, 1, "[0.0, 0.0, 1.0]")) {
+			uid
+		}
+		filtered(func: uid(4001, 4003, 4004)) @filter(similar_to(embedding, 1, [0.1, 1.0, 0.0])) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0xfa1"}, {"uid": "0xfa2"}],
+		"quoted": [{"uid": "0xfa4"}], "filtered": [{"uid": "0xfa3"}]}}`, js)
+}
+
+func TestSimilarToWithoutIndex(t *testing.T) {
+	setSchema(`embedding_noindex : float32vector .`)
+	defer dropPredicate("embedding_noindex")
+	require.NoError(t, addTriplesToCluster(`
+		<4001> <embedding_noindex> "[1.0, 0.0]" .
+		<4002> <embedding_noindex> "[0.0, 1.0]" .
+	`))
+
+	query := `{
+		me(func: similar_to(embedding_noindex, 1, [1.0, 0.1])) {
+			uid
+		}
+	}`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "does not have vector index")
+
+	query = `{
+		me(func: uid(4001, 4002)) @filter(similar_to(embedding_noindex, 1, [0.1, 1.0])) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0xfa2"}]}}`, js)
+}
+
 func TestMultiSort1(t *testing.T) {
 
 	time.Sleep(10 * time.Millisecond)
@@ -93,6 +93,68 @@ func TestRecurseQuery(t *testing.T) {
 		`{"data": {"me":[{"name":"Michonne", "friend":[{"name":"Rick Grimes", "friend":[{"name":"Michonne"}]},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea", "friend":[{"name":"Glenn Rhee"}]}]}]}}`, js)
 }
 
+func TestRecursePaths(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(paths: true, detectcycles: true) {
+				friend
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "me": [
+		      {
+		        "name": "Michonne",
+		        "friend": [
+		          {"name": "Rick Grimes", "friend": [{"name": "Michonne"}]},
+		          {"name": "Glenn Rhee"},
+		          {"name": "Daryl Dixon"},
+		          {"name": "Andrea", "friend": [{"name": "Glenn Rhee"}]}
+		        ],
+		        "_paths_": [
+		          {"uids": ["0x1", "0x17", "0x1"], "predicates": ["friend", "friend"]},
+		          {"uids": ["0x1", "0x18"], "predicates": ["friend"]},
+		          {"uids": ["0x1", "0x19"], "predicates": ["friend"]},
+		          {"uids": ["0x1", "0x1f", "0x18"], "predicates": ["friend", "friend"]},
+		          {"uids": ["0x1", "0x65"], "predicates": ["friend"]}
+		        ],
+		        "_cycles_": [
+		          {"uids": ["0x1", "0x17", "0x1"], "predicates": ["friend", "friend"]}
+		        ]
+		      }
+		    ]
+		  }
+		}`, js)
+}
+
+func TestRecurseDetectCyclesWithLoop(t *testing.T) {
+
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(depth: 3, loop: true, detectcycles: true) {
+				friend
+			}
+		}`
+	// The cycle is walked more than once with loop, but it's only reported once.
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "me": [
+		      {
+		        "_cycles_": [
+		          {"uids": ["0x1", "0x17", "0x1"], "predicates": ["friend", "friend"]}
+		        ]
+		      }
+		    ]
+		  }
+		}`, js)
+}
+
 func TestRecurseExpand(t *testing.T) {
 
 	query := `
@@ -562,6 +624,199 @@ func TestKShortestPathWeighted1MinMaxWeight(t *testing.T) {
 	`, js)
 }
 
+func TestShortestPathAllPaths(t *testing.T) {
+
+	query := `
+		{
+			shortest(from: 1, to:1003, allpaths: true) {
+				path
+			}
+		}`
+	// Both the paths through 0x3e9 and 0x3ea have four edges.
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "_path_": [
+		      {
+		        "path": {
+		          "path": {
+		            "path": {
+		              "path": {
+		                "uid": "0x3eb"
+		              },
+		              "uid": "0x3e9"
+		            },
+		            "uid": "0x3e8"
+		          },
+		          "uid": "0x1f"
+		        },
+		        "uid": "0x1",
+		        "_weight_": 4
+		      },
+		      {
+		        "path": {
+		          "path": {
+		            "path": {
+		              "path": {
+		                "uid": "0x3eb"
+		              },
+		              "uid": "0x3ea"
+		            },
+		            "uid": "0x3e8"
+		          },
+		          "uid": "0x1f"
+		        },
+		        "uid": "0x1",
+		        "_weight_": 4
+		      }
+		    ]
+		  }
+		}
+	`, js)
+}
+
+func TestShortestPathAvoid(t *testing.T) {
+
+	query := `
+		{
+			shortest(from: 1, to:1003, avoid: uid(1001)) {
+				path @facets(weight)
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "_path_": [
+		      {
+		        "path": {
+		          "path": {
+		            "path": {
+		              "path": {
+		                "uid": "0x3eb",
+		                "path|weight": 0.6
+		              },
+		              "uid": "0x3ea",
+		              "path|weight": 0.7
+		            },
+		            "uid": "0x3e8",
+		            "path|weight": 0.1
+		          },
+		          "uid": "0x1f",
+		          "path|weight": 0.1
+		        },
+		        "uid": "0x1",
+		        "_weight_": 1.5
+		      }
+		    ]
+		  }
+		}
+	`, js)
+}
+
+func TestShortestPathAvoidTarget(t *testing.T) {
+
+	query := `
+		{
+			shortest(from: 1, to:1003, avoid: uid(1003)) {
+				path
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{}}`, js)
+}
+
+func TestShortestPathViaVariable(t *testing.T) {
+
+	query := `
+		{
+			V as var(func: uid(1002))
+
+			shortest(from: 1, to:1003, via: uid(V)) {
+				path
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "_path_": [
+		      {
+		        "path": {
+		          "path": {
+		            "path": {
+		              "path": {
+		                "uid": "0x3eb"
+		              },
+		              "uid": "0x3ea"
+		            },
+		            "uid": "0x3e8"
+		          },
+		          "uid": "0x1f"
+		        },
+		        "uid": "0x1",
+		        "_weight_": 4
+		      }
+		    ]
+		  }
+		}
+	`, js)
+}
+
+func TestShortestPathMaxHops(t *testing.T) {
+
+	query := `
+		{
+			shortest(from: 1, to:1003, maxhops: 4) {
+				path @facets(weight)
+			}
+		}`
+	// The path with the least weight has five edges, so the next best one is returned.
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "_path_": [
+		      {
+		        "path": {
+		          "path": {
+		            "path": {
+		              "path": {
+		                "uid": "0x3eb",
+		                "path|weight": 0.6
+		              },
+		              "uid": "0x3ea",
+		              "path|weight": 0.7
+		            },
+		            "uid": "0x3e8",
+		            "path|weight": 0.1
+		          },
+		          "uid": "0x1f",
+		          "path|weight": 0.1
+		        },
+		        "uid": "0x1",
+		        "_weight_": 1.5
+		      }
+		    ]
+		  }
+		}
+	`, js)
+}
+
+func TestShortestPathMaxHopsError(t *testing.T) {
+
+	query := `
+		{
+			shortest(from: 1, to:1003, maxhops: 0) {
+				path
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "maxhops should be greater than 0")
+}
+
 func TestKShortestPathDepth(t *testing.T) {
 	// Shortest path between 1 and 1000 is the path 1 => 31 => 1001 => 1000
 	// but if the depth is less than 3 then there is no direct path between
@@ -952,6 +1207,51 @@ func TestShortestPathRev(t *testing.T) {
 		js)
 }
 
+func TestShortestPathBidirectional(t *testing.T) {
+	query := `
+		{
+			A as shortest(from:23, to:24) {
+				friend
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x17","_weight_":2,"friend":{"uid":"0x1","friend":{"uid":"0x18"}}}],"me":[{"name":"Rick Grimes"},{"name":"Michonne"},{"name":"Glenn Rhee"}]}}`,
+		js)
+}
+
+func TestShortestPathBidirectionalReverse(t *testing.T) {
+	query := `
+		{
+			shortest(from:24, to:23) {
+				~friend
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"_path_":[{"uid":"0x18","_weight_":2,"~friend":{"uid":"0x1","~friend":{"uid":"0x17"}}}]}}`,
+		js)
+}
+
+func TestShortestPathBidirectionalDepth(t *testing.T) {
+	query := `
+		{
+			A as shortest(from:23, to:24, depth:1) {
+				friend
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": []}}`, js)
+}
+
 // Regression test for https://github.com/dgraph-io/dgraph/issues/3657.
 func TestShortestPathPassword(t *testing.T) {
 	query := `
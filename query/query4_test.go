@@ -20,9 +20,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/testutil"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1547,3 +1549,219 @@ func TestNumUids(t *testing.T) {
 	require.Equal(t, metrics.NumUids["name"], uint64(16))
 	require.Equal(t, metrics.NumUids["_total"], uint64(26))
 }
+
+func TestPageRank(t *testing.T) {
+	query := `{
+		var(func: uid(1, 24, 31, 1000, 1001, 1002, 1003)) {
+			pr as pagerank(follow)
+		}
+		me(func: uid(pr), orderdesc: val(pr), first: 3) {
+			uid
+			rank: val(pr)
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{
+		"data": {
+			"me": [
+				{"uid": "0x3e8", "rank": 0.282410},
+				{"uid": "0x3ea", "rank": 0.178478},
+				{"uid": "0x3e9", "rank": 0.148995}
+			]
+		}
+	}`, js)
+}
+
+func TestPageRankArgs(t *testing.T) {
+	query := `{
+		me(func: uid(1, 24, 31)) {
+			uid
+			rank: pagerank(follow, 1, 0.5)
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{
+		"data": {
+			"me": [
+				{"uid": "0x1", "rank": 0.277778},
+				{"uid": "0x18", "rank": 0.361111},
+				{"uid": "0x1f", "rank": 0.361111}
+			]
+		}
+	}`, js)
+
+	query = `{
+		me(func: uid(1, 24, 31)) {
+			rank: pagerank(follow, 10, 1.5)
+		}
+	}`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "pagerank expects the damping factor to be in [0, 1)")
+}
+
+func TestConnectedComponents(t *testing.T) {
+	query := `{
+		var(func: uid(1, 23, 24, 1000, 1001)) {
+			cc as components(friend)
+		}
+		me(func: uid(cc)) @filter(eq(val(cc), 1)) {
+			uid
+		}
+		all(func: uid(cc)) {
+			uid
+			component: val(cc)
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{
+		"data": {
+			"me": [
+				{"uid": "0x1"},
+				{"uid": "0x17"},
+				{"uid": "0x18"}
+			],
+			"all": [
+				{"uid": "0x1", "component": 1},
+				{"uid": "0x17", "component": 1},
+				{"uid": "0x18", "component": 1},
+				{"uid": "0x3e8", "component": 2},
+				{"uid": "0x3e9", "component": 3}
+			]
+		}
+	}`, js)
+}
+
+func TestPlannerSwapsRootWithFilter(t *testing.T) {
+	query := `{
+		me(func: has(name)) @filter(ge(age, 30) AND eq(name, "Michonne")) {
+			uid
+			name
+			age
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0x1", "name": "Michonne", "age": 38}]}}`, js)
+}
+
+func TestPlannerOrdersFilters(t *testing.T) {
+	query := `{
+		me(func: ge(age, 15)) @filter(has(friend) AND le(age, 20) AND
+			anyofterms(name, "Rick Andrea")) {
+			name
+			age
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{
+		"data": {
+			"me": [
+				{"name": "Rick Grimes", "age": 15},
+				{"name": "Andrea", "age": 19}
+			]
+		}
+	}`, js)
+}
+
+func TestPlannerWithNestedFilters(t *testing.T) {
+	query := `{
+		me(func: has(name)) @filter((eq(name, "Michonne") OR eq(name, "Andrea")) AND
+			le(age, 20) AND NOT uid(1)) {
+			name
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"name": "Andrea"}]}}`, js)
+}
+
+func TestPlannerNoMatchingNodes(t *testing.T) {
+	query := `{
+		me(func: has(name)) @filter(eq(name, "Nobody has this name") AND has(friend)) {
+			name
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": []}}`, js)
+}
+
+func TestPlannerUsesCompositeIndex(t *testing.T) {
+	setSchema(`
+		ticket.tenant   : string @index(exact) .
+		ticket.status   : string .
+		ticket.priority : int .
+	`)
+	// The predicates of a composite index have to be served by the same group.
+	for _, pred := range []string{"ticket.tenant", "ticket.status", "ticket.priority"} {
+		resp, err := http.Get("http://" + testutil.SockAddrZeroHttp +
+			"/moveTablet?tablet=" + pred + "&group=1")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	require.NoError(t, addTriplesToCluster(`
+		<3001> <ticket.tenant> "acme" .
+		<3001> <ticket.status> "open" .
+		<3001> <ticket.priority> "1" .
+		<3002> <ticket.tenant> "acme" .
+		<3002> <ticket.status> "closed" .
+		<3002> <ticket.priority> "2" .
+		<3003> <ticket.tenant> "globex" .
+		<3003> <ticket.status> "open" .
+	`))
+	// The index is built from the data added before it's declared.
+	setSchema(`
+		type Ticket {
+			ticket.tenant
+			ticket.status
+			ticket.priority
+		} @index(ticket.tenant, ticket.status)
+	`)
+	defer func() {
+		require.NoError(t, client.Alter(context.Background(), &api.Operation{
+			DropOp:    api.Operation_TYPE,
+			DropValue: "Ticket",
+		}))
+		dropPredicate("ticket.tenant")
+		dropPredicate("ticket.status")
+		dropPredicate("ticket.priority")
+	}()
+
+	query := `{
+		me(func: eq(ticket.tenant, "acme")) @filter(eq(ticket.status, "open")) {
+			uid
+		}
+		filtered(func: has(ticket.priority)) @filter(eq(ticket.status, "open") AND
+			eq(ticket.tenant, "acme") AND le(ticket.priority, 5)) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0xbb9"}], "filtered": [{"uid": "0xbb9"}]}}`, js)
+
+	// The index is kept up to date by the mutations of any of its predicates.
+	require.NoError(t, addTriplesToCluster(`<3002> <ticket.status> "open" .`))
+	deleteTriplesInCluster(`<3001> <ticket.status> * .`)
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0xbba"}], "filtered": [{"uid": "0xbba"}]}}`, js)
+}
+
+func TestPlannerKeepsZeroCountFilters(t *testing.T) {
+	// The @count index doesn't hold the nodes without any friend, so these filters are never
+	// swapped with the root function, even though their estimate is lower.
+	for _, filter := range []string{"lt(count(friend), 1)", "le(count(friend), 0)"} {
+		query := `{
+			me(func: anyofterms(name, "Glenn Daryl Andrea")) @filter(` + filter + `) {
+				name
+			}
+		}`
+		js := processQueryNoErr(t, query)
+		require.JSONEq(t, `{
+			"data": {
+				"me": [
+					{"name": "Glenn Rhee"},
+					{"name": "Daryl Dixon"},
+					{"name": "Andrea With no friends"}
+				]
+			}
+		}`, js)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/dgraph-io/dgraph/algo"
 	"github.com/dgraph-io/dgraph/x"
@@ -221,5 +222,135 @@ func recurse(ctx context.Context, sg *SubGraph) error {
 		}
 	}
 
-	return sg.expandRecurse(ctx, depth)
+	if err := sg.expandRecurse(ctx, depth); err != nil {
+		return err
+	}
+	if sg.Params.RecurseArgs.Paths || sg.Params.RecurseArgs.DetectCycles {
+		return sg.collectRecursePaths()
+	}
+	return nil
+}
+
+// recursePath is a sequence of nodes and the predicates of the edges between them, so attrs
+// has one element less than uids.
+type recursePath struct {
+	uids  []uint64
+	attrs []string
+}
+
+// recurseMetadata holds the root-to-leaf paths and the cycles found under each root node of a
+// @recurse block.
+type recurseMetadata struct {
+	paths  map[uint64][]recursePath
+	cycles map[uint64][]recursePath
+}
+
+// collectRecursePaths walks the tree built by expandRecurse from every root node, and collects
+// the paths from the root to each leaf and the cycles along them. A cycle is found when an
+// edge goes back to a node that is already on the path. The tree is bounded by the depth of
+// the recursion, the number of edges walked is bounded by the query edge limit.
+func (start *SubGraph) collectRecursePaths() error {
+	meta := &recurseMetadata{
+		paths:  make(map[uint64][]recursePath),
+		cycles: make(map[uint64][]recursePath),
+	}
+	start.recurseMeta = meta
+	if len(start.uidMatrix) == 0 {
+		return nil
+	}
+
+	var numEdges uint64
+	args := start.Params.RecurseArgs
+	for _, root := range start.uidMatrix[0].Uids {
+		if algo.IndexOf(start.DestUIDs, root) < 0 {
+			continue
+		}
+		seenCycles := make(map[string]struct{})
+		path := recursePath{uids: []uint64{root}}
+
+		var walk func(sg *SubGraph, uid uint64) error
+		walk = func(sg *SubGraph, uid uint64) error {
+			leaf := true
+			for _, pc := range sg.Children {
+				if pc.Params.IgnoreResult || pc.IsInternal() || len(pc.counts) > 0 {
+					continue
+				}
+				idx := algo.IndexOf(pc.SrcUIDs, uid)
+				if idx < 0 || idx >= len(pc.uidMatrix) {
+					continue
+				}
+				for _, child := range pc.uidMatrix[idx].Uids {
+					leaf = false
+					numEdges++
+					if numEdges > x.Config.QueryEdgeLimit {
+						return errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
+							x.Config.QueryEdgeLimit, numEdges)
+					}
+
+					path.uids = append(path.uids, child)
+					path.attrs = append(path.attrs, pc.fieldName())
+					if args.DetectCycles {
+						if cycle, ok := path.cycle(); ok {
+							key := cycle.key()
+							if _, seen := seenCycles[key]; !seen {
+								seenCycles[key] = struct{}{}
+								meta.cycles[root] = append(meta.cycles[root], cycle)
+							}
+						}
+					}
+					err := walk(pc, child)
+					path.uids = path.uids[:len(path.uids)-1]
+					path.attrs = path.attrs[:len(path.attrs)-1]
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if leaf && args.Paths {
+				meta.paths[root] = append(meta.paths[root], path.copy())
+			}
+			return nil
+		}
+		if err := walk(start, root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p recursePath) copy() recursePath {
+	return recursePath{
+		uids:  append([]uint64{}, p.uids...),
+		attrs: append([]string{}, p.attrs...),
+	}
+}
+
+// cycle returns the cycle closed by the last node of the path, if that node was already in
+// the path. The cycle starts and ends at that node.
+func (p recursePath) cycle() (recursePath, bool) {
+	last := p.uids[len(p.uids)-1]
+	for i, uid := range p.uids[:len(p.uids)-1] {
+		if uid == last {
+			return recursePath{uids: p.uids[i:], attrs: p.attrs[i:]}.copy(), true
+		}
+	}
+	return recursePath{}, false
+}
+
+// key identifies a cycle irrespective of the node it starts from, by rotating it so that it
+// starts from its smallest uid.
+func (p recursePath) key() string {
+	n := len(p.attrs)
+	start := 0
+	for i := 1; i < n; i++ {
+		if p.uids[i] < p.uids[start] {
+			start = i
+		}
+	}
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		j := (start + i) % n
+		fmt.Fprintf(&sb, "%d|%s|", p.uids[j], p.attrs[j])
+	}
+	return sb.String()
 }
@@ -20,6 +20,7 @@ import (
 	"container/heap"
 	"context"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/dgraph-io/dgraph/algo"
@@ -63,6 +64,37 @@ var errFacet = errors.Errorf("Skip the edge")
 
 type priorityQueue []*queueItem
 
+// passesThrough returns true if the route goes through at least one of the nodes in uids, or
+// if uids is empty.
+func (r *route) passesThrough(uids map[uint64]struct{}) bool {
+	if len(uids) == 0 {
+		return true
+	}
+	for _, it := range *r.route {
+		if _, ok := uids[it.uid]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sameWeight returns true if the weights of two paths are equal, allowing for the rounding
+// errors of adding up the weights of their edges in a different order.
+func sameWeight(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
+
+// less compares the uids of the nodes in two routes, in the order of the nodes.
+func (r *route) less(other *route) bool {
+	a, b := *r.route, *other.route
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i].uid != b[i].uid {
+			return a[i].uid < b[i].uid
+		}
+	}
+	return len(a) < len(b)
+}
+
 func (r *route) indexOf(uid uint64) int {
 	for i, val := range *r.route {
 		if val.uid == uid {
@@ -211,6 +243,10 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 					}
 
 					for lIdx, toUID := range subgraph.uidMatrix[mIdx].Uids {
+						if _, ok := sg.Params.Avoid[toUID]; ok {
+							// A path can't go through the nodes that have to be avoided.
+							continue
+						}
 						if adjacencyMap[fromUID] == nil {
 							adjacencyMap[fromUID] = make(map[uint64]mapItem)
 						}
@@ -265,7 +301,8 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 					// in the path again.
 					algo.ApplyFilter(temp.SrcUIDs, func(uid uint64, i int) bool {
 						_, ok := adjacencyMap[uid]
-						return !ok
+						_, avoid := sg.Params.Avoid[uid]
+						return !ok && !avoid
 					})
 					subgraph.Children = append(subgraph.Children, temp)
 					out = append(out, temp)
@@ -299,7 +336,11 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		return nil, errors.Errorf("Invalid shortest path query")
 	}
 
+	// Unless all the shortest paths are asked for, a single path is returned by default.
 	numPaths := sg.Params.NumPaths
+	if numPaths == 0 && !sg.Params.AllPaths {
+		numPaths = 1
+	}
 	var kroutes []route
 	pq := make(priorityQueue, 0)
 
@@ -317,6 +358,10 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	if sg.Params.ExploreDepth != nil {
 		maxHops = int(*sg.Params.ExploreDepth)
 	}
+	// A path can't have more than MaxHops edges, so there is no need to expand further.
+	if sg.Params.MaxHops > 0 && sg.Params.MaxHops < maxHops {
+		maxHops = sg.Params.MaxHops
+	}
 	if maxHops == 0 {
 		return nil, nil
 	}
@@ -334,11 +379,21 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	var stopExpansion bool
 	for pq.Len() > 0 {
 		item := heap.Pop(&pq).(*queueItem)
+		// Paths come out of the queue in the order of their weight, so once a path is heavier
+		// than the first path found, all the shortest paths have been found.
+		if sg.Params.AllPaths && len(kroutes) > 0 && item.cost > kroutes[0].totalWeight &&
+			!sameWeight(item.cost, kroutes[0].totalWeight) {
+			break
+		}
 		if item.uid == sg.Params.To {
 			// Ignore paths that do not meet the minimum weight requirement.
 			if item.cost < minWeight {
 				continue
 			}
+			// Ignore paths that don't go through any of the nodes in via.
+			if !item.path.passesThrough(sg.Params.Via) {
+				continue
+			}
 
 			// Add path to list after making a copy of the path in itemRoute. A copy of
 			// *item.path.route is required because it has to be put back in the sync pool and a
@@ -380,6 +435,10 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		default:
 		}
 		neighbours := adjacencyMap[item.uid]
+		if sg.Params.MaxHops > 0 && item.hop >= sg.Params.MaxHops {
+			// The path can't have any more edges.
+			neighbours = nil
+		}
 		for toUid, info := range neighbours {
 			cost := info.cost
 			// Skip neighbour if the cost is greater than the maximum weight allowed.
@@ -427,6 +486,13 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		sg.DestUIDs = &pb.List{}
 		return nil, nil
 	}
+	if sg.Params.AllPaths {
+		// All the paths have the same weight, order them by their nodes so that the result
+		// doesn't depend on the order in which they were found.
+		sort.Slice(kroutes, func(i, j int) bool {
+			return kroutes[i].less(&kroutes[j])
+		})
+	}
 	var res []uint64
 	for _, it := range *kroutes[0].route {
 		res = append(res, it.uid)
@@ -470,15 +536,27 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	if sg.Params.From == 0 || sg.Params.To == 0 {
 		return nil, nil
 	}
+	_, avoidFrom := sg.Params.Avoid[sg.Params.From]
+	_, avoidTo := sg.Params.Avoid[sg.Params.To]
+	if avoidFrom || avoidTo {
+		// There can't be a path between the nodes if one of them has to be avoided.
+		sg.DestUIDs = &pb.List{}
+		return nil, nil
+	}
 	numPaths := sg.Params.NumPaths
 	if numPaths == 0 {
 		// Return 1 path by default.
 		numPaths = 1
 	}
 
-	if numPaths > 1 {
+	// Djikstra's algorithm finds a single path, the other constraints need the paths to be
+	// enumerated.
+	if numPaths > 1 || sg.Params.AllPaths || len(sg.Params.Via) > 0 || sg.Params.MaxHops > 0 {
 		return runKShortestPaths(ctx, sg)
 	}
+	if sg.canRunBidirectional(ctx) {
+		return bidirectionalShortestPath(ctx, sg)
+	}
 	pq := make(priorityQueue, 0)
 
 	// Initialize and push the source node.
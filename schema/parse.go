@@ -73,6 +73,8 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Upsert = true
 	case "noconflict":
 		schema.NoConflict = true
+	case "unique":
+		schema.Unique = true
 	case "lang":
 		if t != types.StringID || schema.List {
 			return next.Errorf("@lang directive can only be specified for string type."+
@@ -255,6 +257,10 @@ func resolveTokenizers(updates []*pb.SchemaUpdate) error {
 				schema.Predicate, typ.Name())
 		}
 
+		if err := checkUnique(schema); err != nil {
+			return err
+		}
+
 		if typ == types.UidID {
 			continue
 		}
@@ -296,6 +302,25 @@ func resolveTokenizers(updates []*pb.SchemaUpdate) error {
 	return nil
 }
 
+// checkUnique verifies that a predicate with the @unique directive has an index whose tokens
+// identify its values, which is what the uniqueness of the values is checked against.
+func checkUnique(schema *pb.SchemaUpdate) error {
+	if !schema.Unique {
+		return nil
+	}
+	if schema.Lang {
+		return errors.Errorf("@unique isn't supported together with @lang on attr %s",
+			schema.Predicate)
+	}
+	for _, name := range schema.Tokenizer {
+		if tokenizer, ok := tok.GetTokenizer(name); ok && !tokenizer.IsLossy() {
+			return nil
+		}
+	}
+	return errors.Errorf("@unique on attr %s needs an index with a tokenizer that isn't lossy,"+
+		" like exact, hash, int or bool", schema.Predicate)
+}
+
 func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 	// Iterator is currently on the token corresponding to the keyword type.
 	if it.Item().Typ != itemText || it.Item().Val != "type" {
@@ -320,6 +345,14 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 		switch item.Typ {
 		case itemRightCurl:
 			it.Next()
+			for it.Item().Typ == itemAt {
+				index, err := parseTypeIndex(it)
+				if err != nil {
+					return nil, err
+				}
+				typeUpdate.Indexes = append(typeUpdate.Indexes, index)
+				it.Next()
+			}
 			if it.Item().Typ != itemNewLine && it.Item().Typ != lex.ItemEOF {
 				return nil, it.Item().Errorf(
 					"Expected new line or EOF after type declaration. Got %v", it.Item())
@@ -335,6 +368,9 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 
 				fieldSet[field.GetPredicate()] = struct{}{}
 			}
+			if err := checkTypeIndexes(typeUpdate, fieldSet); err != nil {
+				return nil, it.Item().Errorf("%v", err)
+			}
 
 			typeUpdate.Fields = fields
 			return typeUpdate, nil
@@ -353,6 +389,73 @@ func parseTypeDeclaration(it *lex.ItemIterator) (*pb.TypeUpdate, error) {
 	return nil, errors.Errorf("Shouldn't reach here.")
 }
 
+// parseTypeIndex works on the "@index(pred1, pred2, ...)" directive of a type, which declares a
+// composite index over the given fields of the type.
+func parseTypeIndex(it *lex.ItemIterator) (*pb.TypeUpdate_CompositeIndex, error) {
+	// Iterator is currently on the @ token.
+	it.Next()
+	if next := it.Item(); next.Typ != itemText || next.Val != "index" {
+		return nil, next.Errorf("Invalid directive for type: %v", next.Val)
+	}
+	it.Next()
+	if next := it.Item(); next.Typ != itemLeftRound {
+		return nil, next.Errorf("Expected ( after index directive of type. Got %v", next.Val)
+	}
+
+	index := &pb.TypeUpdate_CompositeIndex{}
+	expectArg := true
+	for {
+		it.Next()
+		next := it.Item()
+		switch {
+		case next.Typ == itemRightRound && !expectArg:
+			return index, nil
+		case next.Typ == itemText && expectArg:
+			index.Predicates = append(index.Predicates, next.Val)
+			expectArg = false
+		case next.Typ == itemComma && !expectArg:
+			expectArg = true
+		case expectArg:
+			return nil, next.Errorf("Expected a field name in index directive. Got %v", next.Val)
+		default:
+			return nil, next.Errorf("Expected a comma or ) in index directive. Got %v", next.Val)
+		}
+	}
+}
+
+// checkTypeIndexes verifies that the composite indexes of the type are over at least two of its
+// fields, and that no index is declared twice.
+func checkTypeIndexes(typ *pb.TypeUpdate, fieldSet map[string]struct{}) error {
+	seen := make(map[string]struct{})
+	for _, index := range typ.Indexes {
+		if len(index.Predicates) < 2 {
+			return errors.Errorf("Index of type %s must be over at least two fields",
+				typ.TypeName)
+		}
+		preds := make(map[string]struct{})
+		for _, pred := range index.Predicates {
+			if strings.HasPrefix(pred, "~") {
+				return errors.Errorf("Index of type %s can't be over the reverse field %s",
+					typ.TypeName, pred)
+			}
+			if _, ok := fieldSet[pred]; !ok {
+				return errors.Errorf("Index of type %s is over %s, which isn't a field of the type",
+					typ.TypeName, pred)
+			}
+			if _, ok := preds[pred]; ok {
+				return errors.Errorf("Index of type %s has duplicate field %s", typ.TypeName, pred)
+			}
+			preds[pred] = struct{}{}
+		}
+		key := strings.Join(index.Predicates, ",")
+		if _, ok := seen[key]; ok {
+			return errors.Errorf("Duplicate index (%s) in type %s", key, typ.TypeName)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
 func parseTypeField(it *lex.ItemIterator, typeName string) (*pb.SchemaUpdate, error) {
 	field := &pb.SchemaUpdate{Predicate: it.Item().Val}
 	var list bool
@@ -368,6 +368,45 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseUnique(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		email : string @index(exact, term) @unique .
+		code  : string @index(hash) @unique .
+		age   : int @index(int) @unique .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: "email",
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact", "term"},
+		Unique:    true,
+	}, result.Preds[0])
+}
+
+func TestParseUniqueErrors(t *testing.T) {
+	tests := []struct {
+		schema string
+		errMsg string
+	}{
+		{"email: string @unique .", "needs an index with a tokenizer that isn't lossy"},
+		{"email: string @index(term) @unique .", "needs an index with a tokenizer that isn't lossy"},
+		{"ratio: float @index(float) @unique .", "needs an index with a tokenizer that isn't lossy"},
+		{"friend: uid @unique .", "needs an index with a tokenizer that isn't lossy"},
+		{"name: string @index(exact) @lang @unique .", "isn't supported together with @lang"},
+	}
+	for _, test := range tests {
+		t.Run(test.schema, func(t *testing.T) {
+			reset()
+			_, err := Parse(test.schema)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), test.errMsg)
+		})
+	}
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -482,6 +521,50 @@ func TestParseTypeDuplicateFields(t *testing.T) {
 	require.Contains(t, err.Error(), "Duplicate fields with name: name")
 }
 
+func TestParseTypeIndexes(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Ticket {
+			tenant
+			status
+			priority
+		} @index(tenant, status) @index(tenant, status, priority)
+		type Person {
+			name
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Types))
+	require.Equal(t, []*pb.TypeUpdate_CompositeIndex{
+		{Predicates: []string{"tenant", "status"}},
+		{Predicates: []string{"tenant", "status", "priority"}},
+	}, result.Types[0].Indexes)
+	require.Nil(t, result.Types[1].Indexes)
+}
+
+func TestParseTypeIndexErrors(t *testing.T) {
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{"type T {\n a\n b\n} @index(a)", "must be over at least two fields"},
+		{"type T {\n a\n b\n} @index(a, c)", "is over c, which isn't a field of the type"},
+		{"type T {\n a\n b\n} @index(a, a)", "has duplicate field a"},
+		{"type T {\n a\n b\n} @index(a, b) @index(a, b)", "Duplicate index (a,b) in type T"},
+		{"type T {\n a\n b\n} @reverse(a, b)", "Invalid directive for type: reverse"},
+		{"type T {\n a\n b\n} @index(a, b", "Expected a comma or ) in index directive"},
+		{"type T {\n a\n b\n} @index(a,, b)", "Expected a field name in index directive"},
+		{"type T {\n a\n b\n} @index(a, b) type U {}",
+			"Expected new line or EOF after type declaration"},
+	}
+	for _, tc := range tests {
+		reset()
+		_, err := Parse(tc.schema)
+		require.Error(t, err, tc.schema)
+		require.Contains(t, err.Error(), tc.err, tc.schema)
+	}
+}
+
 func TestOldTypeFormat(t *testing.T) {
 	reset()
 	result, err := Parse(`
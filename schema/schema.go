@@ -21,6 +21,8 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/golang/glog"
@@ -61,6 +63,8 @@ func (s *state) init() {
 	s.types = make(map[string]*pb.TypeUpdate)
 	s.elog = trace.NewEventLog("Dgraph", "Schema")
 	s.mutSchema = make(map[string]*pb.SchemaUpdate)
+	s.composites = make(map[string][][]string)
+	s.building = make(map[string][]string)
 }
 
 type state struct {
@@ -71,6 +75,11 @@ type state struct {
 	elog      trace.EventLog
 	// mutSchema holds the schema update that is being applied in the background.
 	mutSchema map[string]*pb.SchemaUpdate
+	// composites maps every predicate to the composite indexes of the types that include it.
+	composites map[string][][]string
+	// building holds the composite indexes that are being built in the background, keyed by
+	// their predicates joined. Queries don't use them until they're built.
+	building map[string][]string
 }
 
 // State returns the struct holding the current schema.
@@ -93,6 +102,9 @@ func (s *state) DeleteAll() {
 	for pred := range s.mutSchema {
 		delete(s.mutSchema, pred)
 	}
+
+	s.composites = make(map[string][][]string)
+	s.building = make(map[string][]string)
 }
 
 // Delete updates the schema in memory and disk
@@ -135,6 +147,7 @@ func (s *state) DeleteType(typeName string) error {
 	}
 
 	delete(s.types, typeName)
+	s.composites = compositeIndexes(s.types)
 	return nil
 }
 
@@ -182,19 +195,51 @@ func (s *state) DeleteMutSchema(pred string) {
 	delete(s.mutSchema, pred)
 }
 
+// SetBuildingIndexes marks the given composite indexes as being built.
+func (s *state) SetBuildingIndexes(indexes [][]string) {
+	s.Lock()
+	defer s.Unlock()
+	for _, preds := range indexes {
+		s.building[strings.Join(preds, "\x00")] = preds
+	}
+}
+
+// DeleteBuildingIndexes marks the given composite indexes as built.
+func (s *state) DeleteBuildingIndexes(indexes [][]string) {
+	s.Lock()
+	defer s.Unlock()
+	for _, preds := range indexes {
+		delete(s.building, strings.Join(preds, "\x00"))
+	}
+}
+
+// IsBuildingIndex returns whether the composite index over the given predicates is being built.
+func (s *state) IsBuildingIndex(preds []string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	_, ok := s.building[strings.Join(preds, "\x00")]
+	return ok
+}
+
 // GetIndexingPredicates returns the list of predicates for which we are building indexes.
+// A composite index is listed under its first predicate, in whose index it's stored.
 func GetIndexingPredicates() []string {
 	s := State()
 	s.Lock()
 	defer s.Unlock()
-	if len(s.mutSchema) == 0 {
+	if len(s.mutSchema) == 0 && len(s.building) == 0 {
 		return nil
 	}
 
-	ps := make([]string, 0, len(s.mutSchema))
+	ps := make([]string, 0, len(s.mutSchema)+len(s.building))
 	for p := range s.mutSchema {
 		ps = append(ps, p)
 	}
+	for _, preds := range s.building {
+		if !x.HasString(ps, preds[0]) {
+			ps = append(ps, preds[0])
+		}
+	}
 	return ps
 }
 
@@ -204,9 +249,78 @@ func (s *state) SetType(typeName string, typ pb.TypeUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.types[typeName] = &typ
+	s.composites = compositeIndexes(s.types)
 	s.elog.Printf(logTypeUpdate(typ, typeName))
 }
 
+// compositeIndexes maps every predicate to the composite indexes of the given types that
+// include it. An index declared by several types is only listed once.
+func compositeIndexes(typs map[string]*pb.TypeUpdate) map[string][][]string {
+	composites := make(map[string][][]string)
+	seen := make(map[string]struct{})
+	for _, typ := range typs {
+		for _, index := range typ.Indexes {
+			key := strings.Join(index.Predicates, "\x00")
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			for _, pred := range index.Predicates {
+				composites[pred] = append(composites[pred], index.Predicates)
+			}
+		}
+	}
+	return composites
+}
+
+// allCompositeIndexes returns every composite index of the given map, sorted.
+func allCompositeIndexes(composites map[string][][]string) [][]string {
+	var out [][]string
+	for pred, indexes := range composites {
+		for _, index := range indexes {
+			// Every index is listed under its first predicate.
+			if index[0] == pred {
+				out = append(out, index)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return strings.Join(out[i], "\x00") < strings.Join(out[j], "\x00")
+	})
+	return out
+}
+
+// CompositeIndexes returns the composite indexes that include the given predicate.
+func (s *state) CompositeIndexes(pred string) [][]string {
+	if s == nil {
+		return nil
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	return s.composites[pred]
+}
+
+// AllCompositeIndexes returns the composite indexes of all the types.
+func (s *state) AllCompositeIndexes() [][]string {
+	s.RLock()
+	defer s.RUnlock()
+	return allCompositeIndexes(s.composites)
+}
+
+// CompositeIndexesWithType returns the composite indexes of all the types once the given type
+// replaces the current definition of its type.
+func (s *state) CompositeIndexesWithType(typ *pb.TypeUpdate) [][]string {
+	s.RLock()
+	defer s.RUnlock()
+	typs := make(map[string]*pb.TypeUpdate, len(s.types)+1)
+	for name, t := range s.types {
+		typs[name] = t
+	}
+	typs[typ.TypeName] = typ
+	return allCompositeIndexes(compositeIndexes(typs))
+}
+
 // Get gets the schema for the given predicate.
 func (s *state) Get(ctx context.Context, pred string) (pb.SchemaUpdate, bool) {
 	isWrite, _ := ctx.Value(isWrite).(bool)
@@ -395,6 +509,13 @@ func (s *state) HasUpsert(pred string) bool {
 	return false
 }
 
+// IsUnique returns whether the predicate has the @unique directive.
+func (s *state) IsUnique(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.predicate[pred].GetUnique()
+}
+
 func (s *state) HasLang(pred string) bool {
 	s.RLock()
 	defer s.RUnlock()
@@ -410,11 +531,19 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// IsBeingIndexed returns whether the indexes of the given predicate are being built.
+func (s *state) IsBeingIndexed(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	_, ok := s.mutSchema[pred]
+	return ok
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
 	defer s.RUnlock()
-	return len(s.mutSchema) > 0
+	return len(s.mutSchema) > 0 || len(s.building) > 0
 }
 
 // Init resets the schema state, setting the underlying DB to the given pointer.
@@ -453,6 +582,34 @@ func Load(predicate string) error {
 	return nil
 }
 
+// LoadType reads the definition of the given type from the DB, and removes it from memory if
+// it isn't stored.
+func LoadType(typeName string) error {
+	txn := pstore.NewTransactionAt(1, false)
+	defer txn.Discard()
+	item, err := txn.Get(x.TypeKey(typeName))
+	if err == badger.ErrKeyNotFound {
+		s := State()
+		s.Lock()
+		defer s.Unlock()
+		delete(s.types, typeName)
+		s.composites = compositeIndexes(s.types)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var t pb.TypeUpdate
+	err = item.Value(func(val []byte) error {
+		return t.Unmarshal(val)
+	})
+	if err != nil {
+		return err
+	}
+	State().SetType(typeName, t)
+	return nil
+}
+
 // LoadFromDb reads schema information from db and stores it in memory
 func LoadFromDb() error {
 	if err := LoadSchemaFromDb(); err != nil {
@@ -577,6 +734,17 @@ func initialTypesInternal(all bool) []*pb.TypeUpdate {
 					ValueType: pb.Posting_DATETIME,
 				},
 			},
+		}, &pb.TypeUpdate{
+			TypeName: "dgraph.dql",
+			Fields: []*pb.SchemaUpdate{
+				{
+					Predicate: "dgraph.dql.id",
+					ValueType: pb.Posting_STRING,
+				}, {
+					Predicate: "dgraph.dql.query",
+					ValueType: pb.Posting_STRING,
+				},
+			},
 		})
 
 	if all || x.WorkerConfig.AclEnabled {
@@ -678,6 +846,15 @@ func initialSchemaInternal(all bool) []*pb.SchemaUpdate {
 		}, &pb.SchemaUpdate{
 			Predicate: "dgraph.graphql.schema_created_at",
 			ValueType: pb.Posting_DATETIME,
+		}, &pb.SchemaUpdate{
+			Predicate: "dgraph.dql.id",
+			ValueType: pb.Posting_STRING,
+			Directive: pb.SchemaUpdate_INDEX,
+			Tokenizer: []string{"exact"},
+			Upsert:    true,
+		}, &pb.SchemaUpdate{
+			Predicate: "dgraph.dql.query",
+			ValueType: pb.Posting_STRING,
 		})
 
 	if all || x.WorkerConfig.AclEnabled {
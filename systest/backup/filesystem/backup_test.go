@@ -109,8 +109,9 @@ func TestBackupFilesystem(t *testing.T) {
 	// Check the predicates and types in the schema are as expected.
 	// TODO: refactor tests so that minio and filesystem tests share most of their logic.
 	preds := []string{"dgraph.graphql.schema", "dgraph.cors", "dgraph.graphql.xid", "dgraph.type", "movie",
-		"dgraph.graphql.schema_history", "dgraph.graphql.schema_created_at"}
-	types := []string{"Node", "dgraph.graphql", "dgraph.graphql.history"}
+		"dgraph.graphql.schema_history", "dgraph.graphql.schema_created_at", "dgraph.dql.id",
+		"dgraph.dql.query"}
+	types := []string{"Node", "dgraph.graphql", "dgraph.graphql.history", "dgraph.dql"}
 	testutil.CheckSchema(t, preds, types)
 
 	checks := []struct {
@@ -58,7 +58,7 @@ func TestExportSchemaToMinio(t *testing.T) {
 	for _, f := range getFromJSON(result, "data", "export", "exportedFiles").([]interface{}) {
 		files = append(files, f.(string))
 	}
-	require.Equal(t, 3, len(files))
+	require.Equal(t, 4, len(files))
 
 	schemaFile := files[1]
 	require.Contains(t, schemaFile, ".schema.gz")
@@ -83,6 +83,8 @@ var expectedSchema = `<movie>:string .` + " " + `
 <dgraph.graphql.schema>:string .` + " " + `
 <dgraph.graphql.schema_history>:string .` + " " + `
 <dgraph.graphql.schema_created_at>:datetime .` + " " + `
+<dgraph.dql.id>:string @index(exact) @upsert .` + " " + `
+<dgraph.dql.query>:string .` + " " + `
 type Node {
 	movie
 }
@@ -94,6 +96,10 @@ type dgraph.graphql.history {
 	dgraph.graphql.schema_history
 	dgraph.graphql.schema_created_at
 }
+type dgraph.dql {
+	dgraph.dql.id
+	dgraph.dql.query
+}
 `
 
 func setupDgraph(t *testing.T) {
@@ -104,6 +104,7 @@ func TestSystem(t *testing.T) {
 	t.Run("overwrite uid predicates reverse index", wrap(OverwriteUidPredicatesReverse))
 	t.Run("delete and query same txn", wrap(DeleteAndQuerySameTxn))
 	t.Run("add and query zero datetime value", wrap(AddAndQueryZeroTimeValue))
+	t.Run("unique directive", wrap(UniqueDirective))
 }
 
 func FacetJsonInputSupportsAnyOfTerms(t *testing.T, c *dgo.Dgraph) {
@@ -636,7 +637,7 @@ func SchemaAfterDeleteNode(t *testing.T, c *dgo.Dgraph) {
 
 	resp, err := c.NewTxn().Query(ctx, `schema{}`)
 	require.NoError(t, err)
-	testutil.CompareJSON(t, asJson(`[`+x.CorsPredicate+","+
+	testutil.CompareJSON(t, asJson(`[`+x.CorsPredicate+","+x.DqlPredicates+","+
 		x.AclPredicates+","+x.GraphqlPredicates+","+
 		`{"predicate":"friend","type":"uid","list":true},`+
 		`{"predicate":"married","type":"bool"},`+
@@ -659,7 +660,7 @@ func SchemaAfterDeleteNode(t *testing.T, c *dgo.Dgraph) {
 	resp, err = c.NewTxn().Query(ctx, `schema{}`)
 	require.NoError(t, err)
 	testutil.CompareJSON(t, asJson(`[`+
-		x.AclPredicates+","+x.CorsPredicate+","+
+		x.AclPredicates+","+x.CorsPredicate+","+x.DqlPredicates+","+
 		x.GraphqlPredicates+","+
 		`{"predicate":"friend","type":"uid","list":true},`+
 		`{"predicate":"name","type":"default"},`+
@@ -2485,3 +2486,91 @@ func AddAndQueryZeroTimeValue(t *testing.T, c *dgo.Dgraph) {
 		]
 	  }`, string(resp.Json))
 }
+
+func UniqueDirective(t *testing.T, c *dgo.Dgraph) {
+	ctx := context.Background()
+
+	op := &api.Operation{Schema: `email: string @index(exact) @unique .`}
+	require.NoError(t, c.Alter(ctx, op))
+
+	resp, err := c.NewTxn().Mutate(ctx, &api.Mutation{
+		CommitNow: true,
+		SetNquads: []byte(`
+			_:alice <email> "alice@dgraph.io" .
+			_:bob <email> "bob@dgraph.io" .
+		`),
+	})
+	require.NoError(t, err)
+	alice, bob := resp.Uids["alice"], resp.Uids["bob"]
+
+	// A second node can't get the value.
+	_, err = c.NewTxn().Mutate(ctx, &api.Mutation{
+		CommitNow: true,
+		SetNquads: []byte(fmt.Sprintf(`<%s> <email> "alice@dgraph.io" .`, bob)),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Could not enforce @unique")
+
+	// Neither can a new one, even when the mutation isn't committed right away.
+	_, err = c.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:carol <email> "bob@dgraph.io" .`),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Could not enforce @unique")
+
+	// Setting the value again on the node that has it is fine.
+	_, err = c.NewTxn().Mutate(ctx, &api.Mutation{
+		CommitNow: true,
+		SetNquads: []byte(fmt.Sprintf(`<%s> <email> "alice@dgraph.io" .`, alice)),
+	})
+	require.NoError(t, err)
+
+	// A value can be moved from a node to another in a single mutation.
+	_, err = c.NewTxn().Mutate(ctx, &api.Mutation{
+		CommitNow: true,
+		DelNquads: []byte(fmt.Sprintf(`<%s> <email> * .`, alice)),
+		SetNquads: []byte(fmt.Sprintf(`<%s> <email> "alice@dgraph.io" .`, bob)),
+	})
+	require.NoError(t, err)
+
+	// Of two concurrent transactions giving the same value to different nodes, only one
+	// commits.
+	txn1, txn2 := c.NewTxn(), c.NewTxn()
+	_, err = txn1.Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:dave <email> "dave@dgraph.io" .`),
+	})
+	require.NoError(t, err)
+	_, err = txn2.Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:erin <email> "dave@dgraph.io" .`),
+	})
+	require.NoError(t, err)
+	require.NoError(t, txn1.Commit(ctx))
+	require.Equal(t, dgo.ErrAborted, txn2.Commit(ctx))
+
+	resp, err = c.NewReadOnlyTxn().Query(ctx, `{
+		q(func: has(email), orderasc: email) {
+			email
+		}
+	}`)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"q": [
+		{"email": "alice@dgraph.io"},
+		{"email": "dave@dgraph.io"}
+	]}`, string(resp.GetJson()))
+
+	// The directive can't be added to a predicate whose values aren't unique.
+	op = &api.Operation{Schema: `name: string @index(exact) .`}
+	require.NoError(t, c.Alter(ctx, op))
+	_, err = c.NewTxn().Mutate(ctx, &api.Mutation{
+		CommitNow: true,
+		SetNquads: []byte(`
+			_:a <name> "Alice" .
+			_:b <name> "Alice" .
+		`),
+	})
+	require.NoError(t, err)
+	op = &api.Operation{Schema: `name: string @index(exact) @unique .`}
+	err = c.Alter(ctx, op)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Could not enforce @unique")
+}
@@ -336,7 +336,8 @@ func SchemaQueryTest(t *testing.T, c *dgo.Dgraph) {
 	require.NoError(t, err)
 	js := `
   {
-    "schema": [` + x.CorsPredicate + "," + x.AclPredicates + `,` + x.GraphqlPredicates + `,
+    "schema": [` + x.CorsPredicate + "," + x.DqlPredicates + "," + x.AclPredicates + `,` +
+		x.GraphqlPredicates + `,
       {
         "predicate": "dgraph.type",
         "type": "string",
@@ -419,6 +420,12 @@ func SchemaQueryTestPredicate1(t *testing.T, c *dgo.Dgraph) {
 	  {
         "predicate": "dgraph.graphql.xid"
 	  },
+	  {
+        "predicate": "dgraph.dql.id"
+	  },
+	  {
+        "predicate": "dgraph.dql.query"
+	  },
       {
         "predicate": "dgraph.user.group"
       },
@@ -560,7 +567,8 @@ func SchemaQueryTestHTTP(t *testing.T, c *dgo.Dgraph) {
 
 	js := `
   {
-    "schema": [` + x.CorsPredicate + `,` + x.AclPredicates + `,` + x.GraphqlPredicates + `,
+    "schema": [` + x.CorsPredicate + `,` + x.DqlPredicates + `,` + x.AclPredicates + `,` +
+		x.GraphqlPredicates + `,
       {
         "index": true,
         "predicate": "dgraph.type",
@@ -0,0 +1,75 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// A composite index is an index over the values of several predicates of the same nodes. Its
+// tokens are stored in the index of the first predicate, and are made of the names of the
+// other predicates followed by the values of all the predicates, so that a node is found by
+// the values of all of them with a single lookup.
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+// CompositePrefix returns the prefix of all the tokens of the composite index over preds. The
+// prefixes of two composite indexes of the same first predicate are never a prefix of each
+// other, so the tokens of every index can be dropped on their own.
+func CompositePrefix(preds []string) string {
+	buf := []byte{IdentComposite}
+	buf = appendUvarint(buf, uint64(len(preds)-1))
+	for _, pred := range preds[1:] {
+		buf = appendUvarint(buf, uint64(len(pred)))
+		buf = append(buf, pred...)
+	}
+	return string(buf)
+}
+
+// CompositeToken returns the token of the composite index over preds for the given values of
+// the predicates, in the same order. The values must already be of the schema types of their
+// predicates.
+func CompositeToken(preds []string, vals []types.Val) (string, error) {
+	if len(preds) != len(vals) {
+		return "", errors.Errorf("Composite index over %d predicates got %d values",
+			len(preds), len(vals))
+	}
+	buf := []byte(CompositePrefix(preds))
+	for _, val := range vals {
+		// The same instant is given the same token whatever its time zone.
+		if t, ok := val.Value.(time.Time); ok {
+			val.Value = t.UTC()
+		}
+		data := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(val, &data); err != nil {
+			return "", err
+		}
+		b := data.Value.([]byte)
+		buf = appendUvarint(buf, uint64(len(b)))
+		buf = append(buf, b...)
+	}
+	return string(buf), nil
+}
@@ -21,11 +21,16 @@ import (
 	"plugin"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/golang/glog"
 	geom "github.com/twpayne/go-geom"
 	"golang.org/x/crypto/blake2b"
+	"golang.org/x/text/cases"
 	"golang.org/x/text/collate"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
 
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
@@ -52,6 +57,12 @@ const (
 	IdentBool      = 0x9
 	IdentTrigram   = 0xA
 	IdentHash      = 0xB
+	IdentVector    = 0xC
+	IdentComposite = 0xD
+	IdentExactCI   = 0xE
+	IdentHashCI    = 0xF
+	IdentExactCIAI = 0x10
+	IdentHashCIAI  = 0x11
 	IdentCustom    = 0x80
 	IdentDelimiter = 0x1f // ASCII 31 - Unit seperator
 )
@@ -87,6 +98,7 @@ var tokenizers = make(map[string]Tokenizer)
 
 func init() {
 	registerTokenizer(GeoTokenizer{})
+	registerTokenizer(VectorTokenizer{})
 	registerTokenizer(IntTokenizer{})
 	registerTokenizer(FloatTokenizer{})
 	registerTokenizer(YearTokenizer{})
@@ -97,6 +109,10 @@ func init() {
 	registerTokenizer(BoolTokenizer{})
 	registerTokenizer(TrigramTokenizer{})
 	registerTokenizer(HashTokenizer{})
+	registerTokenizer(ExactCITokenizer{})
+	registerTokenizer(ExactCITokenizer{stripAccents: true})
+	registerTokenizer(HashCITokenizer{})
+	registerTokenizer(HashCITokenizer{stripAccents: true})
 	registerTokenizer(TermTokenizer{})
 	registerTokenizer(FullTextTokenizer{})
 	setupBleve()
@@ -185,6 +201,20 @@ func (t GeoTokenizer) Identifier() byte { return IdentGeo }
 func (t GeoTokenizer) IsSortable() bool { return false }
 func (t GeoTokenizer) IsLossy() bool    { return true }
 
+// VectorTokenizer generates locality sensitive hashing tokens from vector data. Vectors pointing
+// in similar directions are likely to share tokens, which makes it usable for nearest neighbour
+// search by cosine similarity.
+type VectorTokenizer struct{}
+
+func (t VectorTokenizer) Name() string { return "vector" }
+func (t VectorTokenizer) Type() string { return "float32vector" }
+func (t VectorTokenizer) Tokens(v interface{}) ([]string, error) {
+	return types.IndexVFloatTokens(v.([]float32))
+}
+func (t VectorTokenizer) Identifier() byte { return IdentVector }
+func (t VectorTokenizer) IsSortable() bool { return false }
+func (t VectorTokenizer) IsLossy() bool    { return true }
+
 // IntTokenizer generates tokens from integer data.
 type IntTokenizer struct{}
 
@@ -444,6 +474,89 @@ func (t HashTokenizer) IsSortable() bool { return false }
 // query operations using the hash index.
 func (t HashTokenizer) IsLossy() bool { return false }
 
+// FoldingTokenizer is implemented by the tokenizers that fold strings before tokenizing them,
+// so that strings which only differ in case (or accents) get the same tokens.
+type FoldingTokenizer interface {
+	Tokenizer
+	// Fold returns the string that s is tokenized as.
+	Fold(s string) string
+}
+
+// foldString applies Unicode case folding to s and, if stripAccents is set, also removes the
+// accents by dropping the nonspacing marks of its NFKD decomposition.
+func foldString(s string, stripAccents bool) string {
+	// Casers and transformers keep state, so they can't be shared between goroutines.
+	s = cases.Fold().String(s)
+	if !stripAccents {
+		return s
+	}
+	t := transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	stripped, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return stripped
+}
+
+// ExactCITokenizer returns the case folded string as a token, so that exact matches and
+// sorting ignore case. With stripAccents the accents are removed too.
+type ExactCITokenizer struct{ stripAccents bool }
+
+func (t ExactCITokenizer) Name() string {
+	if t.stripAccents {
+		return "exact_ci_ai"
+	}
+	return "exact_ci"
+}
+func (t ExactCITokenizer) Type() string { return "string" }
+func (t ExactCITokenizer) Tokens(v interface{}) ([]string, error) {
+	val, ok := v.(string)
+	if !ok {
+		return nil, errors.Errorf("Exact indices only supported for string types")
+	}
+	return []string{t.Fold(val)}, nil
+}
+func (t ExactCITokenizer) Identifier() byte {
+	if t.stripAccents {
+		return IdentExactCIAI
+	}
+	return IdentExactCI
+}
+func (t ExactCITokenizer) IsSortable() bool     { return true }
+func (t ExactCITokenizer) IsLossy() bool        { return false }
+func (t ExactCITokenizer) Fold(s string) string { return foldString(s, t.stripAccents) }
+
+// HashCITokenizer returns the hash of the case folded string as a token, so that equality
+// ignores case. With stripAccents the accents are removed too.
+type HashCITokenizer struct{ stripAccents bool }
+
+func (t HashCITokenizer) Name() string {
+	if t.stripAccents {
+		return "hash_ci_ai"
+	}
+	return "hash_ci"
+}
+func (t HashCITokenizer) Type() string { return "string" }
+func (t HashCITokenizer) Tokens(v interface{}) ([]string, error) {
+	term, ok := v.(string)
+	if !ok {
+		return nil, errors.Errorf("Hash tokenizer only supported for string types")
+	}
+	return HashTokenizer{}.Tokens(t.Fold(term))
+}
+func (t HashCITokenizer) Identifier() byte {
+	if t.stripAccents {
+		return IdentHashCIAI
+	}
+	return IdentHashCI
+}
+func (t HashCITokenizer) IsSortable() bool { return false }
+
+// IsLossy is false for the same reason as for the HashTokenizer. The values are compared
+// after folding them.
+func (t HashCITokenizer) IsLossy() bool        { return false }
+func (t HashCITokenizer) Fold(s string) string { return foldString(s, t.stripAccents) }
+
 // PluginTokenizer is implemented by external plugins loaded dynamically via
 // *.so files. It follows the implementation semantics of the Tokenizer
 // interface.
@@ -486,6 +599,13 @@ func EncodeGeoTokens(tokens []string) {
 	}
 }
 
+// EncodeVectorTokens encodes the given list of tokens as vector tokens.
+func EncodeVectorTokens(tokens []string) {
+	for i := 0; i < len(tokens); i++ {
+		tokens[i] = encodeToken(tokens[i], VectorTokenizer{}.Identifier())
+	}
+}
+
 // EncodeRegexTokens encodes the given list of strings as regex tokens.
 func EncodeRegexTokens(tokens []string) {
 	for i := 0; i < len(tokens); i++ {
@@ -19,10 +19,13 @@ package tok
 import (
 	"math"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/types"
 )
 
 type encL struct {
@@ -73,6 +76,19 @@ func TestFullTextTokenizer(t *testing.T) {
 	require.Equal(t, []string{encodeToken("stem", id), encodeToken("work", id)}, tokens)
 }
 
+func TestVectorTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("vector")
+	require.True(t, has)
+	require.True(t, tokenizer.IsLossy())
+
+	tokens, err := BuildTokens([]float32{0.1, 0.2, 0.3}, tokenizer)
+	require.NoError(t, err)
+	require.Len(t, tokens, types.VectorTables)
+	for _, token := range tokens {
+		require.Equal(t, byte(IdentVector), token[0])
+	}
+}
+
 func TestHourTokenizer(t *testing.T) {
 	var err error
 	tokenizer, has := GetTokenizer("hour")
@@ -191,6 +207,42 @@ func TestTrigramTokenizer(t *testing.T) {
 	require.Equal(t, expected, tokens)
 }
 
+func TestExactCITokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("exact_ci")
+	require.True(t, has)
+	require.True(t, tokenizer.IsSortable())
+	require.False(t, tokenizer.IsLossy())
+	tokens, err := BuildTokens("Straße ÉCOLE", tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken("strasse école", IdentExactCI)}, tokens)
+
+	tokenizer, has = GetTokenizer("exact_ci_ai")
+	require.True(t, has)
+	tokens, err = BuildTokens("Straße ÉCOLE", tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken("strasse ecole", IdentExactCIAI)}, tokens)
+
+	_, err = BuildTokens(42, tokenizer)
+	require.Error(t, err)
+}
+
+func TestHashCITokenizer(t *testing.T) {
+	build := func(name, val string) string {
+		tokenizer, has := GetTokenizer(name)
+		require.True(t, has)
+		tokens, err := BuildTokens(val, tokenizer)
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		return tokens[0]
+	}
+	require.Equal(t, build("hash_ci", "Alice@Example.com"), build("hash_ci", "alice@example.COM"))
+	require.NotEqual(t, build("hash_ci", "José"), build("hash_ci", "jose"))
+	require.Equal(t, build("hash_ci_ai", "José"), build("hash_ci_ai", "JOSE"))
+	require.NotEqual(t, build("hash_ci", "alice"), build("hash_ci", "bob"))
+	require.Equal(t, byte(IdentHashCI), build("hash_ci", "alice")[0])
+	require.Equal(t, byte(IdentHashCIAI), build("hash_ci_ai", "alice")[0])
+}
+
 func TestGetFullTextTokens(t *testing.T) {
 	val := "Our chief weapon is surprise...surprise and fear...fear and surprise...." +
 		"Our two weapons are fear and surprise...and ruthless efficiency.... " +
@@ -315,6 +367,44 @@ func TestTermTokenizeCJKChinese(t *testing.T) {
 
 }
 
+func TestCompositeToken(t *testing.T) {
+	preds := []string{"tenant", "status"}
+	token := func(tenant, status string) string {
+		tok, err := CompositeToken(preds, []types.Val{
+			{Tid: types.StringID, Value: tenant},
+			{Tid: types.StringID, Value: status},
+		})
+		require.NoError(t, err)
+		return tok
+	}
+	require.Equal(t, token("a", "open"), token("a", "open"))
+	require.NotEqual(t, token("a", "open"), token("a", "closed"))
+	require.NotEqual(t, token("ab", "c"), token("a", "bc"))
+	require.Equal(t, byte(IdentComposite), token("a", "open")[0])
+	require.True(t, strings.HasPrefix(token("a", "open"), CompositePrefix(preds)))
+
+	// The prefixes of the indexes of the same first predicate don't overlap.
+	p1 := CompositePrefix([]string{"tenant", "status"})
+	p2 := CompositePrefix([]string{"tenant", "status", "priority"})
+	require.False(t, strings.HasPrefix(p1, p2))
+	require.False(t, strings.HasPrefix(p2, p1))
+
+	// The same instant has the same token in every time zone.
+	utc := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	ist := utc.In(time.FixedZone("IST", 5*3600+1800))
+	dt := []string{"tenant", "created"}
+	tok1, err := CompositeToken(dt, []types.Val{
+		{Tid: types.StringID, Value: "a"}, {Tid: types.DateTimeID, Value: utc}})
+	require.NoError(t, err)
+	tok2, err := CompositeToken(dt, []types.Val{
+		{Tid: types.StringID, Value: "a"}, {Tid: types.DateTimeID, Value: ist}})
+	require.NoError(t, err)
+	require.Equal(t, tok1, tok2)
+
+	_, err = CompositeToken(preds, []types.Val{{Tid: types.StringID, Value: "a"}})
+	require.Error(t, err)
+}
+
 func checkSortedAndUnique(t *testing.T, tokens []string) {
 	if !sort.StringsAreSorted(tokens) {
 		t.Error("tokens were not sorted")
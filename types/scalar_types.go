@@ -55,21 +55,24 @@ const (
 	PasswordID = TypeID(pb.Posting_PASSWORD)
 	// StringID represents the string type.
 	StringID = TypeID(pb.Posting_STRING)
+	// VFloatID represents the vector of float32 type, used to store embeddings.
+	VFloatID = TypeID(pb.Posting_VFLOAT)
 	// UndefinedID represents the undefined type.
 	UndefinedID = TypeID(100)
 )
 
 var typeNameMap = map[string]TypeID{
-	"default":  DefaultID,
-	"binary":   BinaryID,
-	"int":      IntID,
-	"float":    FloatID,
-	"bool":     BoolID,
-	"datetime": DateTimeID,
-	"geo":      GeoID,
-	"uid":      UidID,
-	"string":   StringID,
-	"password": PasswordID,
+	"default":       DefaultID,
+	"binary":        BinaryID,
+	"int":           IntID,
+	"float":         FloatID,
+	"bool":          BoolID,
+	"datetime":      DateTimeID,
+	"geo":           GeoID,
+	"uid":           UidID,
+	"string":        StringID,
+	"password":      PasswordID,
+	"float32vector": VFloatID,
 }
 
 // TypeID represents the type of the data.
@@ -103,6 +106,8 @@ func (t TypeID) Name() string {
 		return "string"
 	case PasswordID:
 		return "password"
+	case VFloatID:
+		return "float32vector"
 	}
 	return ""
 }
@@ -187,6 +192,10 @@ func ValueForType(id TypeID) Val {
 		var p string
 		return Val{PasswordID, p}
 
+	case VFloatID:
+		var v []float32
+		return Val{VFloatID, &v}
+
 	default:
 		return Val{}
 	}
@@ -84,6 +84,18 @@ func (s byValue) Less(i, j int) bool {
 	return false
 }
 
+type byValueAndUid struct{ sortBase }
+
+// Less compares two elements by their values and then by their uids, so that elements with
+// equal values always end up in the same order.
+// skipcq: CRT-P0003
+func (s byValueAndUid) Less(i, j int) bool {
+	if c := compareVals(s.values[i], s.values[j], s.desc, s.cl); c != 0 {
+		return c < 0
+	}
+	return (*s.ul)[i] < (*s.ul)[j]
+}
+
 // IsSortable returns true, if tid is sortable. Otherwise it returns false.
 func IsSortable(tid TypeID) bool {
 	switch tid {
@@ -107,16 +119,7 @@ func SortWithFacet(v [][]Val, ul *[]uint64, l []*pb.Facets, desc []bool, lang st
 		}
 	}
 
-	var cl *collate.Collator
-	if lang != "" {
-		// Collator is nil if we are unable to parse the language.
-		// We default to bytewise comparison in that case.
-		if langTag, err := language.Parse(lang); err == nil {
-			cl = collate.New(langTag)
-		}
-	}
-
-	b := sortBase{v, desc, ul, l, cl}
+	b := sortBase{v, desc, ul, l, collator(lang)}
 	toBeSorted := byValue{b}
 	sort.Sort(toBeSorted)
 	return nil
@@ -127,6 +130,73 @@ func Sort(v [][]Val, ul *[]uint64, desc []bool, lang string) error {
 	return SortWithFacet(v, ul, nil, desc, lang)
 }
 
+// SortWithUid sorts the given array in-place like Sort, but orders uids with equal values by
+// the uid, so that the order is the same every time. Cursors used for pagination rely on this.
+func SortWithUid(v [][]Val, ul *[]uint64, desc []bool, lang string) error {
+	if len(v) == 0 || len(v[0]) == 0 {
+		return nil
+	}
+
+	for _, val := range v[0] {
+		if !IsSortable(val.Tid) {
+			return errors.Errorf("Value of type: %s isn't sortable", val.Tid.Name())
+		}
+	}
+
+	b := sortBase{v, desc, ul, nil, collator(lang)}
+	sort.Sort(byValueAndUid{b})
+	return nil
+}
+
+// CompareVals compares the values a and b as they are compared while sorting in the order
+// given by desc. It returns a negative number if a comes before b, zero if they are equal
+// and a positive number if a comes after b.
+func CompareVals(a, b []Val, desc []bool, lang string) int {
+	return compareVals(a, b, desc, collator(lang))
+}
+
+func compareVals(a, b []Val, desc []bool, cl *collate.Collator) int {
+	for vidx := 0; vidx < len(a) && vidx < len(b); vidx++ {
+		// Null value is considered greatest, as in byValue.
+		c := 0
+		switch {
+		case a[vidx].Value == nil && b[vidx].Value == nil:
+		case a[vidx].Value == nil:
+			c = 1
+		case b[vidx].Value == nil:
+			c = -1
+		case equal(a[vidx], b[vidx]):
+		case less(a[vidx], b[vidx], cl):
+			c = -1
+		case less(b[vidx], a[vidx], cl):
+			c = 1
+		}
+		if c == 0 {
+			// We have to look at next value to decide.
+			continue
+		}
+		if desc[vidx] {
+			return -c
+		}
+		return c
+	}
+	return 0
+}
+
+// collator returns the collator for the given language, or nil if there isn't any.
+func collator(lang string) *collate.Collator {
+	if lang == "" {
+		return nil
+	}
+	// Collator is nil if we are unable to parse the language.
+	// We default to bytewise comparison in that case.
+	langTag, err := language.Parse(lang)
+	if err != nil {
+		return nil
+	}
+	return collate.New(langTag)
+}
+
 // Less returns true if a is strictly less than b.
 func Less(a, b Val) (bool, error) {
 	if a.Tid != b.Tid {
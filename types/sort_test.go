@@ -204,3 +204,29 @@ func TestSortMismatchedTypes(t *testing.T) {
 	require.True(t, idx21 < idx33)
 	require.True(t, idx33 < idx55)
 }
+
+func TestSortWithUid(t *testing.T) {
+	list := getInput(t, IntID, []string{"22", "11", "22", "11"})
+	ul := &pb.List{Uids: []uint64{400, 300, 100, 200}}
+	require.NoError(t, SortWithUid(list, &ul.Uids, []bool{false}, ""))
+	require.EqualValues(t, []uint64{200, 300, 100, 400}, ul.Uids)
+
+	list = getInput(t, IntID, []string{"22", "11", "22", "11"})
+	ul = &pb.List{Uids: []uint64{400, 300, 100, 200}}
+	require.NoError(t, SortWithUid(list, &ul.Uids, []bool{true}, ""))
+	require.EqualValues(t, []uint64{100, 400, 200, 300}, ul.Uids)
+}
+
+func TestCompareVals(t *testing.T) {
+	one := []Val{{Tid: IntID, Value: int64(1)}, {Tid: StringID, Value: "b"}}
+	two := []Val{{Tid: IntID, Value: int64(1)}, {Tid: StringID, Value: "c"}}
+	null := []Val{{Tid: IntID, Value: int64(1)}, {}}
+
+	require.Equal(t, 0, CompareVals(one, one, []bool{false, false}, ""))
+	require.True(t, CompareVals(one, two, []bool{false, false}, "") < 0)
+	require.True(t, CompareVals(one, two, []bool{false, true}, "") > 0)
+	// Null values come last in ascending order and first in descending order.
+	require.True(t, CompareVals(null, two, []bool{false, false}, "") > 0)
+	require.True(t, CompareVals(null, two, []bool{false, true}, "") < 0)
+	require.Equal(t, 0, CompareVals(null, null, []bool{false, false}, ""))
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseVFloat parses a vector given as a list of numbers, e.g. "[0.1, 0.2, 0.3]". The brackets
+// are optional, and the numbers can be separated by commas or white space.
+func ParseVFloat(s string) ([]float32, error) {
+	trimmed := strings.TrimSpace(s)
+	if strings.HasPrefix(trimmed, "[") {
+		if !strings.HasSuffix(trimmed, "]") {
+			return nil, errors.Errorf("Vector %q must end with ]", s)
+		}
+		trimmed = trimmed[1 : len(trimmed)-1]
+	}
+	fields := strings.FieldsFunc(trimmed, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+	if len(fields) == 0 {
+		return nil, errors.Errorf("Vector %q must have at least one element", s)
+	}
+
+	vec := make([]float32, 0, len(fields))
+	for _, f := range fields {
+		v, err := strconv.ParseFloat(f, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing vector %q", s)
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, errors.Errorf("Vector %q must only contain finite numbers", s)
+		}
+		vec = append(vec, float32(v))
+	}
+	return vec, nil
+}
+
+// FormatVFloat returns the string form of the vector, which can be parsed back by ParseVFloat.
+func FormatVFloat(vec []float32) string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, v := range vec {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(strconv.FormatFloat(float64(v), 'G', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// vfloatToBinary encodes the vector as a sequence of little endian float32 values.
+func vfloatToBinary(vec []float32) []byte {
+	data := make([]byte, 4*len(vec))
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(v))
+	}
+	return data
+}
+
+func binaryToVFloat(data []byte) ([]float32, error) {
+	if len(data)%4 != 0 {
+		return nil, errors.Errorf("Invalid data for float32vector of length %d", len(data))
+	}
+	vec := make([]float32, len(data)/4)
+	for i := range vec {
+		vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+	return vec, nil
+}
+
+// CosineDistance returns 1 minus the cosine similarity of the two vectors. The result lies
+// between 0 (same direction) and 2 (opposite directions). It returns an error if the vectors
+// don't have the same number of dimensions. A zero vector is at distance 1 from every vector.
+func CosineDistance(a, b []float32) (float64, error) {
+	if len(a) != len(b) {
+		return 0, errors.Errorf("Vectors of different lengths %d and %d can't be compared",
+			len(a), len(b))
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 1, nil
+	}
+	return 1 - dot/(math.Sqrt(na)*math.Sqrt(nb)), nil
+}
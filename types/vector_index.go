@@ -0,0 +1,122 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// The vector index uses locality sensitive hashing with random hyperplanes. Every table hashes
+// a vector to a bucket made of one bit per hyperplane, telling on which side of the hyperplane
+// the vector lies. Vectors at a small angle from each other are likely to share the bucket in
+// at least one of the tables. Each bucket is stored as an index key, so the index lives in
+// regular posting lists and gets updated along with the data.
+const (
+	// VectorTables is the number of hash tables, i.e. the number of tokens per vector.
+	VectorTables = 16
+	// VectorBits is the number of hyperplanes per table. It must not be more than 16.
+	VectorBits = 12
+	// MaxVectorDim is the maximum number of dimensions of an indexed vector.
+	MaxVectorDim = 4096
+)
+
+// hyperplanes caches the hyperplanes for every vector dimension seen so far. They're derived
+// from a fixed seed so every node in the cluster, and every restart, hashes the same way.
+var hyperplanes sync.Map // int -> [][]float32
+
+func hyperplanesFor(dim int) [][]float32 {
+	if planes, ok := hyperplanes.Load(dim); ok {
+		return planes.([][]float32)
+	}
+	r := rand.New(rand.NewSource(int64(dim)))
+	planes := make([][]float32, VectorTables*VectorBits)
+	for i := range planes {
+		planes[i] = make([]float32, dim)
+		for j := range planes[i] {
+			planes[i][j] = float32(r.NormFloat64())
+		}
+	}
+	actual, _ := hyperplanes.LoadOrStore(dim, planes)
+	return actual.([][]float32)
+}
+
+// vectorSignatures returns the bucket of the vector in every table.
+func vectorSignatures(vec []float32) ([]uint16, error) {
+	if len(vec) == 0 || len(vec) > MaxVectorDim {
+		return nil, errors.Errorf("Vectors must have between 1 and %d dimensions. Got: %d",
+			MaxVectorDim, len(vec))
+	}
+	planes := hyperplanesFor(len(vec))
+	sigs := make([]uint16, VectorTables)
+	for t := range sigs {
+		var sig uint16
+		for b := 0; b < VectorBits; b++ {
+			var dot float32
+			for i, v := range planes[t*VectorBits+b] {
+				dot += v * vec[i]
+			}
+			if dot >= 0 {
+				sig |= 1 << uint(b)
+			}
+		}
+		sigs[t] = sig
+	}
+	return sigs, nil
+}
+
+// The dimension is part of the token, so vectors of different lengths never share a bucket.
+func vectorToken(dim, table int, sig uint16) string {
+	var buf [5]byte
+	binary.BigEndian.PutUint16(buf[0:2], uint16(dim))
+	buf[2] = byte(table)
+	binary.BigEndian.PutUint16(buf[3:5], sig)
+	return string(buf[:])
+}
+
+// IndexVFloatTokens returns the tokens to be used in a vector index for the given vector.
+func IndexVFloatTokens(vec []float32) ([]string, error) {
+	sigs, err := vectorSignatures(vec)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]string, 0, len(sigs))
+	for t, sig := range sigs {
+		tokens = append(tokens, vectorToken(len(vec), t, sig))
+	}
+	return tokens, nil
+}
+
+// ProbeVFloatTokens returns the tokens of the buckets next to the ones of the vector, i.e. the
+// buckets differing in exactly one bit. Querying them as well improves the recall when the
+// buckets of the vector hold too few candidates.
+func ProbeVFloatTokens(vec []float32) ([]string, error) {
+	sigs, err := vectorSignatures(vec)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]string, 0, len(sigs)*VectorBits)
+	for t, sig := range sigs {
+		for b := 0; b < VectorBits; b++ {
+			tokens = append(tokens, vectorToken(len(vec), t, sig^(1<<uint(b))))
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVFloatConversion(t *testing.T) {
+	vec, err := Convert(Val{Tid: StringID, Value: []byte("[0.5, -1, 2.25]")}, VFloatID)
+	require.NoError(t, err)
+	require.Equal(t, []float32{0.5, -1, 2.25}, vec.Value)
+
+	bin := ValueForType(BinaryID)
+	require.NoError(t, Marshal(vec, &bin))
+	require.Len(t, bin.Value, 12)
+
+	back, err := Convert(Val{Tid: VFloatID, Value: bin.Value}, StringID)
+	require.NoError(t, err)
+	require.Equal(t, "[0.5, -1, 2.25]", back.Value)
+
+	_, err = Convert(Val{Tid: StringID, Value: []byte("[0.5, abc]")}, VFloatID)
+	require.Error(t, err)
+	_, err = Convert(Val{Tid: StringID, Value: []byte("[]")}, VFloatID)
+	require.Error(t, err)
+	_, err = Convert(Val{Tid: BinaryID, Value: []byte{1, 2, 3}}, VFloatID)
+	require.Error(t, err)
+}
+
+func TestCosineDistance(t *testing.T) {
+	d, err := CosineDistance([]float32{1, 0}, []float32{2, 0})
+	require.NoError(t, err)
+	require.InDelta(t, 0, d, 1e-9)
+
+	d, err = CosineDistance([]float32{1, 0}, []float32{0, 3})
+	require.NoError(t, err)
+	require.InDelta(t, 1, d, 1e-9)
+
+	d, err = CosineDistance([]float32{1, 0}, []float32{-1, 0})
+	require.NoError(t, err)
+	require.InDelta(t, 2, d, 1e-9)
+
+	_, err = CosineDistance([]float32{1, 0}, []float32{1, 0, 0})
+	require.Error(t, err)
+}
+
+func TestVFloatTokens(t *testing.T) {
+	a := []float32{0.9, 0.1, 0.3, 0.4}
+	toks, err := IndexVFloatTokens(a)
+	require.NoError(t, err)
+	require.Len(t, toks, VectorTables)
+
+	// Scaling a vector doesn't change its direction, hence its buckets.
+	scaled, err := IndexVFloatTokens([]float32{1.8, 0.2, 0.6, 0.8})
+	require.NoError(t, err)
+	require.Equal(t, toks, scaled)
+
+	// The opposite vector lies on the other side of every hyperplane.
+	opposite, err := IndexVFloatTokens([]float32{-0.9, -0.1, -0.3, -0.4})
+	require.NoError(t, err)
+	for i := range toks {
+		require.NotEqual(t, toks[i], opposite[i])
+	}
+
+	probes, err := ProbeVFloatTokens(a)
+	require.NoError(t, err)
+	require.Len(t, probes, VectorTables*VectorBits)
+
+	_, err = IndexVFloatTokens(nil)
+	require.Error(t, err)
+}
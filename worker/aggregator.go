@@ -32,6 +32,12 @@ func couldApplyAggregatorOn(agrtr string, typ types.TypeID) bool {
 	case "sum", "avg":
 		return (typ == types.IntID ||
 			typ == types.FloatID)
+	case "median", "percentile", "stddev", "variance":
+		return (typ == types.IntID ||
+			typ == types.FloatID ||
+			typ == types.DateTimeID)
+	case "countdistinct":
+		return true
 	default:
 		return false
 	}
@@ -0,0 +1,334 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/dgraph-io/ristretto/z"
+)
+
+const (
+	// cdcTickDur is how often the leader ships committed events to the sink, and how often
+	// followers refresh the sink checkpoint.
+	cdcTickDur = time.Second
+	// maxCDCEvents is the maximum number of committed events that are kept in memory while
+	// waiting for the sink to acknowledge them. Once it's crossed, applying new commits blocks
+	// until the sink catches up.
+	maxCDCEvents = 100000
+)
+
+// CDCEdge is a single set or delete operation of a committed transaction, in a form close to
+// an N-Quad.
+type CDCEdge struct {
+	Subject     string            `json:"subject"`
+	Predicate   string            `json:"predicate"`
+	ObjectId    string            `json:"object_id,omitempty"`
+	ObjectValue string            `json:"object_value,omitempty"`
+	ObjectType  string            `json:"object_type,omitempty"`
+	Lang        string            `json:"lang,omitempty"`
+	Facets      map[string]string `json:"facets,omitempty"`
+}
+
+// CDCEvent describes the changes done by a committed transaction to the predicates served by
+// this group. A transaction touching predicates in several groups generates one event per group.
+type CDCEvent struct {
+	Group      uint32     `json:"group"`
+	StartTs    uint64     `json:"start_ts"`
+	CommitTs   uint64     `json:"commit_ts"`
+	Predicates []string   `json:"predicates"`
+	Set        []*CDCEdge `json:"set,omitempty"`
+	Delete     []*CDCEdge `json:"delete,omitempty"`
+}
+
+// CDC keeps track of the mutations applied via Raft and turns them into change events once
+// their transactions commit. Every replica of the group does the tracking, so that a newly
+// elected leader can pick up from the checkpoint stored in the sink. Only the leader sends the
+// events to the sink.
+type CDC struct {
+	sync.Mutex
+	sink   CDCSink
+	closer *z.Closer
+	gid    uint32
+
+	// pending holds the edges of the transactions which have not been committed or aborted yet,
+	// keyed by their start timestamp.
+	pending map[uint64][]*pb.DirectedEdge
+	// events holds the events of committed transactions, ordered by commit timestamp, which
+	// have not been acknowledged by the sink yet.
+	events []*CDCEvent
+	// sentTs is the commit timestamp of the last event acknowledged by the sink.
+	sentTs uint64
+	// acked is signalled whenever the sink acknowledges events, or CDC is closed.
+	acked *sync.Cond
+	// leader tells whether this node was the leader of the group during the last tick.
+	leader bool
+}
+
+func newCDC(dest string, gid uint32) *CDC {
+	if dest == "" {
+		return nil
+	}
+	if x.WorkerConfig.LudicrousMode {
+		glog.Warningf("CDC is not supported in ludicrous mode. Disabling it.")
+		return nil
+	}
+	sink, err := newCDCSink(dest, gid)
+	x.Checkf(err, "while setting up the CDC sink at %s", dest)
+
+	cdc := &CDC{
+		sink:    sink,
+		closer:  z.NewCloser(1),
+		gid:     gid,
+		pending: make(map[uint64][]*pb.DirectedEdge),
+	}
+	cdc.acked = sync.NewCond(&cdc.Mutex)
+	return cdc
+}
+
+// addToPending records the edges applied by a transaction. They are turned into an event once
+// the transaction commits.
+func (cdc *CDC) addToPending(startTs uint64, edges []*pb.DirectedEdge) {
+	if cdc == nil || len(edges) == 0 {
+		return
+	}
+	cdc.Lock()
+	defer cdc.Unlock()
+	cdc.pending[startTs] = append(cdc.pending[startTs], edges...)
+}
+
+// updateTxnStatus moves the pending edges of committed transactions into events, and discards
+// the ones belonging to aborted transactions. If the sink falls too far behind, it blocks until
+// the sink catches up, which holds back the commits applied after these ones.
+func (cdc *CDC) updateTxnStatus(delta *pb.OracleDelta) {
+	if cdc == nil || len(delta.Txns) == 0 {
+		return
+	}
+
+	txns := make([]*pb.TxnStatus, len(delta.Txns))
+	copy(txns, delta.Txns)
+	sort.Slice(txns, func(i, j int) bool {
+		return txns[i].CommitTs < txns[j].CommitTs
+	})
+
+	cdc.Lock()
+	defer cdc.Unlock()
+	for _, status := range txns {
+		edges, ok := cdc.pending[status.StartTs]
+		if !ok {
+			continue
+		}
+		delete(cdc.pending, status.StartTs)
+		// Events which have been sent already show up again when replaying the Raft logs.
+		if status.CommitTs == 0 || status.CommitTs <= cdc.sentTs {
+			continue
+		}
+		cdc.events = append(cdc.events, toCDCEvent(cdc.gid, status.StartTs, status.CommitTs, edges))
+	}
+	sort.SliceStable(cdc.events, func(i, j int) bool {
+		return cdc.events[i].CommitTs < cdc.events[j].CommitTs
+	})
+
+	// The events are only kept in memory, dropping them would lose them for good.
+	if len(cdc.events) > maxCDCEvents {
+		glog.Warningf("CDC: %d events haven't been acknowledged by the sink. "+
+			"Waiting for it to catch up.", len(cdc.events))
+		for len(cdc.events) > maxCDCEvents && !cdc.closed() {
+			cdc.acked.Wait()
+		}
+	}
+}
+
+func (cdc *CDC) closed() bool {
+	select {
+	case <-cdc.closer.HasBeenClosed():
+		return true
+	default:
+		return false
+	}
+}
+
+// resetPending discards the edges of all the pending transactions. It's called when the
+// Oracle forgets about them, e.g. after a DropAll.
+func (cdc *CDC) resetPending() {
+	if cdc == nil {
+		return
+	}
+	cdc.Lock()
+	defer cdc.Unlock()
+	cdc.pending = make(map[uint64][]*pb.DirectedEdge)
+}
+
+// ackTill drops all the events with commit timestamp less than or equal to ts.
+func (cdc *CDC) ackTill(ts uint64) {
+	cdc.Lock()
+	defer cdc.Unlock()
+	if ts <= cdc.sentTs {
+		return
+	}
+	cdc.sentTs = ts
+	idx := sort.Search(len(cdc.events), func(i int) bool {
+		return cdc.events[i].CommitTs > ts
+	})
+	cdc.events = cdc.events[idx:]
+	cdc.acked.Broadcast()
+}
+
+func (cdc *CDC) eventsToSend() []*CDCEvent {
+	cdc.Lock()
+	defer cdc.Unlock()
+	events := make([]*CDCEvent, len(cdc.events))
+	copy(events, cdc.events)
+	return events
+}
+
+// refreshCheckpoint reads the checkpoint stored by the sink and drops the events it covers.
+func (cdc *CDC) refreshCheckpoint() error {
+	ts, err := cdc.sink.Checkpoint()
+	if err != nil {
+		return err
+	}
+	cdc.ackTill(ts)
+	return nil
+}
+
+func (cdc *CDC) processCDCEvents(n *node) {
+	if cdc == nil {
+		return
+	}
+	defer cdc.closer.Done()
+
+	ticker := time.NewTicker(cdcTickDur)
+	defer ticker.Stop()
+
+	send := func() error {
+		if !n.AmLeader() {
+			cdc.leader = false
+			// The leader might have moved ahead. Drop the events it has already delivered.
+			return cdc.refreshCheckpoint()
+		}
+		if !cdc.leader {
+			// We just became the leader. Resume from wherever the previous leader stopped.
+			if err := cdc.refreshCheckpoint(); err != nil {
+				return err
+			}
+			cdc.leader = true
+			glog.Infof("CDC: became leader of group %d. Resuming after the sink checkpoint.",
+				cdc.gid)
+		}
+
+		events := cdc.eventsToSend()
+		if len(events) == 0 {
+			return nil
+		}
+		if err := cdc.sink.Send(events); err != nil {
+			return err
+		}
+		cdc.ackTill(events[len(events)-1].CommitTs)
+		return nil
+	}
+
+	for {
+		select {
+		case <-cdc.closer.HasBeenClosed():
+			// Unblock the commits waiting for the sink.
+			cdc.Lock()
+			cdc.acked.Broadcast()
+			cdc.Unlock()
+			if err := cdc.sink.Close(); err != nil {
+				glog.Errorf("CDC: error while closing sink: %v", err)
+			}
+			return
+		case <-ticker.C:
+			if err := send(); err != nil {
+				glog.Errorf("CDC: error while sending events: %v", err)
+			}
+		}
+	}
+}
+
+func toCDCEvent(gid uint32, startTs, commitTs uint64, edges []*pb.DirectedEdge) *CDCEvent {
+	ev := &CDCEvent{
+		Group:    gid,
+		StartTs:  startTs,
+		CommitTs: commitTs,
+	}
+	preds := make(map[string]struct{})
+	for _, edge := range edges {
+		preds[edge.Attr] = struct{}{}
+		ce := toCDCEdge(edge)
+		if edge.Op == pb.DirectedEdge_DEL {
+			ev.Delete = append(ev.Delete, ce)
+		} else {
+			ev.Set = append(ev.Set, ce)
+		}
+	}
+	for pred := range preds {
+		ev.Predicates = append(ev.Predicates, pred)
+	}
+	sort.Strings(ev.Predicates)
+	return ev
+}
+
+func toCDCEdge(edge *pb.DirectedEdge) *CDCEdge {
+	ce := &CDCEdge{
+		Subject:   fmt.Sprintf("%#x", edge.Entity),
+		Predicate: edge.Attr,
+		Lang:      edge.Lang,
+	}
+
+	tid := posting.TypeID(edge)
+	switch {
+	case tid == types.UidID:
+		ce.ObjectId = fmt.Sprintf("%#x", edge.ValueId)
+	case isStarAll(edge.Value):
+		ce.ObjectValue = "*"
+	case tid == types.PasswordID:
+		// Never leak password hashes out of the cluster.
+		ce.ObjectType = tid.Name()
+	default:
+		ce.ObjectType = tid.Name()
+		str, err := valToStr(types.Val{Tid: tid, Value: edge.Value})
+		if err != nil {
+			glog.Errorf("CDC: unable to convert value of edge %+v: %v", edge, err)
+			break
+		}
+		ce.ObjectValue = str
+	}
+
+	for _, fct := range edge.Facets {
+		str, err := facetToString(fct)
+		if err != nil {
+			glog.Errorf("CDC: ignoring facet %s of predicate %s: %v", fct.Key, edge.Attr, err)
+			continue
+		}
+		if ce.Facets == nil {
+			ce.Facets = make(map[string]string)
+		}
+		ce.Facets[fct.Key] = str
+	}
+	return ce
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CDCSink is the destination of the change data capture events.
+type CDCSink interface {
+	// Send durably writes the events, which are ordered by commit timestamp, and moves the
+	// checkpoint to the commit timestamp of the last one.
+	Send(events []*CDCEvent) error
+	// Checkpoint returns the commit timestamp of the last event written by the sink. Sinks
+	// should store it where a newly elected leader can read it.
+	Checkpoint() (uint64, error)
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// newCDCSink returns the sink for the given destination. Only directories are supported for
+// now, given either as file:///path/to/dir or just /path/to/dir.
+func newCDCSink(dest string, gid uint32) (CDCSink, error) {
+	uri, err := url.Parse(dest)
+	if err != nil {
+		return nil, err
+	}
+	switch uri.Scheme {
+	case "", "file":
+		return newFileSink(uri.Path, gid)
+	default:
+		return nil, errors.Errorf("Unsupported CDC sink: %s", dest)
+	}
+}
+
+// fileSink writes the events as JSON lines to a file in the given directory. The directory
+// should be shared by all the replicas of the group, so the leader can resume after the
+// checkpoint written by the previous one.
+type fileSink struct {
+	logPath  string
+	ckptPath string
+	fd       *os.File
+}
+
+func newFileSink(dir string, gid uint32) (*fileSink, error) {
+	if dir == "" {
+		return nil, errors.New("CDC file sink needs a directory")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &fileSink{
+		logPath:  filepath.Join(dir, fmt.Sprintf("cdc_g%d.jsonl", gid)),
+		ckptPath: filepath.Join(dir, fmt.Sprintf("cdc_g%d.checkpoint", gid)),
+	}, nil
+}
+
+func (s *fileSink) Send(events []*CDCEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	// Followers never write to the log, so it's only opened once we have something to send.
+	if s.fd == nil {
+		fd, err := os.OpenFile(s.logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		s.fd = fd
+	}
+
+	bw := bufio.NewWriter(s.fd)
+	enc := json.NewEncoder(bw)
+	for _, ev := range events {
+		if err := enc.Encode(ev); err != nil {
+			return errors.Wrapf(err, "while encoding CDC event with commit ts %d", ev.CommitTs)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	if err := s.fd.Sync(); err != nil {
+		return err
+	}
+
+	// Write the checkpoint to a temporary file first, so a crash never leaves it half written.
+	// If we crash before the rename, the events would be sent again after a restart.
+	ts := events[len(events)-1].CommitTs
+	tmp := s.ckptPath + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatUint(ts, 10)), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.ckptPath)
+}
+
+func (s *fileSink) Checkpoint() (uint64, error) {
+	data, err := ioutil.ReadFile(s.ckptPath)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	ts, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	return ts, errors.Wrapf(err, "while parsing CDC checkpoint in %s", s.ckptPath)
+}
+
+func (s *fileSink) Close() error {
+	if s.fd == nil {
+		return nil
+	}
+	return s.fd.Close()
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+func TestCDCTxnStatus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cdc := newCDC(dir, 1)
+	require.NotNil(t, cdc)
+
+	cdc.addToPending(10, []*pb.DirectedEdge{
+		{Entity: 1, Attr: "name", Value: []byte("alice"), ValueType: pb.Posting_STRING},
+		{Entity: 1, Attr: "friend", ValueId: 2},
+	})
+	cdc.addToPending(11, []*pb.DirectedEdge{
+		{Entity: 2, Attr: "name", Value: []byte("bob"), ValueType: pb.Posting_STRING},
+	})
+	cdc.addToPending(12, []*pb.DirectedEdge{
+		{Entity: 1, Attr: "name", Value: []byte("alice"), ValueType: pb.Posting_STRING,
+			Op: pb.DirectedEdge_DEL},
+	})
+
+	cdc.updateTxnStatus(&pb.OracleDelta{Txns: []*pb.TxnStatus{
+		{StartTs: 12, CommitTs: 15},
+		{StartTs: 11},
+		{StartTs: 10, CommitTs: 13},
+	}})
+	require.Empty(t, cdc.pending)
+
+	events := cdc.eventsToSend()
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(13), events[0].CommitTs)
+	require.Equal(t, []string{"friend", "name"}, events[0].Predicates)
+	require.Len(t, events[0].Set, 2)
+	require.Equal(t, "0x2", events[0].Set[1].ObjectId)
+	require.Equal(t, uint64(15), events[1].CommitTs)
+	require.Len(t, events[1].Delete, 1)
+	require.Equal(t, "alice", events[1].Delete[0].ObjectValue)
+	require.Equal(t, types.StringID.Name(), events[1].Delete[0].ObjectType)
+
+	require.NoError(t, cdc.sink.Send(events[:1]))
+	ts, err := cdc.sink.Checkpoint()
+	require.NoError(t, err)
+	require.Equal(t, uint64(13), ts)
+
+	// A new leader resumes from the checkpoint stored by the sink.
+	require.NoError(t, cdc.refreshCheckpoint())
+	events = cdc.eventsToSend()
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(15), events[0].CommitTs)
+
+	// Replayed transactions which were already sent don't generate events again.
+	cdc.addToPending(10, []*pb.DirectedEdge{{Entity: 3, Attr: "name", Value: []byte("carol")}})
+	cdc.updateTxnStatus(&pb.OracleDelta{Txns: []*pb.TxnStatus{{StartTs: 10, CommitTs: 13}}})
+	require.Len(t, cdc.eventsToSend(), 1)
+	require.NoError(t, cdc.sink.Close())
+
+	fd, err := os.Open(filepath.Join(dir, "cdc_g1.jsonl"))
+	require.NoError(t, err)
+	defer fd.Close()
+	var lines int
+	scanner := bufio.NewScanner(fd)
+	for scanner.Scan() {
+		var ev CDCEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &ev))
+		require.Equal(t, uint64(10), ev.StartTs)
+		lines++
+	}
+	require.Equal(t, 1, lines)
+}
+
+func TestCDCBackpressure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cdc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cdc := newCDC(dir, 1)
+	require.NotNil(t, cdc)
+	for i := 1; i <= maxCDCEvents; i++ {
+		cdc.events = append(cdc.events, &CDCEvent{CommitTs: uint64(i)})
+	}
+
+	cdc.addToPending(maxCDCEvents+1, []*pb.DirectedEdge{{Entity: 1, Attr: "name", ValueId: 2}})
+	done := make(chan struct{})
+	go func() {
+		cdc.updateTxnStatus(&pb.OracleDelta{Txns: []*pb.TxnStatus{
+			{StartTs: maxCDCEvents + 1, CommitTs: maxCDCEvents + 1},
+		}})
+		close(done)
+	}()
+
+	// The sink is too far behind, the commit waits for it instead of dropping events.
+	select {
+	case <-done:
+		t.Fatal("updateTxnStatus didn't wait for the sink")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cdc.ackTill(10)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("updateTxnStatus didn't resume after the sink caught up")
+	}
+	events := cdc.eventsToSend()
+	require.Len(t, events, maxCDCEvents-9)
+	require.Equal(t, uint64(11), events[0].CommitTs)
+	require.Equal(t, uint64(maxCDCEvents+1), events[len(events)-1].CommitTs)
+	require.NoError(t, cdc.sink.Close())
+}
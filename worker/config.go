@@ -70,6 +70,9 @@ type Options struct {
 	AccessJwtTtl time.Duration
 	// RefreshJwtTtl is the TTL of the refresh JWT.
 	RefreshJwtTtl time.Duration
+
+	// CDCSink is the destination of the change data capture events. CDC is disabled if empty.
+	CDCSink string
 }
 
 // Config holds an instance of the server options..
@@ -0,0 +1,203 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// A cursor marks a node in the results of a sorted query, so that the next page of results
+// can be asked for with after: <cursor> and starts right after that node. It holds the uid of
+// the node and its values for each of the sort orders of the query. That's enough to seek to
+// the index bucket the page starts in, instead of walking all the buckets before it as an
+// offset does. Nodes with equal values are ordered by their uid, so the cursor also resumes
+// correctly in the middle of a run of equal values.
+
+const cursorVersion = 1
+
+// The version byte makes every cursor start with an 'A', so a cursor is never taken for a uid
+// and, being made of letters and digits only, can be used in a query without quotes.
+var cursorEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+// EncodeCursor returns the cursor for the node uid, given its values for each of the sort
+// orders of the query as returned by ProcessTaskOverNetwork. A nil value means that the node
+// doesn't have a value for that order. A cursor without any values marks a node in the results
+// of a query that isn't sorted, those are ordered by uid.
+func EncodeCursor(uid uint64, vals []*pb.TaskValue) string {
+	buf := []byte{cursorVersion}
+	var tmp [8]byte
+	binary.BigEndian.PutUint64(tmp[:], uid)
+	buf = append(buf, tmp[:]...)
+	buf = appendUvarint(buf, uint64(len(vals)))
+	for _, val := range vals {
+		if val == nil {
+			buf = append(buf, 0)
+			continue
+		}
+		buf = append(buf, 1, byte(val.ValType))
+		buf = appendUvarint(buf, uint64(len(val.Val)))
+		buf = append(buf, val.Val...)
+	}
+	return cursorEncoding.EncodeToString(buf)
+}
+
+// DecodeCursor returns the uid and the values that the cursor was made from by EncodeCursor.
+func DecodeCursor(cursor string) (uint64, []*pb.TaskValue, error) {
+	errInvalid := errors.Errorf("Invalid cursor: %q", cursor)
+	buf, err := cursorEncoding.DecodeString(cursor)
+	if err != nil || len(buf) < 9 || buf[0] != cursorVersion {
+		return 0, nil, errInvalid
+	}
+	uid := binary.BigEndian.Uint64(buf[1:9])
+	buf = buf[9:]
+
+	n, sz := binary.Uvarint(buf)
+	if sz <= 0 || n > uint64(len(buf)) {
+		return 0, nil, errInvalid
+	}
+	buf = buf[sz:]
+	vals := make([]*pb.TaskValue, 0, n)
+	for i := uint64(0); i < n; i++ {
+		if len(buf) == 0 {
+			return 0, nil, errInvalid
+		}
+		if buf[0] == 0 {
+			vals = append(vals, nil)
+			buf = buf[1:]
+			continue
+		}
+		if len(buf) < 2 {
+			return 0, nil, errInvalid
+		}
+		typ := pb.Posting_ValType(buf[1])
+		buf = buf[2:]
+		l, sz := binary.Uvarint(buf)
+		if sz <= 0 || l > uint64(len(buf)-sz) {
+			return 0, nil, errInvalid
+		}
+		vals = append(vals, &pb.TaskValue{ValType: typ, Val: buf[sz : sz+int(l)]})
+		buf = buf[sz+int(l):]
+	}
+	if len(buf) != 0 {
+		return 0, nil, errInvalid
+	}
+	return uid, vals, nil
+}
+
+// sortCursor is the cursor of a SortMessage, decoded so that the sorted uids can be compared
+// with it.
+type sortCursor struct {
+	uid uint64
+	// vals has the value for each of the sort orders, with a nil Value if there's none. The
+	// value for the first order is of the schema type of its predicate, as in sortByValue.
+	vals []types.Val
+	desc []bool
+	lang string
+}
+
+// newSortCursor decodes the cursor of ts. scalar is the schema type of the first order.
+func newSortCursor(ts *pb.SortMessage, scalar types.TypeID) (*sortCursor, error) {
+	uid, tvs, err := DecodeCursor(ts.After)
+	if err != nil {
+		return nil, err
+	}
+	if len(tvs) != len(ts.Order) {
+		return nil, errors.Errorf("Cursor has %d sort values but the query is sorted by %d",
+			len(tvs), len(ts.Order))
+	}
+
+	c := &sortCursor{uid: uid, vals: make([]types.Val, len(tvs))}
+	for i, tv := range tvs {
+		c.desc = append(c.desc, ts.Order[i].Desc)
+		if tv == nil {
+			continue
+		}
+		val := types.ValueForType(types.TypeID(tv.ValType))
+		val.Value = tv.Val
+		to := val.Tid
+		if i == 0 {
+			to = scalar
+		}
+		if c.vals[i], err = types.Convert(val, to); err != nil {
+			return nil, errors.Wrapf(err, "Invalid cursor: %q", ts.After)
+		}
+	}
+	if langs := ts.Order[0].Langs; len(langs) == 1 {
+		c.lang = langs[0]
+	}
+	return c, nil
+}
+
+// compare compares the node uid having the values vals with the node of the cursor, in the
+// order that the nodes are sorted in. Only as many sort orders as there are vals are looked at.
+func (c *sortCursor) compare(uid uint64, vals []types.Val) int {
+	lang := c.lang
+	if len(vals) > 1 {
+		// multiSort compares the values of all the orders without a language.
+		lang = ""
+	}
+	if cmp := types.CompareVals(vals, c.vals, c.desc[:len(vals)], lang); cmp != 0 {
+		return cmp
+	}
+	switch {
+	case len(vals) < len(c.vals):
+		// The other orders decide, so we can't tell.
+		return 0
+	case uid < c.uid:
+		return -1
+	case uid > c.uid:
+		return 1
+	}
+	return 0
+}
+
+// after returns whether the node uid having the values vals for every sort order comes after
+// the node of the cursor.
+func (c *sortCursor) after(uid uint64, vals []types.Val) bool {
+	return c.compare(uid, vals) > 0
+}
+
+// skip returns how many of the uids, sorted by their values vals for the first order, come
+// before the cursor. With several sort orders the uids with the same first value as the cursor
+// aren't counted, as the other orders decide whether they come before it.
+func (c *sortCursor) skip(uids []uint64, vals []types.Val) int {
+	return sort.Search(len(uids), func(i int) bool {
+		cmp := c.compare(uids[i], vals[i:i+1])
+		return cmp > 0 || (cmp == 0 && len(c.vals) > 1)
+	})
+}
+
+// ties returns how many of vals, sorted and not coming before the cursor, are equal to the
+// cursor's value for the first order.
+func (c *sortCursor) ties(vals []types.Val) int {
+	return sort.Search(len(vals), func(i int) bool {
+		return types.CompareVals(vals[i:i+1], c.vals[:1], c.desc[:1], c.lang) > 0
+	})
+}
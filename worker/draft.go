@@ -71,7 +71,8 @@ type node struct {
 	canCampaign bool
 	elog        trace.EventLog
 
-	ex *executor
+	ex  *executor
+	cdc *CDC
 }
 
 type op int
@@ -248,6 +249,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 	if x.WorkerConfig.LudicrousMode {
 		n.ex = newExecutor(&m.Applied, x.WorkerConfig.LudicrousConcurrency)
 	}
+	n.cdc = newCDC(Config.CDCSink, gid)
 	return n
 }
 
@@ -307,18 +309,21 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	if proposal.Mutations.DropOp == pb.Mutations_DATA {
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
+		n.cdc.resetPending()
 		if err := posting.DeleteData(); err != nil {
 			return err
 		}
 
 		// Clear entire cache.
 		posting.ResetCache()
+		posting.Oracle().Reset()
 		return nil
 	}
 
 	if proposal.Mutations.DropOp == pb.Mutations_ALL {
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
+		n.cdc.resetPending()
 		schema.State().DeleteAll()
 
 		if err := posting.DeleteAll(); err != nil {
@@ -327,6 +332,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 
 		// Clear entire cache.
 		posting.ResetCache()
+		posting.Oracle().Reset()
 
 		if groups().groupId() == 1 {
 			initialSchema := schema.InitialSchema()
@@ -357,6 +363,12 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	}
 
 	if proposal.Mutations.DropOp == pb.Mutations_TYPE {
+		// Drop the composite indexes that were only declared by the type.
+		removed, _ := compositeIndexChanges(&pb.TypeUpdate{TypeName: proposal.Mutations.DropValue})
+		if err := dropCompositeIndexes(ctx, removed); err != nil {
+			return err
+		}
+		posting.Oracle().Reset()
 		return schema.State().DeleteType(proposal.Mutations.DropValue)
 	}
 
@@ -377,6 +389,21 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 				return err
 			}
 		}
+		// The composite indexes changed by the types are built from the committed data as well.
+		rebuildsIndexes := len(proposal.Mutations.Schema) > 0
+		for _, tupdate := range proposal.Mutations.Types {
+			removed, added := compositeIndexChanges(tupdate)
+			if len(removed) > 0 || len(added) > 0 {
+				rebuildsIndexes = true
+			}
+			for _, preds := range append(removed, added...) {
+				for _, pred := range preds {
+					if err := detectPendingTxns(pred); err != nil {
+						return err
+					}
+				}
+			}
+		}
 
 		// If Dgraph is running in ludicrous mode and we get some schema we should wait for all
 		// active mutations to finish. Previously we were thinking of only waiting for active
@@ -384,25 +411,23 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		// as we call DropPrefix() on Badger while running schema mutations. DropPrefix() blocks
 		// writes on Badger and returns error if writes are tried. To avoid this we should wait for
 		// all active mutations to finish irrespective of predicates present in schema mutation.
-		if x.WorkerConfig.LudicrousMode && len(proposal.Mutations.Schema) > 0 {
+		// The same goes for the types, as they can drop and build composite indexes.
+		if x.WorkerConfig.LudicrousMode && (len(proposal.Mutations.Schema) > 0 ||
+			len(proposal.Mutations.Types) > 0) {
 			n.ex.waitForActiveMutations()
 		}
 
-		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs); err != nil {
+		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, proposal.Mutations.Types,
+			startTs); err != nil {
 			return err
 		}
 
-		// Clear the entire cache if there is a schema update because the index rebuild
-		// will invalidate the state.
-		if len(proposal.Mutations.Schema) > 0 {
+		// Clear the entire cache if there is a schema update or a composite index change
+		// because the index rebuild will invalidate the state.
+		if rebuildsIndexes {
 			posting.ResetCache()
 		}
-
-		for _, tupdate := range proposal.Mutations.Types {
-			if err := runTypeMutation(ctx, tupdate); err != nil {
-				return err
-			}
-		}
+		posting.Oracle().Reset()
 		return nil
 	}
 
@@ -508,28 +533,53 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		}
 		return nil
 	}
-	numGo, width := x.DivideAndRule(len(m.Edges))
-	span.Annotatef(nil, "To apply: %d edges. NumGo: %d. Width: %d", len(m.Edges), numGo, width)
-
-	if numGo == 1 {
-		return process(m.Edges)
-	}
-	errCh := make(chan error, numGo)
-	for i := 0; i < numGo; i++ {
-		start := i * width
-		end := start + width
-		if end > len(m.Edges) {
-			end = len(m.Edges)
+	// The edges of the predicates in composite indexes read the values of the other predicates
+	// of their nodes, so they're applied one after the other once the rest are applied.
+	edges := m.Edges
+	var compositeEdges []*pb.DirectedEdge
+	if len(schema.State().AllCompositeIndexes()) > 0 {
+		edges = make([]*pb.DirectedEdge, 0, len(m.Edges))
+		for _, edge := range m.Edges {
+			if len(schema.State().CompositeIndexes(edge.Attr)) > 0 {
+				compositeEdges = append(compositeEdges, edge)
+			} else {
+				edges = append(edges, edge)
+			}
 		}
-		go func(start, end int) {
-			errCh <- process(m.Edges[start:end])
-		}(start, end)
 	}
-	for i := 0; i < numGo; i++ {
-		if err := <-errCh; err != nil {
+
+	numGo, width := x.DivideAndRule(len(edges))
+	span.Annotatef(nil, "To apply: %d edges. NumGo: %d. Width: %d", len(edges), numGo, width)
+
+	if numGo == 1 {
+		if err := process(edges); err != nil {
 			return err
 		}
+	} else {
+		errCh := make(chan error, numGo)
+		for i := 0; i < numGo; i++ {
+			start := i * width
+			end := start + width
+			if end > len(edges) {
+				end = len(edges)
+			}
+			go func(start, end int) {
+				errCh <- process(edges[start:end])
+			}(start, end)
+		}
+		for i := 0; i < numGo; i++ {
+			if err := <-errCh; err != nil {
+				return err
+			}
+		}
+	}
+	if err := process(compositeEdges); err != nil {
+		return err
 	}
+	if err := checkUniqueValues(ctx, m.Edges, txn); err != nil {
+		return err
+	}
+	n.cdc.addToPending(m.StartTs, m.Edges)
 	return nil
 }
 
@@ -612,8 +662,9 @@ func (n *node) applyCommitted(proposal *pb.Proposal) error {
 			}
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
-		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		// We can now discard all invalid versions of keys below this ts, except for the ones
+		// still within the history retention window.
+		pstore.SetDiscardTs(discardTs(snap.ReadTs))
 		return nil
 
 	case proposal.Restore != nil:
@@ -793,6 +844,9 @@ func (n *node) commitOrAbort(pkey string, delta *pb.OracleDelta) error {
 	}
 	posting.WaitForCache()
 
+	// The data is on disk now. Let CDC turn the committed transactions into events.
+	n.cdc.updateTxnStatus(delta)
+
 	// Now advance Oracle(), so we can service waiting reads.
 	posting.Oracle().ProcessDelta(delta)
 	return nil
@@ -875,6 +929,8 @@ func (n *node) retrieveSnapshot(snap pb.Snapshot) error {
 	if err := schema.LoadFromDb(); err != nil {
 		return errors.Wrapf(err, "while initializing schema")
 	}
+	// The data in the snapshot didn't go through the commits seen by the Oracle.
+	posting.Oracle().Reset()
 	groups().triggerMembershipSync()
 	return nil
 }
@@ -1004,6 +1060,9 @@ func (n *node) checkpointAndClose(done chan struct{}) {
 			if x.WorkerConfig.LudicrousMode {
 				n.ex.closer.SignalAndWait()
 			}
+			if n.cdc != nil {
+				n.cdc.closer.SignalAndWait()
+			}
 			close(done)
 			return
 		}
@@ -1699,6 +1758,7 @@ func (n *node) InitAndStartNode() {
 	}
 	go n.processTabletSizes()
 	go n.processApplyCh()
+	go n.cdc.processCDCEvents(n)
 	go n.BatchAndSendMessages()
 	// Ignoring the error since InitAndStartNode does not return an error and using x.Check would
 	// not be the right thing to do.
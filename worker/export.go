@@ -21,14 +21,17 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -44,6 +47,7 @@ import (
 	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
@@ -69,8 +73,23 @@ var exportFormats = map[string]exportFormat{
 		pre:  "",
 		post: "",
 	},
+	// CSV exports write the values to a nodes file and the uid edges to an edges file, one row
+	// per posting.
+	"csv": {
+		ext:  ".csv",
+		pre:  "uid,predicate,value,type,lang,facets\n",
+		post: "",
+	},
+	// Parquet exports write a file per predicate, whose columns are typed after the schema.
+	"parquet": {
+		ext:  ".parquet",
+		pre:  "",
+		post: "",
+	},
 }
 
+const csvEdgesHeader = "src,predicate,dst,facets\n"
+
 type exporter struct {
 	pl     *posting.List
 	uid    uint64
@@ -90,6 +109,7 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.GeoID:      "geo:geojson",
 	types.BinaryID:   "xs:base64Binary",
 	types.PasswordID: "xs:password",
+	types.VFloatID:   "float32vector",
 }
 
 // UIDs like 0x1 look weird but 64-bit ones like 0x0000000000000001 are too long.
@@ -280,6 +300,352 @@ func (e *exporter) toRDF() (*bpb.KVList, error) {
 	return listWrap(kv), err
 }
 
+// facetsToJSON returns the facets of a posting as a JSON object, or an empty string if the
+// posting has no facets.
+func facetsToJSON(fcts []*api.Facet) (string, error) {
+	if len(fcts) == 0 {
+		return "", nil
+	}
+	var builder strings.Builder
+	x.Check2(builder.WriteRune('{'))
+	for i, fct := range fcts {
+		if i != 0 {
+			x.Check2(builder.WriteRune(','))
+		}
+		str, err := facetToString(fct)
+		if err != nil {
+			return "", err
+		}
+		tid, err := facets.TypeIDFor(fct)
+		if err != nil {
+			return "", errors.Wrapf(err, "getting type id from facet %#v", fct)
+		}
+		if !tid.IsNumber() {
+			str = escapedString(str)
+		}
+		x.Check2(builder.WriteString(escapedString(fct.Key)))
+		x.Check2(builder.WriteRune(':'))
+		x.Check2(builder.WriteString(str))
+	}
+	x.Check2(builder.WriteRune('}'))
+	return builder.String(), nil
+}
+
+// toCSV returns the values of the posting list as rows of the nodes file, and its uid edges as
+// rows of the edges file. The rows of the edges file are sent with version 4.
+func (e *exporter) toCSV() (*bpb.KVList, error) {
+	var nodes, edges bytes.Buffer
+	nw, ew := csv.NewWriter(&nodes), csv.NewWriter(&edges)
+
+	uid := fmt.Sprintf("0x%x", e.uid)
+	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+		fcts, err := facetsToJSON(p.Facets)
+		if err != nil {
+			glog.Errorf("Ignoring error: %+v", err)
+			return nil
+		}
+		if p.PostingType == pb.Posting_REF {
+			return ew.Write([]string{uid, e.attr, fmt.Sprintf("0x%x", p.Uid), fcts})
+		}
+
+		val := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+		str, err := valToStr(val)
+		if err != nil {
+			glog.Errorf("Ignoring error: %+v\n", err)
+			return nil
+		}
+		var lang string
+		if p.PostingType == pb.Posting_VALUE_LANG {
+			lang = string(p.LangTag)
+		}
+		return nw.Write([]string{uid, e.attr, str, val.Tid.Name(), lang, fcts})
+	})
+	if err != nil {
+		return nil, err
+	}
+	nw.Flush()
+	ew.Flush()
+	if err := nw.Error(); err != nil {
+		return nil, err
+	}
+	if err := ew.Error(); err != nil {
+		return nil, err
+	}
+
+	return &bpb.KVList{Kv: []*bpb.KV{
+		{Value: nodes.Bytes(), Version: 1},
+		{Value: edges.Bytes(), Version: 4}, // CSV edges
+	}}, nil
+}
+
+// toDQLQueries returns the triples of the posting list of a persisted query. They're always
+// written as RDF, to a file of their own.
+func (e *exporter) toDQLQueries() (*bpb.KVList, error) {
+	list, err := e.toRDF()
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range list.Kv {
+		kv.Version = 5 // Persisted queries
+	}
+	return list, nil
+}
+
+// toParquet returns the postings of the list, which get appended to the Parquet file of the
+// predicate by parquetExport.
+func (e *exporter) toParquet() (*bpb.KVList, error) {
+	var list pb.PostingList
+	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+		list.Postings = append(list.Postings, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	val, err := list.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	kv := &bpb.KV{
+		Key:     x.DataKey(e.attr, e.uid),
+		Value:   val,
+		Version: 1,
+	}
+	return listWrap(kv), nil
+}
+
+// parquetExport writes the data of every predicate to its own Parquet file. Besides the uid of
+// the node, the columns hold the value, typed after the schema of the predicate, the language
+// tag if the predicate has @lang, and the facets as a JSON object. The keys must come in order,
+// so that the file of a predicate can be closed as soon as the keys of the next one come in.
+type parquetExport struct {
+	storage  exportStorage
+	groupId  uint32
+	noSchema bool // whether the schema isn't loaded, e.g. when exporting from a p directory.
+
+	cur     *parquetFile    // the file of the predicate being exported.
+	done    map[string]bool // the predicates whose file has been closed.
+	writers []*fileWriter   // the writers of the closed files.
+}
+
+type parquetFile struct {
+	attr string
+	fw   *fileWriter
+	pw   *parquetWriter
+	tid  types.TypeID
+	lang bool
+}
+
+func newParquetExport(storage exportStorage, groupId uint32, noSchema bool) *parquetExport {
+	return &parquetExport{
+		storage:  storage,
+		groupId:  groupId,
+		noSchema: noSchema,
+		done:     make(map[string]bool),
+	}
+}
+
+// parquetValueColumn returns the column holding the values of the given type.
+func parquetValueColumn(tid types.TypeID) *parquetColumn {
+	c := &parquetColumn{name: "value"}
+	switch tid {
+	case types.UidID:
+		c.typ = parquetUint64
+	case types.IntID:
+		c.typ = parquetInt64
+	case types.FloatID:
+		c.typ = parquetDouble
+	case types.BoolID:
+		c.typ = parquetBoolean
+	case types.DateTimeID:
+		c.typ = parquetTimestampMicros
+	default:
+		// Everything else is exported in its string representation, as in the other formats.
+		c.typ = parquetUTF8
+	}
+	return c
+}
+
+// parquetValue converts the value of the posting to the Go type of the column of type tid.
+func parquetValue(p *pb.Posting, tid types.TypeID) (interface{}, error) {
+	if (tid == types.UidID) != (p.PostingType == pb.Posting_REF) {
+		return nil, errors.Errorf("posting of type %v doesn't match the schema type %s",
+			p.PostingType, tid.Name())
+	}
+	if tid == types.UidID {
+		return p.Uid, nil
+	}
+
+	val := types.Val{Tid: types.TypeID(p.ValType), Value: p.Value}
+	switch tid {
+	case types.IntID, types.FloatID, types.BoolID, types.DateTimeID:
+		v, err := types.Convert(val, tid)
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := v.Value.(time.Time); ok {
+			return t.UnixNano() / int64(time.Microsecond), nil
+		}
+		return v.Value, nil
+	default:
+		return valToStr(val)
+	}
+}
+
+// typesWithPredicate returns the names of the types having the predicate as a field.
+func typesWithPredicate(attr string) []string {
+	var names []string
+	for _, name := range schema.State().Types() {
+		typ, ok := schema.State().GetType(name)
+		if !ok {
+			continue
+		}
+		for _, field := range typ.Fields {
+			if field.Predicate == attr {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (pe *parquetExport) fileFor(attr string, first *pb.Posting) (*parquetFile, error) {
+	if pe.cur != nil && pe.cur.attr == attr {
+		return pe.cur, nil
+	}
+	if err := pe.closeFile(); err != nil {
+		return nil, err
+	}
+	if pe.done[attr] {
+		return nil, errors.Errorf("The keys of predicate %s aren't contiguous in the export", attr)
+	}
+
+	metadata := map[string]string{"dgraph.predicate": attr}
+	f := &parquetFile{attr: attr}
+	var su pb.SchemaUpdate
+	var ok bool
+	if !pe.noSchema {
+		su, ok = schema.State().Get(context.Background(), attr)
+	}
+	if ok {
+		f.tid, f.lang = types.TypeID(su.ValueType), su.Lang
+		kvs, err := toSchema(attr, &su)
+		if err != nil {
+			return nil, err
+		}
+		metadata["dgraph.schema"] = strings.TrimSpace(string(kvs.Kv[0].Value))
+		if names := typesWithPredicate(attr); len(names) > 0 {
+			metadata["dgraph.types"] = strings.Join(names, ",")
+		}
+	} else {
+		// Without a schema, the column gets the type of the first value of the predicate.
+		f.tid, f.lang = types.TypeID(first.ValType), true
+		if first.PostingType == pb.Posting_REF {
+			f.tid = types.UidID
+		}
+	}
+
+	columns := []*parquetColumn{
+		{name: "uid", typ: parquetUint64},
+		parquetValueColumn(f.tid),
+	}
+	if f.lang {
+		columns = append(columns, &parquetColumn{name: "lang", typ: parquetUTF8, optional: true})
+	}
+	columns = append(columns, &parquetColumn{name: "facets", typ: parquetUTF8, optional: true})
+
+	var err error
+	f.fw, err = pe.storage.openFile(
+		fmt.Sprintf("g%02d.%s.parquet", pe.groupId, url.PathEscape(attr)))
+	if err != nil {
+		return nil, err
+	}
+	if f.pw, err = newParquetWriter(f.fw, columns, metadata); err != nil {
+		return nil, err
+	}
+	pe.cur = f
+	return f, nil
+}
+
+// closeFile writes the metadata of the current file and closes it.
+func (pe *parquetExport) closeFile() error {
+	f := pe.cur
+	if f == nil {
+		return nil
+	}
+	pe.cur = nil
+	if err := f.pw.close(); err != nil {
+		return err
+	}
+	if err := f.fw.Close(); err != nil {
+		return err
+	}
+	pe.done[f.attr] = true
+	pe.writers = append(pe.writers, f.fw)
+	return nil
+}
+
+// add appends the postings sent by toParquet to the file of their predicate.
+func (pe *parquetExport) add(kv *bpb.KV) error {
+	pk, err := x.Parse(kv.Key)
+	if err != nil {
+		return err
+	}
+	var list pb.PostingList
+	if err := list.Unmarshal(kv.Value); err != nil {
+		return err
+	}
+	if len(list.Postings) == 0 {
+		return nil
+	}
+	f, err := pe.fileFor(pk.Attr, list.Postings[0])
+	if err != nil {
+		return err
+	}
+
+	for _, p := range list.Postings {
+		val, err := parquetValue(p, f.tid)
+		if err != nil {
+			glog.Errorf("Ignoring error: %+v\n", err)
+			continue
+		}
+		fcts, err := facetsToJSON(p.Facets)
+		if err != nil {
+			glog.Errorf("Ignoring error: %+v", err)
+			continue
+		}
+
+		row := []interface{}{pk.Uid, val}
+		if f.lang {
+			var lang interface{}
+			if p.PostingType == pb.Posting_VALUE_LANG {
+				lang = string(p.LangTag)
+			}
+			row = append(row, lang)
+		}
+		var fctsVal interface{}
+		if fcts != "" {
+			fctsVal = fcts
+		}
+		row = append(row, fctsVal)
+		if err := f.pw.appendRow(row...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finish closes the last Parquet file and returns the writers of all the files.
+func (pe *parquetExport) finish() ([]*fileWriter, error) {
+	if err := pe.closeFile(); err != nil {
+		return nil, err
+	}
+	return pe.writers, nil
+}
+
 func toSchema(attr string, update *pb.SchemaUpdate) (*bpb.KVList, error) {
 	// bytes.Buffer never returns error for any of the writes. So, we don't need to check them.
 	var buf bytes.Buffer
@@ -311,6 +677,9 @@ func toSchema(attr string, update *pb.SchemaUpdate) (*bpb.KVList, error) {
 	if update.GetUpsert() {
 		x.Check2(buf.WriteString(" @upsert"))
 	}
+	if update.GetUnique() {
+		x.Check2(buf.WriteString(" @unique"))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
@@ -326,7 +695,12 @@ func toType(attr string, update pb.TypeUpdate) (*bpb.KVList, error) {
 		x.Check2(buf.WriteString(fieldToString(field)))
 	}
 
-	x.Check2(buf.WriteString("}\n"))
+	x.Check2(buf.WriteString("}"))
+	for _, index := range update.Indexes {
+		x.Check2(buf.WriteString(fmt.Sprintf(" @index(%s)",
+			strings.Join(index.Predicates, ", "))))
+	}
+	x.Check2(buf.WriteString("\n"))
 
 	kv := &bpb.KV{
 		Value:   buf.Bytes(),
@@ -354,10 +728,12 @@ func fieldToString(update *pb.SchemaUpdate) string {
 type fileWriter struct {
 	fd           *os.File
 	bw           *bufio.Writer
-	gw           *gzip.Writer
+	ew           io.Writer    // encrypts the data, if an encryption key is set.
+	gw           *gzip.Writer // nil if the file isn't gzipped.
 	relativePath string
 }
 
+// open creates the file at fpath. Files with the .gz extension are gzipped.
 func (writer *fileWriter) open(fpath string) error {
 	var err error
 	writer.fd, err = os.Create(fpath)
@@ -365,20 +741,37 @@ func (writer *fileWriter) open(fpath string) error {
 		return err
 	}
 	writer.bw = bufio.NewWriterSize(writer.fd, 1e6)
-	w, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
+	writer.ew, err = enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
 	if err != nil {
 		return err
 	}
-	writer.gw, err = gzip.NewWriterLevel(w, gzip.BestCompression)
+	if filepath.Ext(fpath) != ".gz" {
+		return nil
+	}
+	writer.gw, err = gzip.NewWriterLevel(writer.ew, gzip.BestCompression)
 	return err
 }
 
+func (writer *fileWriter) Write(b []byte) (int, error) {
+	if writer.gw != nil {
+		return writer.gw.Write(b)
+	}
+	return writer.ew.Write(b)
+}
+
+// Close flushes and closes the file. Closing an already closed file is a no-op, as the Parquet
+// files are closed during the export but still passed to finishWriting.
 func (writer *fileWriter) Close() error {
-	if err := writer.gw.Flush(); err != nil {
-		return err
+	if writer.fd == nil {
+		return nil
 	}
-	if err := writer.gw.Close(); err != nil {
-		return err
+	if writer.gw != nil {
+		if err := writer.gw.Flush(); err != nil {
+			return err
+		}
+		if err := writer.gw.Close(); err != nil {
+			return err
+		}
 	}
 	if err := writer.bw.Flush(); err != nil {
 		return err
@@ -386,7 +779,9 @@ func (writer *fileWriter) Close() error {
 	if err := writer.fd.Sync(); err != nil {
 		return err
 	}
-	return writer.fd.Close()
+	err := writer.fd.Close()
+	writer.fd = nil
+	return err
 }
 
 // ExportedFiles has the relative path of files that were written during export
@@ -507,8 +902,12 @@ func (r *remoteExportStorage) finishWriting(fs ...*fileWriter) (ExportedFiles, e
 		filePath := path.Join(r.les.destination, f)
 		// FIXME: tejas [06/2020] - We could probably stream these results, but it's easier to copy for now
 		glog.Infof("Uploading from %s to %s\n", filePath, d)
+		contentType := "application/gzip"
+		if filepath.Ext(f) != ".gz" {
+			contentType = "application/octet-stream"
+		}
 		_, err := r.mc.FPutObject(r.bucket, d, filePath, minio.PutObjectOptions{
-			ContentType: "application/gzip",
+			ContentType: contentType,
 		})
 		if err != nil {
 			return nil, err
@@ -529,7 +928,7 @@ func newExportStorage(in *pb.ExportRequest, backupName string) (exportStorage, e
 	}
 }
 
-// export creates a export of data by exporting it as an RDF gzip.
+// export creates a export of data in the requested format.
 func export(ctx context.Context, in *pb.ExportRequest) (ExportedFiles, error) {
 
 	if in.GroupId != groups().groupId() {
@@ -561,9 +960,28 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 
 	xfmt := exportFormats[in.Format]
 
-	dataWriter, err := exportStorage.openFile(fmt.Sprintf("g%02d%s", in.GroupId, xfmt.ext+".gz"))
-	if err != nil {
-		return nil, err
+	var dataWriter, edgeWriter *fileWriter
+	var pqExport *parquetExport
+	switch in.Format {
+	case "csv":
+		dataWriter, err = exportStorage.openFile(
+			fmt.Sprintf("g%02d.nodes%s", in.GroupId, xfmt.ext+".gz"))
+		if err != nil {
+			return nil, err
+		}
+		edgeWriter, err = exportStorage.openFile(
+			fmt.Sprintf("g%02d.edges%s", in.GroupId, xfmt.ext+".gz"))
+		if err != nil {
+			return nil, err
+		}
+	case "parquet":
+		// The Parquet files are opened as the data of each predicate comes in.
+		pqExport = newParquetExport(exportStorage, in.GroupId, skipZero)
+	default:
+		dataWriter, err = exportStorage.openFile(fmt.Sprintf("g%02d%s", in.GroupId, xfmt.ext+".gz"))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	schemaWriter, err := exportStorage.openFile(fmt.Sprintf("g%02d%s", in.GroupId, ".schema.gz"))
@@ -577,8 +995,19 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 		return nil, err
 	}
 
+	dqlQueriesWriter, err := exportStorage.openFile(
+		fmt.Sprintf("g%02d%s", in.GroupId, ".dql_queries.rdf.gz"))
+	if err != nil {
+		return nil, err
+	}
+
 	stream := db.NewStreamAt(in.ReadTs)
 	stream.LogPrefix = "Export"
+	if pqExport != nil {
+		// With a single goroutine, the keys are sent in order. This allows parquetExport to keep
+		// only the file of the current predicate open.
+		stream.NumGo = 1
+	}
 	stream.ChooseKey = func(item *badger.Item) bool {
 		// Skip exporting delete data including Schema and Types.
 		if item.IsDeletedOrExpired() {
@@ -686,6 +1115,14 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			}
 			return listWrap(kv), nil
 
+		case pk.IsData() && (pk.Attr == "dgraph.dql.id" || pk.Attr == "dgraph.dql.query"):
+			// Export the persisted queries.
+			e.pl, err = posting.ReadPostingList(key, itr)
+			if err != nil {
+				return nil, err
+			}
+			return e.toDQLQueries()
+
 		case pk.IsData():
 			e.pl, err = posting.ReadPostingList(key, itr)
 			if err != nil {
@@ -693,7 +1130,8 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			}
 
 			// The GraphQL layer will create a node of type "dgraph.graphql". That entry
-			// should not be exported.
+			// should not be exported. Every persisted query has a node of type "dgraph.dql",
+			// exported along with the query.
 			if pk.Attr == "dgraph.type" {
 				vals, err := e.pl.AllValues(in.ReadTs)
 				if err != nil {
@@ -707,6 +1145,9 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 					if string(val) == "dgraph.graphql" {
 						return nil, nil
 					}
+					if string(val) == "dgraph.dql" {
+						return e.toDQLQueries()
+					}
 				}
 			}
 
@@ -715,6 +1156,10 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 				return e.toJSON()
 			case "rdf":
 				return e.toRDF()
+			case "csv":
+				return e.toCSV()
+			case "parquet":
+				return e.toParquet()
 			default:
 				glog.Fatalf("Invalid export format found: %s", in.Format)
 			}
@@ -730,9 +1175,11 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 	switch in.Format {
 	case "json":
 		separator = []byte(",\n")
-	case "rdf":
-		// The separator for RDF should be empty since the toRDF function already
-		// adds newline to each RDF entry.
+	case "rdf", "csv":
+		// The separator for RDF and CSV should be empty since the toRDF and toCSV
+		// functions already add a newline to each entry.
+	case "parquet":
+		// Parquet data isn't written as is, see parquetExport.
 	default:
 		glog.Fatalf("Invalid export format found: %s", in.Format)
 	}
@@ -745,6 +1192,13 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 				continue
 			}
 
+			if kv.Version == 1 && pqExport != nil {
+				if err := pqExport.add(kv); err != nil {
+					return err
+				}
+				continue
+			}
+
 			var writer *fileWriter
 			switch kv.Version {
 			case 1: // data
@@ -753,13 +1207,17 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 				writer = schemaWriter
 			case 3:
 				writer = gqlSchemaWriter
+			case 4: // CSV edges
+				writer = edgeWriter
+			case 5: // persisted queries
+				writer = dqlQueriesWriter
 			default:
 				glog.Fatalf("Invalid data type found: %x", kv.Key)
 			}
 
 			if kv.Version == 1 { // only insert separator for data
 				if hasDataBefore {
-					if _, err := writer.gw.Write(separator); err != nil {
+					if _, err := writer.Write(separator); err != nil {
 						return err
 					}
 				}
@@ -768,7 +1226,7 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 				hasDataBefore = true
 			}
 
-			if _, err := writer.gw.Write(kv.Value); err != nil {
+			if _, err := writer.Write(kv.Value); err != nil {
 				return err
 			}
 		}
@@ -777,17 +1235,38 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 	}
 
 	// All prepwork done. Time to roll.
-	if _, err = dataWriter.gw.Write([]byte(xfmt.pre)); err != nil {
-		return nil, err
+	if dataWriter != nil {
+		if _, err = dataWriter.Write([]byte(xfmt.pre)); err != nil {
+			return nil, err
+		}
+	}
+	if edgeWriter != nil {
+		if _, err = edgeWriter.Write([]byte(csvEdgesHeader)); err != nil {
+			return nil, err
+		}
 	}
 	if err := stream.Orchestrate(ctx); err != nil {
 		return nil, err
 	}
-	if _, err = dataWriter.gw.Write([]byte(xfmt.post)); err != nil {
-		return nil, err
+
+	writers := []*fileWriter{schemaWriter, gqlSchemaWriter, dqlQueriesWriter}
+	switch {
+	case pqExport != nil:
+		pqWriters, err := pqExport.finish()
+		if err != nil {
+			return nil, err
+		}
+		writers = append(pqWriters, writers...)
+	case edgeWriter != nil:
+		writers = append([]*fileWriter{dataWriter, edgeWriter}, writers...)
+	default:
+		if _, err = dataWriter.Write([]byte(xfmt.post)); err != nil {
+			return nil, err
+		}
+		writers = append([]*fileWriter{dataWriter}, writers...)
 	}
 	glog.Infof("Export DONE for group %d at timestamp %d.", in.GroupId, in.ReadTs)
-	return exportStorage.finishWriting(dataWriter, schemaWriter, gqlSchemaWriter)
+	return exportStorage.finishWriting(writers...)
 }
 
 // Export request is used to trigger exports for the request list of groups.
@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -32,8 +33,10 @@ import (
 	"testing"
 	"time"
 
+	bpb "github.com/dgraph-io/badger/v2/pb"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/parquet"
 
 	"github.com/dgraph-io/dgo/v200/protos/api"
 
@@ -91,6 +94,15 @@ func populateGraphExport(t *testing.T) {
 	}
 	// This triplet will be deleted to ensure deleted nodes do not affect the output of the export.
 	edgeToDelete := `<7> <name> "node_to_delete" .`
+
+	for _, edge := range rdfEdges {
+		processExportEdge(t, edge, true)
+	}
+	processExportEdge(t, edgeToDelete, false)
+}
+
+// processExportEdge sets or deletes the edge given as an RDF triple.
+func processExportEdge(t *testing.T, edge string, set bool) {
 	idMap := map[string]uint64{
 		"1": 1,
 		"2": 2,
@@ -101,26 +113,18 @@ func populateGraphExport(t *testing.T) {
 		"7": 7,
 	}
 
-	l := &lex.Lexer{}
-	processEdge := func(edge string, set bool) {
-		nq, err := chunker.ParseRDF(edge, l)
-		require.NoError(t, err)
-		rnq := gql.NQuad{NQuad: &nq}
-		err = facets.SortAndValidate(rnq.Facets)
-		require.NoError(t, err)
-		e, err := rnq.ToEdgeUsing(idMap)
-		require.NoError(t, err)
-		if set {
-			addEdge(t, e, getOrCreate(x.DataKey(e.Attr, e.Entity)))
-		} else {
-			delEdge(t, e, getOrCreate(x.DataKey(e.Attr, e.Entity)))
-		}
-	}
-
-	for _, edge := range rdfEdges {
-		processEdge(edge, true)
+	nq, err := chunker.ParseRDF(edge, &lex.Lexer{})
+	require.NoError(t, err)
+	rnq := gql.NQuad{NQuad: &nq}
+	err = facets.SortAndValidate(rnq.Facets)
+	require.NoError(t, err)
+	e, err := rnq.ToEdgeUsing(idMap)
+	require.NoError(t, err)
+	if set {
+		addEdge(t, e, getOrCreate(x.DataKey(e.Attr, e.Entity)))
+	} else {
+		delEdge(t, e, getOrCreate(x.DataKey(e.Attr, e.Entity)))
 	}
-	processEdge(edgeToDelete, false)
 }
 
 func initTestExport(t *testing.T, schemaStr string) {
@@ -161,7 +165,8 @@ func initTestExport(t *testing.T, schemaStr string) {
 	require.NoError(t, txn.CommitAt(1, nil))
 }
 
-func getExportFileList(t *testing.T, bdir string) (dataFiles, schemaFiles, gqlSchema []string) {
+func getExportFileList(t *testing.T, bdir string) (dataFiles, schemaFiles, gqlSchema,
+	dqlQueries []string) {
 	searchDir := bdir
 	err := filepath.Walk(searchDir, func(path string, f os.FileInfo, err error) error {
 		if f.IsDir() {
@@ -171,6 +176,8 @@ func getExportFileList(t *testing.T, bdir string) (dataFiles, schemaFiles, gqlSc
 			switch {
 			case strings.Contains(path, "gql_schema"):
 				gqlSchema = append(gqlSchema, path)
+			case strings.Contains(path, "dql_queries"):
+				dqlQueries = append(dqlQueries, path)
 			case strings.Contains(path, "schema"):
 				schemaFiles = append(schemaFiles, path)
 			default:
@@ -243,8 +250,9 @@ func TestExportRdf(t *testing.T) {
 	files, err := export(context.Background(), &pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "rdf"})
 	require.NoError(t, err)
 
-	fileList, schemaFileList, gqlSchema := getExportFileList(t, bdir)
-	require.Equal(t, len(files), len(fileList)+len(schemaFileList)+len(gqlSchema))
+	fileList, schemaFileList, gqlSchema, dqlQueries := getExportFileList(t, bdir)
+	require.Equal(t, len(files),
+		len(fileList)+len(schemaFileList)+len(gqlSchema)+len(dqlQueries))
 
 	file := fileList[0]
 	f, err := os.Open(file)
@@ -341,8 +349,9 @@ func TestExportJson(t *testing.T) {
 	files, err := export(context.Background(), &req)
 	require.NoError(t, err)
 
-	fileList, schemaFileList, gqlSchema := getExportFileList(t, bdir)
-	require.Equal(t, len(files), len(fileList)+len(schemaFileList)+len(gqlSchema))
+	fileList, schemaFileList, gqlSchema, dqlQueries := getExportFileList(t, bdir)
+	require.Equal(t, len(files),
+		len(fileList)+len(schemaFileList)+len(gqlSchema)+len(dqlQueries))
 
 	file := fileList[0]
 	f, err := os.Open(file)
@@ -372,6 +381,212 @@ func TestExportJson(t *testing.T) {
 	checkExportGqlSchema(t, gqlSchema)
 }
 
+func TestExportPersistedQueries(t *testing.T) {
+	initTestExport(t, "name: string @index(exact) .")
+
+	queryEdges := []string{
+		`<9> <dgraph.dql.id> "names" .`,
+		`<9> <dgraph.dql.query> "{ q(func: has(name)) { name } }" .`,
+		`<9> <dgraph.type> "dgraph.dql" .`,
+	}
+	for _, edge := range queryEdges {
+		processExportEdge(t, edge, true)
+	}
+	defer func() {
+		for _, edge := range queryEdges {
+			processExportEdge(t, edge, false)
+		}
+	}()
+
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+
+	time.Sleep(1 * time.Second)
+
+	x.WorkerConfig.ExportPath = bdir
+	readTs := timestamp()
+	// Do the following so export won't block forever for readTs.
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: readTs})
+	req := pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "json"}
+	_, err = export(context.Background(), &req)
+	require.NoError(t, err)
+
+	readFile := func(file string) string {
+		f, err := os.Open(file)
+		require.NoError(t, err)
+		defer f.Close()
+		r, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		buf, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		return string(buf)
+	}
+
+	// The persisted queries are written as RDF to their own file, whatever the format of the
+	// export.
+	fileList, _, _, dqlQueries := getExportFileList(t, bdir)
+	require.NotContains(t, readFile(fileList[0]), "dgraph.dql")
+	require.Equal(t, 1, len(dqlQueries))
+	lines := strings.Split(strings.TrimSpace(readFile(dqlQueries[0])), "\n")
+	require.ElementsMatch(t, []string{
+		`<0x9> <dgraph.dql.id> "names" .`,
+		`<0x9> <dgraph.dql.query> "{ q(func: has(name)) { name } }" .`,
+		`<0x9> <dgraph.type> "dgraph.dql" .`,
+	}, lines)
+}
+
+func TestExportCsv(t *testing.T) {
+	initTestExport(t, "name: string @index(exact) .")
+
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+
+	time.Sleep(1 * time.Second)
+
+	x.WorkerConfig.ExportPath = bdir
+	readTs := timestamp()
+	// Do the following so export won't block forever for readTs.
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: readTs})
+	req := pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "csv"}
+	files, err := export(context.Background(), &req)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(files))
+
+	readCsv := func(name string) [][]string {
+		matches, err := filepath.Glob(filepath.Join(bdir, "*", name))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(matches))
+		f, err := os.Open(matches[0])
+		require.NoError(t, err)
+		defer f.Close()
+		r, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		records, err := csv.NewReader(r).ReadAll()
+		require.NoError(t, err)
+		return records
+	}
+
+	nodes := readCsv("g01.nodes.csv.gz")
+	require.Equal(t, []string{"uid", "predicate", "value", "type", "lang", "facets"}, nodes[0])
+	require.ElementsMatch(t, [][]string{
+		{"0x1", "name", "pho\ton", "default", "", ""},
+		{"0x2", "name", "pho\ton", "default", "en", ""},
+		{"0x3", "name", "First Line\nSecondLine", "default", "", ""},
+		{"0x5", "name", "", "default", "", ""},
+		{"0x6", "name", "Ding!\u0007Ding!\u0007Ding!\u0007", "default", "", ""},
+	}, nodes[1:])
+
+	edges := readCsv("g01.edges.csv.gz")
+	require.Equal(t, []string{"src", "predicate", "dst", "facets"}, edges[0])
+	require.ElementsMatch(t, [][]string{
+		{"0x1", "friend", "0x5", ""},
+		{"0x2", "friend", "0x5", ""},
+		{"0x3", "friend", "0x5", ""},
+		{"0x4", "friend", "0x5", `{"age":33,"close":"true","game":"football",` +
+			`"poem":"roses are red\nviolets are blue","since":"2005-05-02T15:04:05Z"}`},
+	}, edges[1:])
+}
+
+func TestExportParquet(t *testing.T) {
+	initTestExport(t, "name: string @index(exact) @lang .")
+
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+
+	time.Sleep(1 * time.Second)
+
+	x.WorkerConfig.ExportPath = bdir
+	readTs := timestamp()
+	// Do the following so export won't block forever for readTs.
+	posting.Oracle().ProcessDelta(&pb.OracleDelta{MaxAssigned: readTs})
+	req := pb.ExportRequest{ReadTs: readTs, GroupId: 1, Format: "parquet"}
+	files, err := export(context.Background(), &req)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(files))
+
+	readFile := func(name string) (*parquet.FileMetaData, [][]interface{}) {
+		matches, err := filepath.Glob(filepath.Join(bdir, "*", name))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(matches))
+		return readParquet(t, matches[0])
+	}
+	columnNames := func(meta *parquet.FileMetaData) []string {
+		var names []string
+		for _, elem := range meta.GetSchema()[1:] {
+			names = append(names, elem.GetName())
+		}
+		return names
+	}
+
+	names, values := readFile("g01.name.parquet")
+	require.Equal(t, int64(5), names.GetNumRows())
+	require.Equal(t, []string{"uid", "value", "lang", "facets"}, columnNames(names))
+	require.Equal(t, "<name>:string @index(exact) @lang .",
+		parquetMetadata(names)["dgraph.schema"])
+	require.Equal(t, []interface{}{int64(1), int64(2), int64(3), int64(5), int64(6)}, values[0])
+	require.Equal(t, "First Line\nSecondLine", values[1][2])
+	require.Equal(t, []interface{}{nil, "en", nil, nil, nil}, values[2])
+
+	// The schema of friend isn't loaded, the type of the column comes from the data.
+	friends, values := readFile("g01.friend.parquet")
+	require.Equal(t, int64(4), friends.GetNumRows())
+	require.Equal(t, []string{"uid", "value", "lang", "facets"}, columnNames(friends))
+	value := friends.GetSchema()[2]
+	require.Equal(t, parquet.Type_INT64, value.GetType())
+	require.Equal(t, parquet.ConvertedType_UINT_64, value.GetConvertedType())
+	require.Equal(t, []interface{}{int64(5), int64(5), int64(5), int64(5)}, values[1])
+	require.Nil(t, values[3][0])
+	require.Contains(t, values[3][3], `"game":"football"`)
+}
+
+func TestParquetExportClosesFiles(t *testing.T) {
+	bdir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	defer os.RemoveAll(bdir)
+
+	storage, err := newLocalExportStorage(bdir, "export")
+	require.NoError(t, err)
+	pe := newParquetExport(storage, 1, true)
+
+	add := func(attr string, uid uint64) error {
+		list := pb.PostingList{Postings: []*pb.Posting{
+			{ValType: pb.Posting_STRING, Value: []byte(fmt.Sprintf("%s-%d", attr, uid))},
+		}}
+		val, err := list.Marshal()
+		require.NoError(t, err)
+		return pe.add(&bpb.KV{Key: x.DataKey(attr, uid), Value: val, Version: 1})
+	}
+
+	require.NoError(t, add("a", 1))
+	require.NoError(t, add("a", 2))
+	first := pe.cur.fw
+	require.NotNil(t, first.fd)
+
+	// The file of a is closed once the stream moves on to b.
+	require.NoError(t, add("b", 1))
+	require.Nil(t, first.fd)
+	require.Equal(t, []*fileWriter{first}, pe.writers)
+
+	// Going back to a would overwrite its file.
+	require.Error(t, add("a", 3))
+
+	writers, err := pe.finish()
+	require.NoError(t, err)
+	require.Len(t, writers, 2)
+	files, err := storage.finishWriting(writers...)
+	require.NoError(t, err)
+
+	meta, values := readParquet(t, filepath.Join(bdir, files[0]))
+	require.Equal(t, "a", parquetMetadata(meta)["dgraph.predicate"])
+	require.Equal(t, []interface{}{"a-1", "a-2"}, values[1])
+	meta, values = readParquet(t, filepath.Join(bdir, files[1]))
+	require.Equal(t, "b", parquetMetadata(meta)["dgraph.predicate"])
+	require.Equal(t, []interface{}{"b-1"}, values[1])
+}
+
 const exportRequest = `mutation export($format: String!) {
 	export(input: {format: $format}) {
 		exportedFiles
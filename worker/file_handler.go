@@ -257,7 +257,7 @@ func pathExist(path string) bool {
 
 func (h *fileHandler) ExportBackup(backupDir, exportDir, format string,
 	key x.SensitiveByteSlice) error {
-	if format != "json" && format != "rdf" {
+	if _, ok := exportFormats[format]; !ok {
 		return errors.Errorf("invalid format %s", format)
 	}
 
@@ -496,10 +496,31 @@ func (g *groupi) Tablet(key string) (*pb.Tablet, error) {
 
 	// We don't know about this tablet.
 	// Check with dgraphzero if we can serve it.
-	tablet = &pb.Tablet{GroupId: g.groupId(), Predicate: key}
+	tablet = &pb.Tablet{GroupId: g.tabletGroup(key), Predicate: key}
 	return g.sendTablet(tablet)
 }
 
+// tabletGroup returns the group that should serve the new tablet for key. That's this group,
+// unless key is in a composite index over predicates that are already served. All the predicates
+// of a composite index must be served by the same group, as their values are read together when
+// the index is updated, so the tablet then goes to their group.
+func (g *groupi) tabletGroup(key string) uint32 {
+	for _, preds := range schema.State().CompositeIndexes(key) {
+		for _, pred := range preds {
+			if pred == key {
+				continue
+			}
+			g.RLock()
+			tablet := g.tablets[pred]
+			g.RUnlock()
+			if tablet.GetGroupId() != 0 {
+				return tablet.GetGroupId()
+			}
+		}
+	}
+	return g.groupId()
+}
+
 func (g *groupi) ForceTablet(key string) (*pb.Tablet, error) {
 	return g.sendTablet(&pb.Tablet{GroupId: g.groupId(), Predicate: key, Force: true})
 }
@@ -619,6 +640,20 @@ func GroupId() uint32 {
 	return groups().groupId()
 }
 
+// ServesPredicates returns true if all of preds are served by the group of this worker. A
+// predicate that isn't served by any group yet isn't served by this one.
+func ServesPredicates(preds []string) bool {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	for _, pred := range preds {
+		if tablet := g.tablets[pred]; tablet == nil || tablet.GroupId != g.groupId() {
+			return false
+		}
+	}
+	return true
+}
+
 func (g *groupi) triggerMembershipSync() {
 	// It's ok if we miss the trigger, periodic membership sync runs every minute.
 	select {
@@ -0,0 +1,81 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/conn"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// historyTs returns the timestamp at the start of the history retention window, as known by
+// Zero. It returns zero if history retention is disabled.
+func (g *groupi) historyTs() uint64 {
+	g.RLock()
+	defer g.RUnlock()
+	return g.state.GetHistoryTs()
+}
+
+// discardTs returns the timestamp below which Badger can drop the older versions of the keys,
+// once a snapshot has been taken at snapshotTs. With history retention enabled on Zero, the
+// versions needed to read at any time within the retention window are kept.
+func discardTs(snapshotTs uint64) uint64 {
+	if historyTs := groups().historyTs(); historyTs > 0 {
+		return x.Min(snapshotTs, historyTs)
+	}
+	return snapshotTs
+}
+
+// parseAsOf parses the as_of option of a query, given either as a timestamp or as a time in
+// RFC 3339 format.
+func parseAsOf(asOf string) (*pb.TsSample, error) {
+	if t, err := time.Parse(time.RFC3339, asOf); err == nil {
+		return &pb.TsSample{UnixNano: t.UnixNano()}, nil
+	}
+	ts, err := strconv.ParseUint(asOf, 0, 64)
+	if err != nil || ts == 0 {
+		return nil, errors.Errorf("as_of must be a timestamp or a time in RFC 3339 format. Got: %q",
+			asOf)
+	}
+	return &pb.TsSample{Ts: ts}, nil
+}
+
+// AsOfTs parses the as_of option of a query and returns the timestamp to read at. Zero maps the
+// time to a timestamp, and returns an error if the versions needed to read at that timestamp
+// might have been discarded already.
+func AsOfTs(ctx context.Context, asOf string) (uint64, error) {
+	in, err := parseAsOf(asOf)
+	if err != nil {
+		return 0, err
+	}
+	pl := groups().connToZeroLeader()
+	if pl == nil {
+		return 0, conn.ErrNoConnection
+	}
+	c := pb.NewZeroClient(pl.Get())
+	out, err := c.AsOfTs(ctx, in)
+	if err != nil {
+		return 0, err
+	}
+	return out.Ts, nil
+}
@@ -0,0 +1,128 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v200"
+	"github.com/dgraph-io/dgo/v200/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/testutil"
+	"github.com/dgraph-io/dgraph/x"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseAsOf(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	in, err := parseAsOf(now.Format(time.RFC3339))
+	require.NoError(t, err)
+	require.Equal(t, &pb.TsSample{UnixNano: now.UnixNano()}, in)
+
+	in, err = parseAsOf("1234")
+	require.NoError(t, err)
+	require.Equal(t, &pb.TsSample{Ts: 1234}, in)
+
+	for _, asOf := range []string{"", "0", "-5", "yesterday", "2020-06-01"} {
+		_, err := parseAsOf(asOf)
+		require.Error(t, err, "as_of: %q", asOf)
+		require.Contains(t, err.Error(), "as_of must be a timestamp or a time in RFC 3339 format")
+	}
+}
+
+func TestDiscardTs(t *testing.T) {
+	state := gr.state
+	defer func() { gr.state = state }()
+
+	gr.state = nil
+	require.Equal(t, uint64(100), discardTs(100))
+
+	// The versions within the history retention window are kept.
+	gr.state = &pb.MembershipState{HistoryTs: 40}
+	require.Equal(t, uint64(40), discardTs(100))
+	require.Equal(t, uint64(30), discardTs(30))
+}
+
+func setValue(t *testing.T, dg *dgo.Dgraph, uid, val string) uint64 {
+	resp, err := dg.NewTxn().Mutate(context.Background(), &api.Mutation{
+		SetNquads: []byte(fmt.Sprintf(`<%s> <as_of_value> "%s" .`, uid, val)),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	return resp.Txn.CommitTs
+}
+
+func queryAsOfGrpc(dg *dgo.Dgraph, q string, ts uint64) (string, error) {
+	md := metadata.Pairs(x.AsOfKey, strconv.FormatUint(ts, 10))
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := dg.NewReadOnlyTxn().Query(ctx, q)
+	if err != nil {
+		return "", err
+	}
+	return string(resp.Json), nil
+}
+
+func queryAsOfHttp(t *testing.T, q string, ts uint64) string {
+	url := fmt.Sprintf("http://localhost:8180/query?%s=%d", x.AsOfKey, ts)
+	resp, err := http.Post(url, "application/graphql+-", strings.NewReader(q))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestQueryAsOf(t *testing.T) {
+	dg, err := testutil.DgraphClient("localhost:9180")
+	require.NoError(t, err)
+	require.NoError(t, dg.Alter(context.Background(), &api.Operation{
+		Schema: `as_of_value: string .`,
+	}))
+
+	oldTs := setValue(t, dg, "0x1000", "old")
+	setValue(t, dg, "0x1000", "new")
+
+	q := `{ q(func: uid(0x1000)) { as_of_value } }`
+	resp, err := testutil.RetryQuery(dg, q)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"q": [{"as_of_value": "new"}]}`, string(resp.Json))
+
+	res, err := queryAsOfGrpc(dg, q, oldTs)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"q": [{"as_of_value": "old"}]}`, res)
+	require.Contains(t, queryAsOfHttp(t, q, oldTs), `{"q":[{"as_of_value":"old"}]}`)
+
+	// Once a snapshot is taken past the old ts, its versions may be discarded, so the query must
+	// be rejected instead of returning partial data.
+	for i := 0; i < 200; i++ {
+		setValue(t, dg, "0x1001", strconv.Itoa(i))
+	}
+	waitForSnapshot(t, oldTs)
+
+	_, err = queryAsOfGrpc(dg, q, oldTs)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "below the history watermark")
+	require.Contains(t, queryAsOfHttp(t, q, oldTs), "below the history watermark")
+}
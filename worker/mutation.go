@@ -19,7 +19,9 @@ package worker
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -29,6 +31,8 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	otrace "go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/dgraph-io/badger/v2"
 	"github.com/dgraph-io/dgo/v200"
@@ -37,6 +41,7 @@ import (
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/tok"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/ristretto/z"
@@ -47,8 +52,38 @@ var (
 	ErrNonExistentTabletMessage = "Requested predicate is not being served by any tablet"
 	errNonExistentTablet        = errors.Errorf(ErrNonExistentTabletMessage)
 	errUnservedTablet           = errors.Errorf("Tablet isn't being served by this instance")
+
+	// ErrUniqueViolationMessage is the error message sent when a mutation can't keep the values
+	// of a predicate with the @unique directive unique.
+	ErrUniqueViolationMessage = "Could not enforce @unique"
+	// ErrUniqueViolation is matched by the errors returned when a mutation can't keep the values
+	// of a predicate with the @unique directive unique.
+	ErrUniqueViolation = errors.New(ErrUniqueViolationMessage)
 )
 
+// uniqueViolationError is the error returned when the values of a predicate with the @unique
+// directive can't be kept unique. Its message starts with ErrUniqueViolationMessage.
+type uniqueViolationError struct {
+	msg string
+}
+
+func (e *uniqueViolationError) Error() string {
+	return e.msg
+}
+
+// Is lets errors.Is match the error with ErrUniqueViolation.
+func (e *uniqueViolationError) Is(target error) bool {
+	return target == ErrUniqueViolation
+}
+
+// uniqueViolationf returns a uniqueViolationError, with the formatted details appended to
+// ErrUniqueViolationMessage.
+func uniqueViolationf(format string, args ...interface{}) error {
+	return &uniqueViolationError{
+		msg: ErrUniqueViolationMessage + " " + fmt.Sprintf(format, args...),
+	}
+}
+
 func isStarAll(v []byte) bool {
 	return bytes.Equal(v, []byte(x.Star))
 }
@@ -92,6 +127,9 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	case len(su.GetTokenizer()) > 0 || su.GetCount():
 		// Any index or count index.
 		getFn = txn.Get
+	case len(schema.State().CompositeIndexes(edge.Attr)) > 0:
+		// The old value is needed to update the composite indexes.
+		getFn = txn.Get
 	case su.GetValueType() == pb.Posting_UID && !su.GetList():
 		// Single UID, not a list.
 		getFn = txn.Get
@@ -120,6 +158,137 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	return plist.AddMutationWithIndex(ctx, edge, txn)
 }
 
+// uniqueTokenizer returns the first of the named tokenizers that isn't lossy, whose tokens
+// identify the values of a predicate with the @unique directive, or nil if there is none.
+func uniqueTokenizer(names []string) tok.Tokenizer {
+	for _, name := range names {
+		if tokenizer, ok := tok.GetTokenizer(name); ok && !tokenizer.IsLossy() {
+			return tokenizer
+		}
+	}
+	return nil
+}
+
+// checkUniqueValues returns an error if a value set by the edges for a predicate with the
+// @unique directive is held by another node, once all the edges are applied. Checking after
+// the edges are applied lets a mutation move a value from one node to another. The index keys
+// of these values are conflict keys regardless of the uid, so two concurrent transactions
+// can't both give the same value to different nodes.
+func checkUniqueValues(ctx context.Context, edges []*pb.DirectedEdge, txn *posting.Txn) error {
+	ctx = schema.GetWriteContext(ctx)
+	for _, edge := range edges {
+		if edge.Op != pb.DirectedEdge_SET || !schema.State().IsUnique(edge.Attr) {
+			continue
+		}
+		if schema.State().IsBeingIndexed(edge.Attr) {
+			// The index that is being built doesn't have all the values yet.
+			return uniqueViolationf("on predicate [%s] while its index is being built."+
+				" Please retry", edge.Attr)
+		}
+		tokenizer := uniqueTokenizer(schema.State().TokenizerNames(ctx, edge.Attr))
+		if tokenizer == nil {
+			return uniqueViolationf("on predicate [%s]: it has no index with a tokenizer that"+
+				" isn't lossy", edge.Attr)
+		}
+		schemaType, err := schema.State().TypeOf(edge.Attr)
+		if err != nil {
+			return err
+		}
+		sv, err := types.Convert(types.Val{Tid: types.TypeID(edge.ValueType), Value: edge.Value},
+			schemaType)
+		if err != nil {
+			return err
+		}
+		tokens, err := tok.BuildTokens(sv.Value, tokenizer)
+		if err != nil {
+			return err
+		}
+		for _, token := range tokens {
+			// The list cached by the transaction may have been built only from its deltas.
+			pl, err := txn.GetFromDisk(x.IndexKey(edge.Attr, token))
+			if err != nil {
+				return err
+			}
+			uids, err := pl.Uids(posting.ListOptions{ReadTs: txn.StartTs})
+			if err != nil {
+				return err
+			}
+			for _, uid := range uids.Uids {
+				if uid != edge.Entity {
+					return uniqueViolationf("on predicate [%s]: value [%v] is already held by"+
+						" node [%#x]", edge.Attr, sv.Value, uid)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkUniqueData returns an error if two nodes have the same value for the predicate of the
+// schema update at startTs. It's run when the @unique directive is added to a predicate.
+func checkUniqueData(ctx context.Context, su *pb.SchemaUpdate, startTs uint64) error {
+	tokenizer := uniqueTokenizer(su.Tokenizer)
+	if tokenizer == nil {
+		return uniqueViolationf("on predicate [%s]: it has no index with a tokenizer that"+
+			" isn't lossy", su.Predicate)
+	}
+	schemaType := types.TypeID(su.ValueType)
+
+	prefix := x.ParsedKey{Attr: su.Predicate}.DataPrefix()
+	txn := pstore.NewTransactionAt(startTs, false)
+	defer txn.Discard()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.AllVersions = true
+	iterOpt.Prefix = prefix
+	it := txn.NewIterator(iterOpt)
+	defer it.Close()
+
+	holders := make(map[string]uint64)
+	for it.Seek(prefix); it.Valid(); {
+		item := it.Item()
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return err
+		}
+		if pk.HasStartUid {
+			it.Next()
+			continue
+		}
+		// ReadPostingList moves the iterator past all the versions of the key.
+		l, err := posting.ReadPostingList(item.KeyCopy(nil), it)
+		if err != nil {
+			return err
+		}
+		vals, err := l.AllUntaggedValues(startTs)
+		if err != nil {
+			return err
+		}
+		for _, val := range vals {
+			sv, err := types.Convert(val, schemaType)
+			if err != nil {
+				return err
+			}
+			tokens, err := tok.BuildTokens(sv.Value, tokenizer)
+			if err != nil {
+				return err
+			}
+			for _, token := range tokens {
+				if uid, ok := holders[token]; ok && uid != pk.Uid {
+					return uniqueViolationf("on predicate [%s]: nodes [%#x] and [%#x] have the"+
+						" same value [%v]", su.Predicate, uid, pk.Uid, sv.Value)
+				}
+				holders[token] = pk.Uid
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+	}
+	return nil
+}
+
 func undoSchemaUpdate(predicate string) {
 	maxRetries := 10
 	loadErr := x.RetryUntilSuccess(maxRetries, 10*time.Millisecond, func() error {
@@ -131,8 +300,23 @@ func undoSchemaUpdate(predicate string) {
 	}
 }
 
-func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs uint64) error {
-	if len(updates) == 0 {
+func undoTypeUpdate(typeName string) {
+	maxRetries := 10
+	loadErr := x.RetryUntilSuccess(maxRetries, 10*time.Millisecond, func() error {
+		return schema.LoadType(typeName)
+	})
+
+	if loadErr != nil {
+		glog.Fatalf("failed to load type after %d retries: %v", maxRetries, loadErr)
+	}
+}
+
+// runSchemaMutation applies the schema and type updates. The indexes are built in the background
+// from the data at startTs, while mutations keep being applied and maintain them. The schema and
+// types are only written to disk, and the indexes used by queries, once they're built.
+func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate,
+	typeUpdates []*pb.TypeUpdate, startTs uint64) error {
+	if len(updates) == 0 && len(typeUpdates) == 0 {
 		return nil
 	}
 	// Wait until schema modification for all predicates is complete. There cannot be two
@@ -181,9 +365,13 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		if err := rebuild.BuildIndexes(wrtCtx); err != nil {
 			return err
 		}
+		// The posting lists cached while building may not have the postings that were built.
+		posting.ResetCache()
 		if err := updateSchema(update); err != nil {
 			return err
 		}
+		// Queries can use the index now, e.g. to follow reverse edges.
+		posting.Oracle().Reset()
 
 		glog.Infof("Done schema update %+v\n", update)
 		return nil
@@ -210,6 +398,34 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 			undoSchemaUpdate(update.Predicate)
 		}
 	}
+	buildCompositeIndexes := func(update *pb.TypeUpdate, rebuilds []*posting.IndexRebuild,
+		building [][]string) {
+		defer stopIndexing(closer)
+		wg.Wait()
+
+		err := func() error {
+			wrtCtx := schema.GetWriteContext(context.Background())
+			for _, rebuild := range rebuilds {
+				if err := rebuild.BuildIndexes(wrtCtx); err != nil {
+					return err
+				}
+			}
+			// The index posting lists in the cache don't have the tokens that were built.
+			posting.ResetCache()
+			if err := updateType(update.TypeName, *update); err != nil {
+				return err
+			}
+			posting.Oracle().Reset()
+			return nil
+		}()
+		schema.State().DeleteBuildingIndexes(building)
+		if err != nil {
+			glog.Errorf("error in building composite indexes, aborting :: %v\n", err)
+			undoTypeUpdate(update.TypeName)
+			return
+		}
+		glog.Infof("Done type update %+v\n", update)
+	}
 
 	for _, su := range updates {
 		if tablet, err := groups().Tablet(su.Predicate); err != nil {
@@ -223,11 +439,20 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		}
 
 		old, _ := schema.State().Get(ctx, su.Predicate)
+		if su.Unique && !old.Unique {
+			// The values already stored must be unique before the directive is added.
+			if err := checkUniqueData(ctx, su, startTs); err != nil {
+				return err
+			}
+		}
+		indexes := schema.State().CompositeIndexes(su.Predicate)
 		rebuild := posting.IndexRebuild{
-			Attr:          su.Predicate,
-			StartTs:       startTs,
-			OldSchema:     &old,
-			CurrentSchema: su,
+			Attr:           su.Predicate,
+			StartTs:        startTs,
+			OldSchema:      &old,
+			CurrentSchema:  su,
+			OldIndexes:     indexes,
+			CurrentIndexes: indexes,
 		}
 		querySchema := rebuild.GetQuerySchema()
 		// Sets the schema only in memory. The schema is written to
@@ -255,6 +480,33 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		}
 	}
 
+	for _, update := range typeUpdates {
+		removed, added := compositeIndexChanges(update)
+		rebuilds, err := compositeIndexRebuilds(ctx, removed, added, startTs)
+		if err != nil {
+			return err
+		}
+		var building [][]string
+		for _, rebuild := range rebuilds {
+			if err := rebuild.DropIndexes(ctx); err != nil {
+				return err
+			}
+			building = append(building, rebuild.CurrentIndexes...)
+		}
+		if len(building) == 0 {
+			if err := updateType(update.TypeName, *update); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Sets the type only in memory, so that mutations maintain the composite indexes while
+		// they're built. The type is written to disk once they're built.
+		schema.State().SetBuildingIndexes(building)
+		schema.State().SetType(update.TypeName, *update)
+		go buildCompositeIndexes(update, rebuilds, building)
+	}
+
 	return nil
 }
 
@@ -308,10 +560,96 @@ func createSchema(attr string, typ types.TypeID, hint pb.Metadata_HintType) erro
 	return updateSchema(&s)
 }
 
-func runTypeMutation(ctx context.Context, update *pb.TypeUpdate) error {
-	current := *update
-	schema.State().SetType(update.TypeName, current)
-	return updateType(update.TypeName, *update)
+// compositeIndexChanges returns the composite indexes that are removed and the ones that are
+// added when the given type replaces the current definition of its type.
+func compositeIndexChanges(update *pb.TypeUpdate) (removed, added [][]string) {
+	before := schema.State().AllCompositeIndexes()
+	after := schema.State().CompositeIndexesWithType(update)
+	diff := func(a, b [][]string) [][]string {
+		inB := make(map[string]struct{}, len(b))
+		for _, preds := range b {
+			inB[strings.Join(preds, "\x00")] = struct{}{}
+		}
+		var out [][]string
+		for _, preds := range a {
+			if _, ok := inB[strings.Join(preds, "\x00")]; !ok {
+				out = append(out, preds)
+			}
+		}
+		return out
+	}
+	return diff(before, after), diff(after, before)
+}
+
+// compositeIndexRebuilds returns the rebuilds that drop the removed composite indexes and build
+// the added ones, one for the first predicate of the indexes. Only the indexes whose first
+// predicate is served by this group are stored here.
+func compositeIndexRebuilds(ctx context.Context, removed, added [][]string,
+	startTs uint64) ([]*posting.IndexRebuild, error) {
+	var rebuilds []*posting.IndexRebuild
+	rebuildFor := func(preds []string) (*posting.IndexRebuild, error) {
+		attr := preds[0]
+		for _, rb := range rebuilds {
+			if rb.Attr == attr {
+				return rb, nil
+			}
+		}
+		if gid, err := groups().BelongsToReadOnly(attr, 0); err != nil {
+			return nil, err
+		} else if gid != groups().groupId() {
+			return nil, nil
+		}
+		su, _ := schema.State().Get(ctx, attr)
+		rb := &posting.IndexRebuild{
+			Attr:          attr,
+			StartTs:       startTs,
+			OldSchema:     &su,
+			CurrentSchema: &su,
+		}
+		rebuilds = append(rebuilds, rb)
+		return rb, nil
+	}
+	for _, preds := range removed {
+		rb, err := rebuildFor(preds)
+		if err != nil {
+			return nil, err
+		} else if rb != nil {
+			rb.OldIndexes = append(rb.OldIndexes, preds)
+		}
+	}
+	for _, preds := range added {
+		rb, err := rebuildFor(preds)
+		if err != nil {
+			return nil, err
+		} else if rb != nil {
+			rb.CurrentIndexes = append(rb.CurrentIndexes, preds)
+		}
+	}
+	return rebuilds, nil
+}
+
+// dropCompositeIndexes drops the given composite indexes that are stored by this group.
+func dropCompositeIndexes(ctx context.Context, removed [][]string) error {
+	rebuilds, err := compositeIndexRebuilds(ctx, removed, nil, 0)
+	if err != nil || len(rebuilds) == 0 {
+		return err
+	}
+
+	// Ensure that rollup and the index builds of the schema mutations aren't running.
+	gr.Node.waitForTask(opIndexing)
+	closer, err := gr.Node.startTask(opIndexing)
+	if err != nil {
+		return err
+	}
+	defer closer.Done()
+
+	for _, rb := range rebuilds {
+		if err := rb.DropIndexes(ctx); err != nil {
+			return err
+		}
+	}
+	posting.ResetCache()
+	return nil
 }
 
 // We commit schema to disk in blocking way, should be ok because this happens
@@ -395,6 +733,21 @@ func checkSchema(s *pb.SchemaUpdate) error {
 			s.Predicate)
 	}
 
+	if s.Unique {
+		if x.WorkerConfig.LudicrousMode {
+			return errors.Errorf("@unique isn't supported in ludicrous mode on predicate %s",
+				s.Predicate)
+		}
+		if s.Lang {
+			return errors.Errorf("@unique isn't supported together with @lang on predicate %s",
+				s.Predicate)
+		}
+		if uniqueTokenizer(s.Tokenizer) == nil {
+			return errors.Errorf("A tokenizer that isn't lossy is mandatory for: [%s] when"+
+				" specifying @unique directive", s.Predicate)
+		}
+	}
+
 	t, err := schema.State().TypeOf(s.Predicate)
 	if err != nil {
 		// No schema previously defined, so no need to do checks about schema conversions.
@@ -563,6 +916,10 @@ func proposeOrSend(ctx context.Context, gid uint32, m *pb.Mutations, chr chan re
 		res.err = ctx.Err()
 		res.ctx = nil
 	case err := <-ch:
+		if status.Code(err) == codes.AlreadyExists {
+			// The leader of the group couldn't keep the values of a @unique predicate unique.
+			err = &uniqueViolationError{msg: status.Convert(err).Message()}
+		}
 		res.err = err
 		res.ctx = tc
 	}
@@ -712,9 +1069,9 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 	if err != nil {
 		return errors.Wrapf(err, "cannot retrieve predicate information")
 	}
-	schemaSet := make(map[string]struct{})
+	schemaSet := make(map[string]*pb.SchemaNode)
 	for _, schemaNode := range schemas {
-		schemaSet[schemaNode.Predicate] = struct{}{}
+		schemaSet[schemaNode.Predicate] = schemaNode
 	}
 
 	for _, t := range m.Types {
@@ -734,8 +1091,88 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 					field.Predicate, t.TypeName)
 			}
 		}
+
+		if err := verifyCompositeIndexes(t, m.Schema, schemaSet); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyCompositeIndexes checks that the composite indexes of the type are over scalar predicates
+// that have a single value, and that they're served by the same group as the values of all the
+// predicates of an index are read at once.
+func verifyCompositeIndexes(t *pb.TypeUpdate, reqSchema []*pb.SchemaUpdate,
+	schemaSet map[string]*pb.SchemaNode) error {
+	if len(t.Indexes) > 0 && x.WorkerConfig.LudicrousMode {
+		return errors.Errorf("Composite index of type %s can't be used in ludicrous mode",
+			t.TypeName)
+	}
+	for _, index := range t.Indexes {
+		for _, pred := range index.Predicates {
+			var typ string
+			var list bool
+			if node, ok := schemaSet[pred]; ok {
+				typ, list = node.Type, node.List
+			}
+			for _, su := range reqSchema {
+				if su.Predicate == pred {
+					typ, list = types.TypeID(su.ValueType).Name(), su.List
+				}
+			}
+			switch {
+			case list:
+				return errors.Errorf("Composite index of type %s can't be over the list "+
+					"predicate %s", t.TypeName, pred)
+			case typ != "string" && typ != "int" && typ != "float" && typ != "bool" &&
+				typ != "datetime":
+				return errors.Errorf("Composite index of type %s can't be over the predicate "+
+					"%s of type %s", t.TypeName, pred, typ)
+			}
+		}
+		if err := serveCompositeIndex(t.TypeName, index.Predicates); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
+// serveCompositeIndex makes sure that all the predicates of a composite index are served by the
+// same group before the index is created. The predicates that aren't served yet, e.g. the ones
+// added by the same schema update, are assigned to the group of the others, or to this group if
+// none of them is served.
+func serveCompositeIndex(typeName string, preds []string) error {
+	errSplit := errors.Errorf("Composite index of type %s is over predicates %v "+
+		"served by different groups", typeName, preds)
+	var gid uint32
+	var unserved []string
+	for _, pred := range preds {
+		predGid, err := groups().BelongsToReadOnly(pred, 0)
+		switch {
+		case err != nil:
+			return err
+		case predGid == 0:
+			unserved = append(unserved, pred)
+		case gid != 0 && predGid != gid:
+			return errSplit
+		default:
+			gid = predGid
+		}
+	}
+	if gid == 0 {
+		gid = groups().groupId()
+	}
+	for _, pred := range unserved {
+		tablet, err := groups().sendTablet(&pb.Tablet{GroupId: gid, Predicate: pred})
+		if err != nil {
+			return err
+		}
+		if tablet.GetGroupId() != gid {
+			// Another request got the tablet served by some other group in the meantime.
+			return errSplit
+		}
+	}
 	return nil
 }
 
@@ -829,7 +1266,12 @@ func (w *grpcWorker) Mutate(ctx context.Context, m *pb.Mutations) (*api.TxnConte
 		return txnCtx, errors.Errorf("This server doesn't serve group id: %v", m.GroupId)
 	}
 
-	return txnCtx, w.proposeAndWait(ctx, txnCtx, m)
+	err := w.proposeAndWait(ctx, txnCtx, m)
+	if errors.Is(err, ErrUniqueViolation) {
+		// Keep the error identifiable by the Alpha that sent the mutation, see proposeOrSend.
+		err = status.Error(codes.AlreadyExists, err.Error())
+	}
+	return txnCtx, err
 }
 
 func tryAbortTransactions(startTimestamps []uint64) {
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/posting"
@@ -134,6 +135,48 @@ func TestPopulateMutationMap(t *testing.T) {
 	require.NotNil(t, mu.Schema)
 }
 
+func TestCompositeIndexGroups(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("tenant: string .\nstatus: string ."), 1))
+	schema.State().SetType("Ticket", pb.TypeUpdate{
+		TypeName: "Ticket",
+		Indexes:  []*pb.TypeUpdate_CompositeIndex{{Predicates: []string{"tenant", "status"}}},
+	})
+	gr.Lock()
+	gr.tablets["tenant"] = &pb.Tablet{Predicate: "tenant", GroupId: 2}
+	gr.Unlock()
+	defer func() {
+		gr.Lock()
+		delete(gr.tablets, "tenant")
+		delete(gr.tablets, "status")
+		gr.Unlock()
+		schema.State().DeleteAll()
+	}()
+
+	// A predicate of the index that's assigned later goes to the group of the others.
+	require.Equal(t, uint32(2), gr.tabletGroup("status"))
+	require.Equal(t, uint32(1), gr.tabletGroup("name"))
+
+	gr.Lock()
+	gr.tablets["status"] = &pb.Tablet{Predicate: "status", GroupId: 2}
+	gr.Unlock()
+	require.NoError(t, serveCompositeIndex("Ticket", []string{"tenant", "status"}))
+
+	// Moving a predicate of the index away from the others is refused.
+	err := checkCompositeMove("tenant", 3)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "would be split across groups")
+	require.NoError(t, checkCompositeMove("name", 3))
+
+	// Once the indexed predicates are split, e.g. by an earlier move, the index can't be created
+	// and only a move that joins them again is allowed.
+	gr.Lock()
+	gr.tablets["status"] = &pb.Tablet{Predicate: "status", GroupId: 3}
+	gr.Unlock()
+	require.Error(t, serveCompositeIndex("Ticket", []string{"tenant", "status"}))
+	require.NoError(t, checkCompositeMove("tenant", 3))
+	require.Error(t, checkCompositeMove("tenant", 1))
+}
+
 func TestCheckSchema(t *testing.T) {
 	require.NoError(t, posting.DeleteAll())
 	initTest(t, "name:string @index(term) .")
@@ -213,6 +256,28 @@ func TestCheckSchema(t *testing.T) {
 	require.NoError(t, err)
 	err = checkSchema(result.Preds[1])
 	require.NoError(t, err)
+
+	s1 = &pb.SchemaUpdate{Predicate: "email", ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"term"}, Unique: true}
+	err = checkSchema(s1)
+	require.Error(t, err)
+	require.Equal(t, "A tokenizer that isn't lossy is mandatory for: [email] when specifying"+
+		" @unique directive", err.Error())
+
+	s1 = &pb.SchemaUpdate{Predicate: "email", ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX, Tokenizer: []string{"term", "hash"}, Unique: true}
+	require.NoError(t, checkSchema(s1))
+}
+
+func TestUniqueViolationError(t *testing.T) {
+	err := uniqueViolationf("on predicate [%s]: value [%v] is already held by node [%#x]",
+		"email", "a@b.c", 2)
+	require.True(t, errors.Is(err, ErrUniqueViolation))
+	require.True(t, errors.Is(errors.Wrapf(err, "while applying mutations"), ErrUniqueViolation))
+	require.Equal(t, "Could not enforce @unique on predicate [email]: value [a@b.c] is already"+
+		" held by node [0x2]", err.Error())
+
+	require.False(t, errors.Is(errors.New(err.Error()), ErrUniqueViolation))
 }
 
 func TestTypeSanityCheck(t *testing.T) {
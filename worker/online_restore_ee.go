@@ -242,6 +242,8 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest) error {
 	if err := schema.LoadFromDb(); err != nil {
 		return errors.Wrapf(err, "cannot load schema after restore")
 	}
+	// The restored data is written without a commit.
+	posting.Oracle().Reset()
 
 	// Propose a snapshot immediately after all the work is done to prevent the restore
 	// from being replayed.
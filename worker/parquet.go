@@ -0,0 +1,164 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetRowGroupSize bounds the size of the rows buffered in memory before a row group is
+// written out.
+const parquetRowGroupSize = 64 << 20
+
+// Types of the Parquet columns, as given to parquet-go. A converted type, like UINT_64, implies
+// the physical type it's stored as.
+const (
+	parquetBoolean         = "BOOLEAN"
+	parquetInt64           = "INT64"
+	parquetDouble          = "DOUBLE"
+	parquetUint64          = "UINT_64"
+	parquetTimestampMicros = "TIMESTAMP_MICROS"
+	parquetUTF8            = "UTF8"
+)
+
+// parquetColumn describes a column of a Parquet file. parquet-go declares all the columns of flat
+// tables as optional, rows are checked to have values for the other ones by appendRow.
+type parquetColumn struct {
+	name     string
+	typ      string
+	optional bool
+}
+
+// value converts val to the Go type parquet-go expects for the column.
+func (c *parquetColumn) value(val interface{}) (interface{}, error) {
+	switch v := val.(type) {
+	case nil:
+		if c.optional {
+			return nil, nil
+		}
+		return nil, errors.Errorf("Column %s is required", c.name)
+	case bool:
+		if c.typ == parquetBoolean {
+			return v, nil
+		}
+	case int64:
+		if c.typ == parquetInt64 || c.typ == parquetTimestampMicros {
+			return v, nil
+		}
+	case uint64:
+		if c.typ == parquetUint64 {
+			return int64(v), nil
+		}
+	case float64:
+		if c.typ == parquetDouble {
+			return v, nil
+		}
+	case string:
+		if c.typ == parquetUTF8 {
+			return v, nil
+		}
+	}
+	return nil, errors.Errorf("Unexpected value of type %T for column %s of type %s",
+		val, c.name, c.typ)
+}
+
+// parquetWriter writes rows to a Parquet file, using parquet-go.
+type parquetWriter struct {
+	columns []*parquetColumn
+	cw      *writer.CSVWriter
+}
+
+func newParquetWriter(w io.Writer, columns []*parquetColumn,
+	metadata map[string]string) (*parquetWriter, error) {
+	md := make([]string, 0, len(columns))
+	for _, c := range columns {
+		md = append(md, fmt.Sprintf("name=%s, type=%s", c.name, c.typ))
+	}
+	cw, err := writer.NewCSVWriter(md, parquetSink{w}, 1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating the Parquet writer")
+	}
+	cw.RowGroupSize = parquetRowGroupSize
+
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		val := metadata[k]
+		cw.Footer.KeyValueMetadata = append(cw.Footer.KeyValueMetadata,
+			&parquet.KeyValue{Key: k, Value: &val})
+	}
+	return &parquetWriter{columns: columns, cw: cw}, nil
+}
+
+// appendRow adds a row to the file. It must hold one value per column, nil for a null value.
+func (pw *parquetWriter) appendRow(row ...interface{}) error {
+	if len(row) != len(pw.columns) {
+		return errors.Errorf("Expected %d values in the row. Got: %d", len(pw.columns), len(row))
+	}
+	rec := make([]interface{}, len(row))
+	for i, val := range row {
+		var err error
+		if rec[i], err = pw.columns[i].value(val); err != nil {
+			return err
+		}
+	}
+	return pw.cw.Write(rec)
+}
+
+// close writes the remaining rows and the file metadata. It doesn't close the underlying writer.
+func (pw *parquetWriter) close() error {
+	return pw.cw.WriteStop()
+}
+
+// parquetSink lets parquet-go write a file to an io.Writer. Writing a file only needs Write, the
+// other methods are used to read files or to write several files.
+type parquetSink struct {
+	io.Writer
+}
+
+var _ source.ParquetFile = parquetSink{}
+
+func (parquetSink) Read([]byte) (int, error) {
+	return 0, errors.New("Reading a Parquet sink isn't supported")
+}
+
+func (parquetSink) Seek(int64, int) (int64, error) {
+	return 0, errors.New("Seeking a Parquet sink isn't supported")
+}
+
+func (parquetSink) Open(string) (source.ParquetFile, error) {
+	return nil, errors.New("Opening a Parquet sink isn't supported")
+}
+
+func (parquetSink) Create(string) (source.ParquetFile, error) {
+	return nil, errors.New("Creating a Parquet sink isn't supported")
+}
+
+// Close is a no-op, the underlying writer is closed by its owner.
+func (parquetSink) Close() error {
+	return nil
+}
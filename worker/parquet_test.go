@@ -0,0 +1,164 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// readParquet reads the Parquet file at path with an independent implementation of the format.
+// It returns the metadata of the file and the values of every column, nil for a null value.
+func readParquet(t *testing.T, path string) (*parquet.FileMetaData, [][]interface{}) {
+	fr, err := local.NewLocalFileReader(path)
+	require.NoError(t, err)
+	defer fr.Close()
+	pr, err := reader.NewParquetColumnReader(fr, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	numRows := pr.GetNumRows()
+	var columns [][]interface{}
+	for i := range pr.Footer.Schema[1:] {
+		values, _, _, err := pr.ReadColumnByIndex(int64(i), numRows)
+		require.NoError(t, err)
+		require.Len(t, values, int(numRows))
+		columns = append(columns, values)
+	}
+	return pr.Footer, columns
+}
+
+// parquetMetadata returns the key-value metadata of the file.
+func parquetMetadata(meta *parquet.FileMetaData) map[string]string {
+	kvs := make(map[string]string)
+	for _, kv := range meta.GetKeyValueMetadata() {
+		kvs[kv.GetKey()] = kv.GetValue()
+	}
+	return kvs
+}
+
+// writeParquet writes the rows to a Parquet file in dir, in row groups of up to rowGroupSize
+// bytes, and returns its path.
+func writeParquet(t *testing.T, dir string, rowGroupSize int64, columns []*parquetColumn,
+	metadata map[string]string, rows ...[]interface{}) string {
+	path := filepath.Join(dir, "test.parquet")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	pw, err := newParquetWriter(f, columns, metadata)
+	require.NoError(t, err)
+	pw.cw.RowGroupSize = rowGroupSize
+	for _, row := range rows {
+		require.NoError(t, pw.appendRow(row...))
+	}
+	require.NoError(t, pw.close())
+	return path
+}
+
+func TestParquetWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	columns := []*parquetColumn{
+		{name: "uid", typ: parquetUint64},
+		{name: "value", typ: parquetDouble},
+		{name: "ok", typ: parquetBoolean},
+		{name: "since", typ: parquetTimestampMicros},
+		{name: "facets", typ: parquetUTF8, optional: true},
+	}
+	metadata := map[string]string{"dgraph.predicate": "score"}
+	path := writeParquet(t, dir, parquetRowGroupSize, columns, metadata,
+		[]interface{}{uint64(1), 1.5, true, int64(10), nil},
+		[]interface{}{uint64(2), 2.5, false, int64(20), `{"since":2019}`},
+		[]interface{}{uint64(3), 3.5, true, int64(30), nil},
+	)
+
+	meta, values := readParquet(t, path)
+	require.Equal(t, int64(3), meta.GetNumRows())
+	require.Equal(t, "score", parquetMetadata(meta)["dgraph.predicate"])
+	schema := meta.GetSchema()[1:]
+	require.Len(t, schema, len(columns))
+	for i, typ := range []parquet.Type{parquet.Type_INT64, parquet.Type_DOUBLE,
+		parquet.Type_BOOLEAN, parquet.Type_INT64, parquet.Type_BYTE_ARRAY} {
+		require.Equal(t, columns[i].name, schema[i].GetName())
+		require.Equal(t, typ, schema[i].GetType())
+	}
+	require.Equal(t, parquet.ConvertedType_UINT_64, schema[0].GetConvertedType())
+	require.Equal(t, parquet.ConvertedType_TIMESTAMP_MICROS, schema[3].GetConvertedType())
+	require.Equal(t, parquet.ConvertedType_UTF8, schema[4].GetConvertedType())
+
+	require.Equal(t, [][]interface{}{
+		{int64(1), int64(2), int64(3)},
+		{1.5, 2.5, 3.5},
+		{true, false, true},
+		{int64(10), int64(20), int64(30)},
+		{nil, `{"since":2019}`, nil},
+	}, values)
+}
+
+func TestParquetWriterErrors(t *testing.T) {
+	columns := []*parquetColumn{
+		{name: "uid", typ: parquetUint64},
+		{name: "value", typ: parquetDouble},
+	}
+	pw, err := newParquetWriter(ioutil.Discard, columns, nil)
+	require.NoError(t, err)
+	require.Error(t, pw.appendRow(uint64(1)))
+	require.Error(t, pw.appendRow(nil, 1.5))
+	require.Error(t, pw.appendRow(uint64(1), "1.5"))
+	require.Error(t, pw.appendRow(int64(1), 1.5))
+	require.NoError(t, pw.close())
+}
+
+func TestParquetWriterRowGroups(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	columns := []*parquetColumn{
+		{name: "uid", typ: parquetUint64},
+		{name: "lang", typ: parquetUTF8, optional: true},
+	}
+	numRows := 100000
+	var rows [][]interface{}
+	var uids, langs []interface{}
+	for i := 0; i < numRows; i++ {
+		var lang interface{}
+		if i%3 == 0 {
+			lang = fmt.Sprintf("l%d", i)
+		}
+		rows = append(rows, []interface{}{uint64(i + 1), lang})
+		uids = append(uids, int64(i+1))
+		langs = append(langs, lang)
+	}
+	path := writeParquet(t, dir, 1<<20, columns, nil, rows...)
+
+	meta, values := readParquet(t, path)
+	require.Equal(t, int64(numRows), meta.GetNumRows())
+	require.True(t, len(meta.GetRowGroups()) > 1)
+	require.Equal(t, [][]interface{}{uids, langs}, values)
+}
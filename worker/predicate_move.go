@@ -54,6 +54,8 @@ func populateKeyValues(ctx context.Context, kvs []*bpb.KV) error {
 	if err := writer.Flush(); err != nil {
 		return err
 	}
+	// The keys of a moved predicate are written without a commit.
+	posting.Oracle().Reset()
 	pk, err := x.Parse(kvs[0].Key)
 	if err != nil {
 		return err
@@ -173,6 +175,28 @@ func (w *grpcWorker) ReceivePredicate(stream pb.Worker_ReceivePredicateServer) e
 	return err
 }
 
+// checkCompositeMove refuses to move the predicate to the group destGid if that splits one of its
+// composite indexes across groups. The index is updated with the values of all its predicates,
+// which are read from the group serving the index, so they must all stay in that group.
+func checkCompositeMove(predicate string, destGid uint32) error {
+	for _, preds := range schema.State().CompositeIndexes(predicate) {
+		for _, pred := range preds {
+			if pred == predicate {
+				continue
+			}
+			gid, err := groups().BelongsToReadOnly(pred, 0)
+			if err != nil {
+				return err
+			}
+			if gid != 0 && gid != destGid {
+				return errors.Errorf("Predicate %s can't be moved to group %d, as its composite "+
+					"index over %v would be split across groups", predicate, destGid, preds)
+			}
+		}
+	}
+	return nil
+}
+
 func (w *grpcWorker) MovePredicate(ctx context.Context,
 	in *pb.MovePredicatePayload) (*api.Payload, error) {
 	ctx, span := otrace.StartSpan(ctx, "worker.MovePredicate")
@@ -214,6 +238,9 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	case gid != groups().groupId():
 		return &emptyPayload, errUnservedTablet
 	}
+	if err := checkCompositeMove(in.Predicate, in.DestGid); err != nil {
+		return &emptyPayload, err
+	}
 
 	msg := fmt.Sprintf("Move predicate request: %+v", in)
 	glog.Info(msg)
@@ -57,7 +57,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
-			"lang", "noconflict"}
+			"lang", "noconflict", "unique"}
 	}
 
 	myGid := groups().groupId()
@@ -112,6 +112,8 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.Lang = schema.State().HasLang(attr)
 		case "noconflict":
 			schemaNode.NoConflict = schema.State().HasNoConflict(attr)
+		case "unique":
+			schemaNode.Unique = schema.State().IsUnique(attr)
 		default:
 			//pass
 		}
@@ -124,7 +124,7 @@ func resultWithError(err error) *sortresult {
 	return &sortresult{&emptySortResult, nil, nil, err}
 }
 
-func sortWithoutIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
+func sortWithoutIndex(ctx context.Context, ts *pb.SortMessage, cur *sortCursor) *sortresult {
 	span := otrace.FromContext(ctx)
 	span.Annotate(nil, "sortWithoutIndex")
 
@@ -151,8 +151,16 @@ func sortWithoutIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 			if vals, err = sortByValue(ctx, ts, tempList, sType); err != nil {
 				return resultWithError(err)
 			}
-			start, end, err := paginate(ts, tempList, vals)
-			if err != nil {
+			if cur != nil {
+				skip := cur.skip(tempList.Uids, vals)
+				tempList.Uids, vals = tempList.Uids[skip:], vals[skip:]
+			}
+			var start, end int
+			if cur != nil && len(ts.Order) > 1 {
+				// Some of the uids with the same first value as the cursor might still come
+				// before it, so all of them are kept and the offset is applied by multiSort.
+				start, end = 0, len(tempList.Uids)
+			} else if start, end, err = paginate(ts, tempList, vals); err != nil {
 				return resultWithError(err)
 			}
 			if len(ts.Order) > 1 {
@@ -174,7 +182,7 @@ func sortWithoutIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 	return &sortresult{r, multiSortOffsets, multiSortVals, nil}
 }
 
-func sortWithIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
+func sortWithIndex(ctx context.Context, ts *pb.SortMessage, cur *sortCursor) *sortresult {
 	span := otrace.FromContext(ctx)
 	span.Annotate(nil, "sortWithIndex")
 
@@ -185,6 +193,12 @@ func sortWithIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 		// offsets[i] is the offset for i-th posting list. It gets decremented as we
 		// iterate over buckets.
 		out[i].offset = int(ts.Offset)
+		if cur != nil && len(ts.Order) > 1 {
+			// The offset counts from the cursor, which is only found after all sorts are
+			// applied. So it's applied at the end like the rest of a multi sort offset.
+			out[i].offset = 0
+			out[i].multiSortOffset = ts.Offset
+		}
 		var emptyList pb.List
 		out[i].ulist = &emptyList
 		out[i].uset = map[uint64]struct{}{}
@@ -201,16 +215,7 @@ func sortWithIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 		return resultWithError(errors.Errorf("Attribute %s is not indexed.", order.Attr))
 	}
 
-	tokenizers := schema.State().Tokenizer(ctx, order.Attr)
-	var tokenizer tok.Tokenizer
-	for _, t := range tokenizers {
-		// Get the first sortable index.
-		if t.IsSortable() {
-			tokenizer = t
-			break
-		}
-	}
-
+	tokenizer := sortTokenizer(ctx, order.Attr)
 	if tokenizer == nil {
 		// String type can have multiple tokenizers, only one of which is
 		// sortable.
@@ -254,6 +259,31 @@ func sortWithIndex(ctx context.Context, ts *pb.SortMessage) *sortresult {
 		prefix[len(prefix)-1]++
 		seekKey = x.IndexKey(order.Attr, string(prefix))
 	}
+
+	var cursorToken string
+	switch {
+	case cur == nil:
+	case cur.vals[0].Value != nil:
+		// The buckets before the one with the cursor's value only have uids that come before
+		// the cursor, so we start from that bucket.
+		tokens, err := tok.BuildTokens(cur.vals[0].Value, tokenizer)
+		if err != nil {
+			return resultWithError(err)
+		}
+		if len(tokens) != 1 {
+			return resultWithError(errors.Errorf(
+				"Expected a single token for the cursor of attribute %s, got %d",
+				order.Attr, len(tokens)))
+		}
+		cursorToken = tokens[0]
+		seekKey = x.IndexKey(order.Attr, cursorToken)
+	case !order.Desc:
+		// Uids without a value come last in ascending order, but they aren't in the index.
+		// So nothing in the index comes after the cursor and we seek past its end.
+		prefix[len(prefix)-1]++
+		seekKey = x.IndexKey(order.Attr, string(prefix))
+	}
+
 	itr := txn.NewIterator(iterOpt)
 	defer itr.Close()
 
@@ -275,9 +305,13 @@ BUCKETS:
 
 			x.AssertTrue(k.IsIndex())
 			token := k.Term
+			bucketCur := cur
+			if token != cursorToken {
+				bucketCur = nil
+			}
 			// Intersect every UID list with the index bucket, and update their
 			// results (in out).
-			err = intersectBucket(ctx, ts, token, out)
+			err = intersectBucket(ctx, ts, token, bucketCur, out)
 			switch err {
 			case errDone:
 				break BUCKETS
@@ -307,13 +341,24 @@ BUCKETS:
 	}
 }
 
+// sortTokenizer returns the first sortable tokenizer of attr, which its index is sorted by, or
+// nil if it doesn't have one.
+func sortTokenizer(ctx context.Context, attr string) tok.Tokenizer {
+	for _, t := range schema.State().Tokenizer(ctx, attr) {
+		if t.IsSortable() {
+			return t
+		}
+	}
+	return nil
+}
+
 type orderResult struct {
 	idx int
 	r   *pb.Result
 	err error
 }
 
-func multiSort(ctx context.Context, r *sortresult, ts *pb.SortMessage) error {
+func multiSort(ctx context.Context, r *sortresult, ts *pb.SortMessage, cur *sortCursor) error {
 	span := otrace.FromContext(ctx)
 	span.Annotate(nil, "multiSort")
 
@@ -400,13 +445,19 @@ func multiSort(ctx context.Context, r *sortresult, ts *pb.SortMessage) error {
 
 	// Values have been accumulated, now we do the multisort for each list.
 	for i, ul := range r.reply.UidMatrix {
-		vals := make([][]types.Val, len(ul.Uids))
-		for j, uid := range ul.Uids {
+		vals := make([][]types.Val, 0, len(ul.Uids))
+		uids := make([]uint64, 0, len(ul.Uids))
+		for _, uid := range ul.Uids {
 			idx := algo.IndexOf(dest, uid)
 			x.AssertTrue(idx >= 0)
-			vals[j] = sortVals[idx]
+			if cur != nil && !cur.after(uid, sortVals[idx]) {
+				continue
+			}
+			uids = append(uids, uid)
+			vals = append(vals, sortVals[idx])
 		}
-		if err := types.Sort(vals, &ul.Uids, desc, ""); err != nil {
+		ul.Uids = uids
+		if err := sortUids(ts, vals, &ul.Uids, desc, ""); err != nil {
 			return err
 		}
 		// Paginate
@@ -451,6 +502,19 @@ func processSort(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error
 			ts.Order[0].Attr)
 	}
 
+	var cur *sortCursor
+	if ts.After != "" {
+		scalar, err := schema.State().TypeOf(ts.Order[0].Attr)
+		if err != nil {
+			return nil, errors.Errorf("Attribute %s not defined in schema", ts.Order[0].Attr)
+		}
+		if cur, err = newSortCursor(ts, scalar); err != nil {
+			return nil, err
+		}
+		// The values of the first order are compared folded, see sortByValue.
+		cur.vals[0] = foldValue(sortTokenizer(ctx, ts.Order[0].Attr), cur.vals[0])
+	}
+
 	// We're not using any txn local cache here. So, no need to deal with that yet.
 	cctx, cancel := context.WithCancel(ctx)
 	resCh := make(chan *sortresult, 2)
@@ -462,12 +526,12 @@ func processSort(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error
 			resCh <- &sortresult{err: ctx.Err()}
 			return
 		}
-		r := sortWithoutIndex(cctx, ts)
+		r := sortWithoutIndex(cctx, ts, cur)
 		resCh <- r
 	}()
 
 	go func() {
-		sr := sortWithIndex(cctx, ts)
+		sr := sortWithIndex(cctx, ts, cur)
 		resCh <- sr
 	}()
 
@@ -489,7 +553,7 @@ func processSort(ctx context.Context, ts *pb.SortMessage) (*pb.SortResult, error
 		return r.reply, nil
 	}
 
-	err := multiSort(ctx, r, ts)
+	err := multiSort(ctx, r, ts, cur)
 	return r.reply, err
 }
 
@@ -529,11 +593,14 @@ type intersectedList struct {
 	values          []types.Val
 	uset            map[uint64]struct{}
 	multiSortOffset int32
+	// cursorTies is the number of uids in ulist with the same first value as the cursor. In
+	// case of multi sort, some of them might still be dropped for coming before the cursor.
+	cursorTies int
 }
 
 // intersectBucket intersects every UID list in the UID matrix with the
-// indexed bucket.
-func intersectBucket(ctx context.Context, ts *pb.SortMessage, token string,
+// indexed bucket. cur is the cursor of the sort if its value is in this bucket.
+func intersectBucket(ctx context.Context, ts *pb.SortMessage, token string, cur *sortCursor,
 	out []intersectedList) error {
 	count := int(ts.Count)
 	order := ts.Order[0]
@@ -557,7 +624,7 @@ func intersectBucket(ctx context.Context, ts *pb.SortMessage, token string,
 		// We need to reduce multiSortOffset while checking the count as we might have included
 		// some extra uids from the bucket that the offset falls into. We are going to discard
 		// the first multiSortOffset number of uids later after all sorts are applied.
-		if count > 0 && len(il.ulist.Uids)-int(il.multiSortOffset) >= count {
+		if count > 0 && len(il.ulist.Uids)-int(il.multiSortOffset)-il.cursorTies >= count {
 			continue
 		}
 
@@ -575,6 +642,19 @@ func intersectBucket(ctx context.Context, ts *pb.SortMessage, token string,
 		// variants of a predicate.
 		result.Uids = removeDuplicates(result.Uids, il.uset)
 
+		if cur != nil {
+			// The bucket also has the uids that come before the cursor, which have to be
+			// dropped before applying the offset.
+			if vals, err = sortByValue(ctx, ts, result, scalar); err != nil {
+				return err
+			}
+			skip := cur.skip(result.Uids, vals)
+			result.Uids, vals = result.Uids[skip:], vals[skip:]
+			if len(ts.Order) > 1 {
+				il.cursorTies = cur.ties(vals)
+			}
+		}
+
 		// Check offsets[i].
 		n := len(result.Uids)
 		if il.offset >= n {
@@ -587,8 +667,10 @@ func intersectBucket(ctx context.Context, ts *pb.SortMessage, token string,
 		// We are within the page. We need to apply sorting.
 		// Sort results by value before applying offset.
 		// TODO (pawan) - Why do we do this? Looks like it it is only useful for language.
-		if vals, err = sortByValue(ctx, ts, result, scalar); err != nil {
-			return err
+		if cur == nil {
+			if vals, err = sortByValue(ctx, ts, result, scalar); err != nil {
+				return err
+			}
 		}
 
 		// Result set might have reduced after sorting. As some uids might not have a
@@ -631,7 +713,7 @@ func intersectBucket(ctx context.Context, ts *pb.SortMessage, token string,
 	for i := 0; i < len(ts.UidMatrix); i++ { // Iterate over UID lists.
 		// We need to reduce multiSortOffset while checking the count as we might have included
 		// some extra uids earlier for the multi-sort case.
-		if len(out[i].ulist.Uids)-int(out[i].multiSortOffset) < count {
+		if len(out[i].ulist.Uids)-int(out[i].multiSortOffset)-out[i].cursorTies < count {
 			return errContinue
 		}
 
@@ -710,6 +792,9 @@ func sortByValue(ctx context.Context, ts *pb.SortMessage, ul *pb.List,
 		return nil, errors.Errorf("Sorting on multiple language is not supported.")
 	}
 
+	// The values are folded like the index folds them, if it does, so that they are sorted
+	// the same way with and without the index.
+	tokenizer := sortTokenizer(ctx, order.Attr)
 	for i := 0; i < lenList; i++ {
 		select {
 		case <-ctx.Done():
@@ -724,12 +809,12 @@ func sortByValue(ctx context.Context, ts *pb.SortMessage, ul *pb.List,
 				// end (start) for orderasc (orderdesc).
 				val.Value = nil
 			}
-			values = append(values, []types.Val{val})
+			values = append(values, []types.Val{foldValue(tokenizer, val)})
 		}
 	}
-	err := types.Sort(values, &uids, []bool{order.Desc}, lang)
+	err := sortUids(ts, values, &uids, []bool{order.Desc}, lang)
 	ul.Uids = uids
-	if len(ts.Order) > 1 {
+	if len(ts.Order) > 1 || ts.After != "" {
 		for _, v := range values {
 			multiSortVals = append(multiSortVals, v[0])
 		}
@@ -737,6 +822,16 @@ func sortByValue(ctx context.Context, ts *pb.SortMessage, ul *pb.List,
 	return multiSortVals, err
 }
 
+// sortUids sorts the uids by their values like types.Sort. Uids with equal values are
+// ordered by uid if the sort has to be stable, which is always the case with a cursor.
+func sortUids(ts *pb.SortMessage, v [][]types.Val, ul *[]uint64, desc []bool,
+	lang string) error {
+	if ts.Stable || ts.After != "" {
+		return types.SortWithUid(v, ul, desc, lang)
+	}
+	return types.Sort(v, ul, desc, lang)
+}
+
 // fetchValue gets the value for a given UID.
 func fetchValue(uid uint64, attr string, langs []string, scalar types.TypeID,
 	readTs uint64) (types.Val, error) {
@@ -20,6 +20,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
 )
 
 func TestRemoveDuplicates(t *testing.T) {
@@ -54,3 +58,68 @@ func TestRemoveDuplicates(t *testing.T) {
 		require.Equal(t, set, toSet(test.setOut))
 	}
 }
+
+func TestCursor(t *testing.T) {
+	vals := []*pb.TaskValue{
+		{Val: []byte("Alice"), ValType: pb.Posting_STRING},
+		nil,
+		{Val: []byte{}, ValType: pb.Posting_DEFAULT},
+	}
+	cursor := EncodeCursor(0x2a, vals)
+	require.Equal(t, byte('A'), cursor[0])
+
+	uid, out, err := DecodeCursor(cursor)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x2a), uid)
+	require.Len(t, out, 3)
+	require.Equal(t, vals[0].Val, out[0].Val)
+	require.Equal(t, pb.Posting_STRING, out[0].ValType)
+	require.Nil(t, out[1])
+	require.Empty(t, out[2].Val)
+
+	uid, out, err = DecodeCursor(EncodeCursor(7, nil))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), uid)
+	require.Empty(t, out)
+
+	for _, cursor := range []string{"", "0x2a", "abc", cursor[:len(cursor)-2]} {
+		_, _, err := DecodeCursor(cursor)
+		require.Error(t, err, cursor)
+	}
+}
+
+func TestSortCursor(t *testing.T) {
+	intVal := func(v int64) types.Val { return types.Val{Tid: types.IntID, Value: v} }
+	uids := []uint64{5, 9, 3, 4, 8, 2}
+	vals := []types.Val{intVal(1), intVal(1), intVal(2), intVal(2), intVal(2), intVal(3)}
+
+	c := &sortCursor{uid: 4, vals: []types.Val{intVal(2)}, desc: []bool{false}}
+	require.Equal(t, 4, c.skip(uids, vals))
+	require.True(t, c.after(8, []types.Val{intVal(2)}))
+	require.False(t, c.after(3, []types.Val{intVal(2)}))
+
+	// With several orders all the uids with the same first value are kept.
+	c = &sortCursor{uid: 4, vals: []types.Val{intVal(2), {}}, desc: []bool{false, false}}
+	require.Equal(t, 2, c.skip(uids, vals))
+	require.Equal(t, 3, c.ties(vals[2:]))
+	require.True(t, c.after(8, []types.Val{intVal(2), {}}))
+	require.False(t, c.after(3, []types.Val{intVal(2), {}}))
+	require.False(t, c.after(9, []types.Val{intVal(2), intVal(7)}))
+	c.desc[1] = true
+	require.True(t, c.after(9, []types.Val{intVal(2), intVal(7)}))
+	require.True(t, c.after(1, []types.Val{intVal(3), intVal(7)}))
+}
+
+func TestFoldValue(t *testing.T) {
+	strVal := func(v string) types.Val { return types.Val{Tid: types.StringID, Value: v} }
+	exactCI, ok := tok.GetTokenizer("exact_ci")
+	require.True(t, ok)
+	exact, ok := tok.GetTokenizer("exact")
+	require.True(t, ok)
+
+	require.Equal(t, strVal("alice"), foldValue(exactCI, strVal("ALICE")))
+	require.Equal(t, strVal("ALICE"), foldValue(exact, strVal("ALICE")))
+	require.Equal(t, strVal("ALICE"), foldValue(nil, strVal("ALICE")))
+	// Missing values stay missing, so they're still sorted last.
+	require.Nil(t, foldValue(exactCI, types.Val{}).Value)
+}
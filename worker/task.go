@@ -19,6 +19,7 @@ package worker
 import (
 	"bytes"
 	"context"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -166,6 +167,67 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 	return reply, nil
 }
 
+// TaskStats describes how a task query was processed. It's returned for queries run in
+// explain mode.
+type TaskStats struct {
+	// Group is the group that serves the predicate of the task. Remote is true if the task was
+	// sent to an Alpha of that group, because this one doesn't serve it.
+	Group  uint32
+	Remote bool
+	// Path is how the task finds its results. It's "index" if the task looks up the index of
+	// the predicate, "count" if it looks up its @count index, "scan" if it goes through all the
+	// nodes that have the predicate and "data" if it reads the predicate of the given uids.
+	Path string
+	// Intersect is true if only the uids found for all the index tokens looked up by the task
+	// are kept, and false if the uids found for any of them are.
+	Intersect bool
+	// BytesSent and BytesReceived are the sizes of the task and of its result, if the task was
+	// sent to another Alpha.
+	BytesSent     int
+	BytesReceived int
+	Latency       time.Duration
+}
+
+// ProcessTaskWithStats works like ProcessTaskOverNetwork, and also returns how the task was
+// processed.
+func ProcessTaskWithStats(ctx context.Context, q *pb.Query) (*pb.Result, *TaskStats, error) {
+	stats := &TaskStats{}
+	stats.Path, stats.Intersect = taskPath(q)
+	if gid, err := groups().BelongsToReadOnly(q.Attr, q.ReadTs); err == nil {
+		stats.Group = gid
+		stats.Remote = gid != 0 && !groups().ServesGroup(gid)
+	}
+
+	start := time.Now()
+	result, err := ProcessTaskOverNetwork(ctx, q)
+	stats.Latency = time.Since(start)
+	if err != nil {
+		return nil, stats, err
+	}
+	if stats.Remote {
+		stats.BytesSent = q.Size()
+		stats.BytesReceived = result.Size()
+	}
+	return result, stats, nil
+}
+
+// taskPath returns how the task q finds its results, following the same decisions as
+// helpProcessTask, and whether it intersects the uids found for its index tokens.
+func taskPath(q *pb.Query) (string, bool) {
+	fnType, fname := parseFuncType(q.SrcFunc)
+	isFuncAtRoot := q.UidList == nil
+	switch {
+	case needsIndex(fnType, q.UidList) || fnType == customIndexFn || fnType == compositeFn ||
+		(isFuncAtRoot && fnType == regexFn):
+		return "index", needsIntersect(fname)
+	case isFuncAtRoot && fnType == compareScalarFn:
+		return "count", false
+	case isFuncAtRoot && fnType == hasFn:
+		return "scan", false
+	}
+	return "data", false
+}
+
 // convertValue converts the data to the schema.State() type of predicate.
 func convertValue(attr, data string) (types.Val, error) {
 	// Parse given value and get token. There should be only one token.
@@ -220,6 +282,8 @@ const (
 	uidInFn
 	customIndexFn
 	matchFn
+	similarToFn
+	compositeFn
 	standardFn = 100
 )
 
@@ -246,7 +310,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 	switch f {
 	case "le", "ge", "lt", "gt", "eq", "between":
 		return compareAttrFn, f
-	case "min", "max", "sum", "avg":
+	case "min", "max", "sum", "avg", "median", "percentile", "stddev", "variance",
+		"countdistinct":
 		return aggregatorFn, f
 	case "checkpwd":
 		return passwordFn, f
@@ -262,6 +327,10 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "similar_to":
+		return similarToFn, f
+	case "composite_eq":
+		return compositeFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
@@ -282,6 +351,9 @@ func needsIndex(fnType FuncType, uidList *pb.List) bool {
 		return true
 	case geoFn, fullTextSearchFn, standardFn, matchFn:
 		return true
+	case similarToFn:
+		// As a filter, the vectors of the given uids are compared directly.
+		return uidList == nil
 	}
 	return false
 }
@@ -314,15 +386,44 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
-	case uidInFn, compareScalarFn:
+	case uidInFn, compareScalarFn, similarToFn:
 		// Operate on uid postings
 		return false, nil
+	case compositeFn:
+		// The composite index is looked up both at the root and for filters.
+		return false, nil
 	case notAFunction:
 		return typ.IsScalar(), nil
 	}
 	return false, errors.Errorf("Unhandled case in fetchValuePostings for fn: %s", srcFn.fname)
 }
 
+// hasCompositeIndex returns true if there's a composite index over preds that's built, and none
+// of its predicates has its indexes being rebuilt.
+func hasCompositeIndex(preds []string) bool {
+	if schema.State().IsBuildingIndex(preds) {
+		return false
+	}
+	for _, pred := range preds {
+		if schema.State().IsBeingIndexed(pred) {
+			return false
+		}
+	}
+	for _, index := range schema.State().CompositeIndexes(preds[0]) {
+		if len(index) != len(preds) {
+			continue
+		}
+		same := true
+		for i := range index {
+			same = same && index[i] == preds[i]
+		}
+		if same {
+			return true
+		}
+	}
+	return false
+}
+
 // Handles fetching of value posting lists and filtering of uids based on that.
 func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) error {
 	srcFn := args.srcFn
@@ -451,6 +552,7 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 					if val, err = types.Convert(val, srcFn.atype); err != nil {
 						return err
 					}
+					val = foldValue(srcFn.foldTokenizer, val)
 					if types.CompareVals(srcFn.fname, val, srcFn.eqTokens[0]) {
 						uidList.Uids = append(uidList.Uids, q.UidList.Uids[i])
 						break
@@ -717,7 +819,7 @@ func (qs *queryState) handleUidPostings(
 					key = x.DataKey(q.Attr, q.UidList.Uids[i])
 				}
 			case geoFn, regexFn, fullTextSearchFn, standardFn, customIndexFn, matchFn,
-				compareAttrFn:
+				compareAttrFn, compositeFn:
 				key = x.IndexKey(q.Attr, srcFn.tokens[i])
 			default:
 				return errors.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
@@ -1001,6 +1103,13 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	if srcFn.fnType == similarToFn {
+		span.Annotate(nil, "handleSimilarToFunction")
+		if err := qs.handleSimilarToFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
 	if srcFn.fnType == matchFn {
 		span.Annotate(nil, "handleMatchFunction")
 		if err := qs.handleMatchFunction(ctx, args); err != nil {
@@ -1183,6 +1292,95 @@ func (qs *queryState) handleRegexFunction(ctx context.Context, arg funcArgs) err
 	return nil
 }
 
+func (qs *queryState) handleSimilarToFunction(ctx context.Context, arg funcArgs) error {
+	span := otrace.FromContext(ctx)
+	stop := x.SpanTimer(span, "handleSimilarToFunction")
+	defer stop()
+
+	attr := arg.q.Attr
+	typ, err := schema.State().TypeOf(attr)
+	if err != nil || typ != types.VFloatID {
+		return errors.Errorf("Attribute %s must be of type %s for %s",
+			attr, types.VFloatID.Name(), arg.srcFn.fname)
+	}
+	k := int(arg.srcFn.threshold[0])
+	useIndex := schema.State().HasTokenizer(ctx, tok.IdentVector, attr)
+	span.Annotatef(nil, "Vector index found: %t, func at root: %t, k: %d",
+		useIndex, arg.srcFn.isFuncAtRoot, k)
+
+	var uids *pb.List
+	switch {
+	// As a filter, the nearest neighbours are picked among the given uids.
+	case arg.q.UidList != nil:
+		uids = &pb.List{}
+		uids.Uids = append(arg.q.UidList.Uids[:0:0], arg.q.UidList.Uids...)
+
+	case useIndex:
+		if uids, err = uidsForVector(attr, arg, k); err != nil {
+			return err
+		}
+
+	default:
+		return errors.Errorf(
+			"Attribute %v does not have vector index for %s. "+
+				"Please add a vector index or use has/uid function with %s as filter.",
+			attr, arg.srcFn.fname, arg.srcFn.fname)
+	}
+
+	arg.out.UidMatrix = append(arg.out.UidMatrix, uids)
+	isList := schema.State().IsList(attr)
+	span.Annotatef(nil, "Total candidates: %d, list: %t", len(uids.Uids), isList)
+
+	dists := make([]vectorDist, 0, len(uids.Uids))
+	for _, uid := range uids.Uids {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
+
+		vals := make([]types.Val, 1)
+		if isList {
+			vals, err = pl.AllUntaggedValues(arg.q.ReadTs)
+		} else {
+			vals[0], err = pl.Value(arg.q.ReadTs)
+		}
+		if err != nil {
+			if err == posting.ErrNoValue {
+				continue
+			}
+			return err
+		}
+
+		// For lists, the distance to the closest vector counts.
+		best := vectorDist{uid: uid, dist: math.Inf(1)}
+		for _, val := range vals {
+			vec, err := types.Convert(val, types.VFloatID)
+			if err != nil {
+				continue
+			}
+			// Vectors with a different number of dimensions can't be compared.
+			dist, err := types.CosineDistance(arg.srcFn.vector, vec.Value.([]float32))
+			if err == nil && dist < best.dist {
+				best.dist = dist
+			}
+		}
+		if !math.IsInf(best.dist, 1) {
+			dists = append(dists, best)
+		}
+	}
+
+	filtered := nearestUids(dists, k)
+	for i := 0; i < len(arg.out.UidMatrix); i++ {
+		algo.IntersectWith(arg.out.UidMatrix[i], filtered, arg.out.UidMatrix[i])
+	}
+	return nil
+}
+
 func (qs *queryState) handleCompareFunction(ctx context.Context, arg funcArgs) error {
 	span := otrace.FromContext(ctx)
 	stop := x.SpanTimer(span, "handleCompareFunction")
@@ -1532,7 +1730,7 @@ func (qs *queryState) filterStringFunction(arg funcArgs) error {
 			if err != nil {
 				continue
 			}
-			strVals = append(strVals, strVal)
+			strVals = append(strVals, foldValue(arg.srcFn.foldTokenizer, strVal))
 		}
 		if len(strVals) > 0 {
 			values = append(values, strVals)
@@ -1618,7 +1816,10 @@ type functionContext struct {
 	// other compareAttr functions.
 	// TODO(@Animesh): change field names which could explain their uses better. Check if we
 	// really need all of ineqValue, eqTokens, tokens
-	eqTokens       []types.Val
+	eqTokens []types.Val
+	// foldTokenizer is the tokenizer that compareAttr functions use. If it folds the values
+	// that it indexes, the eqTokens are folded and so must be the values compared to them.
+	foldTokenizer  tok.Tokenizer
 	ineqValueToken []string
 	n              int
 	threshold      []int64
@@ -1626,6 +1827,7 @@ type functionContext struct {
 	fname          string
 	fnType         FuncType
 	regex          *cregexp.Regexp
+	vector         []float32
 	isFuncAtRoot   bool
 	isStringFn     bool
 	atype          types.TypeID
@@ -1751,6 +1953,14 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			}
 			fc.tokens = append(fc.tokens, tokens...)
 		}
+		if isIndexedAttr {
+			if fc.foldTokenizer, err = pickTokenizer(ctx, attr, f); err != nil {
+				return nil, err
+			}
+			for i := range fc.eqTokens {
+				fc.eqTokens[i] = foldValue(fc.foldTokenizer, fc.eqTokens[i])
+			}
+		}
 
 		// In case of non-indexed predicate, there won't be any tokens. We will fetch value
 		// from data keys.
@@ -1768,6 +1978,35 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		default:
 			fc.n = len(fc.tokens)
 		}
+	case compositeFn:
+		// The arguments are the value of the first predicate of the index, followed by every
+		// other predicate and its value, e.g. composite_eq(tenant, "a", status, "open").
+		args := q.SrcFunc.Args
+		if len(args) < 3 || len(args)%2 == 0 {
+			return nil, errors.Errorf("Function %s expects a value for every predicate. Got: %v",
+				q.SrcFunc.Name, args)
+		}
+		preds := []string{attr}
+		for i := 1; i < len(args); i += 2 {
+			preds = append(preds, args[i])
+		}
+		if !hasCompositeIndex(preds) {
+			return nil, errors.Errorf("No composite index over %v is ready", preds)
+		}
+		vals := make([]types.Val, 0, len(preds))
+		for i, pred := range preds {
+			val, err := convertValue(pred, args[2*i])
+			if err != nil {
+				return nil, errors.Errorf("Got error: %v while running: %v", err, q.SrcFunc)
+			}
+			vals = append(vals, val)
+		}
+		token, err := tok.CompositeToken(preds, vals)
+		if err != nil {
+			return nil, err
+		}
+		fc.tokens = []string{token}
+		fc.n = 1
 	case compareScalarFn:
 		argCount := 1
 		if q.SrcFunc.Name == between {
@@ -1879,6 +2118,21 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = 0
+	case similarToFn:
+		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
+			return nil, err
+		}
+		k, err := strconv.ParseInt(q.SrcFunc.Args[0], 0, 32)
+		if err != nil || k <= 0 {
+			return nil, errors.Errorf("Number of neighbours in %s must be a positive int, got %v",
+				f, q.SrcFunc.Args[0])
+		}
+		fc.threshold = []int64{k}
+		if fc.vector, err = types.ParseVFloat(q.SrcFunc.Args[1]); err != nil {
+			return nil, err
+		}
+		// The candidates are fetched in handleSimilarToFunction.
+		fc.n = 0
 	case hasFn:
 		if err = ensureArgsCount(q.SrcFunc, 0); err != nil {
 			return nil, err
@@ -0,0 +1,55 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestTaskPath(t *testing.T) {
+	uids := &pb.List{Uids: []uint64{1, 2, 3}}
+	tests := []struct {
+		fn        *pb.SrcFunction
+		uids      *pb.List
+		path      string
+		intersect bool
+	}{
+		{fn: nil, uids: uids, path: "data"},
+		{fn: &pb.SrcFunction{Name: "eq"}, path: "index"},
+		{fn: &pb.SrcFunction{Name: "eq"}, uids: uids, path: "data"},
+		{fn: &pb.SrcFunction{Name: "allofterms"}, path: "index", intersect: true},
+		{fn: &pb.SrcFunction{Name: "anyofterms"}, uids: uids, path: "index"},
+		{fn: &pb.SrcFunction{Name: "alloftext"}, uids: uids, path: "index", intersect: true},
+		{fn: &pb.SrcFunction{Name: "gt", IsCount: true}, path: "count"},
+		{fn: &pb.SrcFunction{Name: "gt", IsCount: true}, uids: uids, path: "data"},
+		{fn: &pb.SrcFunction{Name: "has"}, path: "scan"},
+		{fn: &pb.SrcFunction{Name: "has"}, uids: uids, path: "data"},
+		{fn: &pb.SrcFunction{Name: "regexp"}, path: "index"},
+		{fn: &pb.SrcFunction{Name: "regexp"}, uids: uids, path: "data"},
+		{fn: &pb.SrcFunction{Name: "uid_in"}, uids: uids, path: "data"},
+	}
+	for _, tc := range tests {
+		q := &pb.Query{SrcFunc: tc.fn, UidList: tc.uids}
+		path, intersect := taskPath(q)
+		require.Equal(t, tc.path, path, "%+v", tc.fn)
+		require.Equal(t, tc.intersect, intersect, "%+v", tc.fn)
+	}
+}
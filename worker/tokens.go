@@ -109,6 +109,19 @@ func pickTokenizer(ctx context.Context, attr string, f string) (tok.Tokenizer, e
 	return tokenizers[0], nil
 }
 
+// foldValue folds a string value the way the tokenizer t folds what it indexes, if it does. The
+// values that are compared directly, instead of through the index, then compare like their tokens.
+func foldValue(t tok.Tokenizer, val types.Val) types.Val {
+	ft, ok := t.(tok.FoldingTokenizer)
+	if !ok {
+		return val
+	}
+	if s, ok := val.Value.(string); ok {
+		val.Value = ft.Fold(s)
+	}
+	return val
+}
+
 // getInequalityTokens gets tokens ge/le/between compared to given tokens using the first sortable
 // index that is found for the predicate.
 // In case of ge/gt/le/lt/eq len(ineqValues) should be 1, else(between) len(ineqValues) should be 2.
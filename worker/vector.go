@@ -0,0 +1,94 @@
+/*
+ * Copyright 2020 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// uidsForVector returns the candidates for the k nearest neighbours of the vector, i.e. the
+// uids sharing an index bucket with it. If the buckets hold fewer than k uids, the neighbouring
+// buckets are looked up as well.
+func uidsForVector(attr string, arg funcArgs, k int) (*pb.List, error) {
+	opts := posting.ListOptions{ReadTs: arg.q.ReadTs}
+	uidsForTokens := func(tokens []string) (*pb.List, error) {
+		tok.EncodeVectorTokens(tokens)
+		lists := make([]*pb.List, 0, len(tokens))
+		for _, t := range tokens {
+			pl, err := posting.GetNoStore(x.IndexKey(attr, t), arg.q.ReadTs)
+			if err != nil {
+				return nil, err
+			}
+			uids, err := pl.Uids(opts)
+			if err != nil {
+				return nil, err
+			}
+			lists = append(lists, uids)
+		}
+		return algo.MergeSorted(lists), nil
+	}
+
+	tokens, err := types.IndexVFloatTokens(arg.srcFn.vector)
+	if err != nil {
+		return nil, err
+	}
+	uids, err := uidsForTokens(tokens)
+	if err != nil || len(uids.Uids) >= k {
+		return uids, err
+	}
+
+	probes, err := types.ProbeVFloatTokens(arg.srcFn.vector)
+	if err != nil {
+		return nil, err
+	}
+	more, err := uidsForTokens(probes)
+	if err != nil {
+		return nil, err
+	}
+	return algo.MergeSorted([]*pb.List{uids, more}), nil
+}
+
+type vectorDist struct {
+	uid  uint64
+	dist float64
+}
+
+// nearestUids returns the uids of the k entries with the smallest distance, sorted by uid.
+func nearestUids(dists []vectorDist, k int) *pb.List {
+	sort.Slice(dists, func(i, j int) bool {
+		if dists[i].dist == dists[j].dist {
+			return dists[i].uid < dists[j].uid
+		}
+		return dists[i].dist < dists[j].dist
+	})
+	if len(dists) > k {
+		dists = dists[:k]
+	}
+	out := &pb.List{Uids: make([]uint64, 0, len(dists))}
+	for _, d := range dists {
+		out.Uids = append(out.Uids, d.uid)
+	}
+	sort.Slice(out.Uids, func(i, j int) bool { return out.Uids[i] < out.Uids[j] })
+	return out
+}
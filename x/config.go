@@ -30,11 +30,19 @@ type Options struct {
 	// QueryEdgeLimit is the maximum number of edges that will be traversed during
 	// recurse and shortest-path queries.
 	QueryEdgeLimit uint64
+	// ShortestFrontierLimit is the maximum number of nodes in the frontier of the breadth first
+	// search used by unweighted shortest-path queries.
+	ShortestFrontierLimit uint64
 	// NormalizeNodeLimit is the maximum number of nodes allowed in a normalize query.
 	NormalizeNodeLimit int
 	// MutationsNQuadLimit is maximum number of nquads that can be present in a single
 	// mutation request.
 	MutationsNQuadLimit int
+	// QueryCacheMB is the size of the cache of query results in MB. The cache is disabled if it's
+	// zero.
+	QueryCacheMB int
+	// PersistedQueriesOnly is true if the clients can only run persisted queries.
+	PersistedQueriesOnly bool
 	// PollInterval is the polling interval for graphql subscription.
 	PollInterval time.Duration
 	// GraphqlExtension will be set to see extensions in graphql results
@@ -62,6 +62,7 @@ func AddInit(f func()) {
 func Init() {
 	// Default value, would be overwritten by flag.
 	Config.QueryEdgeLimit = 1e6
+	Config.ShortestFrontierLimit = 1e5
 
 	// Next, run all the init functions that have been added.
 	for _, f := range initFunc {
@@ -546,6 +546,8 @@ var graphqlReservedPredicate = map[string]struct{}{
 	"dgraph.cors":                      {},
 	"dgraph.graphql.schema_history":    {},
 	"dgraph.graphql.schema_created_at": {},
+	"dgraph.dql.id":                    {},
+	"dgraph.dql.query":                 {},
 }
 
 // internalPredicateMap stores a set of Dgraph's internal predicate. An internal
@@ -561,6 +563,7 @@ var preDefinedTypeMap = map[string]struct{}{
 	"dgraph.type.Group":      {},
 	"dgraph.type.Rule":       {},
 	"dgraph.graphql.history": {},
+	"dgraph.dql":             {},
 }
 
 // IsGraphqlReservedPredicate returns true if it is the predicate is reserved by graphql.
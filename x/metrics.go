@@ -51,6 +51,13 @@ var (
 	// LatencyMs is the latency of the various Dgraph operations.
 	LatencyMs = stats.Float64("latency",
 		"Latency of the various methods", stats.UnitMilliseconds)
+	// QueryCacheHits is the total number of queries answered from the query result cache.
+	QueryCacheHits = stats.Int64("query_cache_hits_total",
+		"Number of queries answered from the result cache", stats.UnitDimensionless)
+	// QueryCacheMisses is the total number of cacheable queries not found in the query result
+	// cache.
+	QueryCacheMisses = stats.Int64("query_cache_misses_total",
+		"Number of cacheable queries missing from the result cache", stats.UnitDimensionless)
 
 	// Point-in-time metrics.
 
@@ -75,6 +82,9 @@ var (
 	// AlphaHealth status records the current health of the alphas.
 	AlphaHealth = stats.Int64("alpha_health_status",
 		"Status of the alphas", stats.UnitDimensionless)
+	// QueryCacheSize records the current size of the query result cache.
+	QueryCacheSize = stats.Int64("query_cache_size_bytes",
+		"Size of the query result cache", stats.UnitBytes)
 	// RaftAppliedIndex records the latest applied RAFT index.
 	RaftAppliedIndex = stats.Int64("raft_applied_index",
 		"Latest applied Raft index", stats.UnitDimensionless)
@@ -151,6 +161,20 @@ var (
 			Aggregation: view.Count(),
 			TagKeys:     allTagKeys,
 		},
+		{
+			Name:        QueryCacheHits.Name(),
+			Measure:     QueryCacheHits,
+			Description: QueryCacheHits.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     allTagKeys,
+		},
+		{
+			Name:        QueryCacheMisses.Name(),
+			Measure:     QueryCacheMisses,
+			Description: QueryCacheMisses.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     allTagKeys,
+		},
 		{
 			Name:        RaftAppliedIndex.Name(),
 			Measure:     RaftAppliedIndex,
@@ -223,6 +247,13 @@ var (
 			Aggregation: view.LastValue(),
 			TagKeys:     nil,
 		},
+		{
+			Name:        QueryCacheSize.Name(),
+			Measure:     QueryCacheSize,
+			Description: QueryCacheSize.Description(),
+			Aggregation: view.LastValue(),
+			TagKeys:     nil,
+		},
 		{
 			Name:        PBlockHitRatio.Name(),
 			Measure:     PBlockHitRatio,
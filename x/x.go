@@ -144,6 +144,9 @@ const (
 }, {
 	"fields": [{"name": "dgraph.graphql.schema_history"},{"name": "dgraph.graphql.schema_created_at"}],
 	"name": "dgraph.graphql.history"
+}, {
+	"fields": [{"name": "dgraph.dql.id"},{"name": "dgraph.dql.query"}],
+	"name": "dgraph.dql"
 }]`
 
 	// GroupIdFileName is the name of the file storing the ID of the group to which
@@ -156,6 +159,16 @@ const (
 		"Content-Type, Content-Length, Accept-Encoding, Cache-Control, " +
 		"X-CSRF-Token, X-Auth-Token, X-Requested-With"
 	DgraphCostHeader = "Dgraph-TouchedUids"
+	// AsOfKey is the HTTP query parameter, and the gRPC metadata key, used to run a query at the
+	// given timestamp or time.
+	AsOfKey = "as_of"
+	// ExplainKey is the HTTP query parameter, and the gRPC metadata key, used to run a query in
+	// explain mode. gRPC clients get the plans and profiles of the query back as JSON in the
+	// trailer with the same key.
+	ExplainKey = "explain"
+	// PersistedQueryKey is the HTTP query parameter, and the gRPC metadata key, used to run the
+	// persisted query with the given id. The request then only holds the variables of the query.
+	PersistedQueryKey = "persisted_query"
 
 	// GraphqlPredicates is the json representation of the predicate reserved for graphql system.
 	GraphqlPredicates = `
@@ -163,6 +176,12 @@ const (
 {"predicate":"dgraph.graphql.schema_history", "type": "string"},
 {"predicate":"dgraph.graphql.schema_created_at", "type": "datetime"},
 {"predicate":"dgraph.graphql.xid","type":"string","index":true,"tokenizer":["exact"],"upsert":true}
+`
+	// DqlPredicates is the json representation of the predicates reserved for the persisted DQL
+	// queries.
+	DqlPredicates = `
+{"predicate":"dgraph.dql.id","type":"string","index":true,"tokenizer":["exact"],"upsert":true},
+{"predicate":"dgraph.dql.query","type":"string"}
 `
 )
 
@@ -425,6 +444,33 @@ func AttachAccessJwt(ctx context.Context, r *http.Request) context.Context {
 	return ctx
 }
 
+// AttachAsOf adds the as_of query parameter, used for point-in-time queries, into the grpc
+// context metadata.
+func AttachAsOf(ctx context.Context, r *http.Request) context.Context {
+	return attachQueryParam(ctx, r, AsOfKey)
+}
+
+// AttachPersistedQuery adds the persisted_query query parameter, used to run a persisted query,
+// into the grpc context metadata.
+func AttachPersistedQuery(ctx context.Context, r *http.Request) context.Context {
+	return attachQueryParam(ctx, r, PersistedQueryKey)
+}
+
+// attachQueryParam adds the query parameter key of r, if it's given, into the grpc context
+// metadata under the same key.
+func attachQueryParam(ctx context.Context, r *http.Request, key string) context.Context {
+	if val := r.URL.Query().Get(key); val != "" {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			md = metadata.New(nil)
+		}
+
+		md.Set(key, val)
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	return ctx
+}
+
 // AttachRemoteIP adds any incoming IP data into the grpc context metadata
 func AttachRemoteIP(ctx context.Context, r *http.Request) context.Context {
 	if ip, port, err := net.SplitHostPort(r.RemoteAddr); err == nil {